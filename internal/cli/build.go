@@ -0,0 +1,171 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/ork-cli/ork/internal/config"
+	"github.com/ork-cli/ork/internal/docker"
+	"github.com/ork-cli/ork/internal/service"
+	"github.com/ork-cli/ork/internal/ui"
+	"github.com/ork-cli/ork/pkg/utils"
+	"github.com/spf13/cobra"
+)
+
+// ============================================================================
+// Type Definitions
+// ============================================================================
+
+// buildFlags holds the command-line options for 'ork build'
+type buildFlags struct {
+	noCache  bool
+	pull     bool
+	progress docker.ProgressMode
+}
+
+// ============================================================================
+// Cobra Command Definition
+// ============================================================================
+
+var buildCmd = &cobra.Command{
+	Use:   "build [service...]",
+	Short: "Build images for services with a build section",
+	Long: `
+Build images for services that define a 'build' section in ork.yml.
+
+With no arguments, all build-based services are built. Services that only
+specify an 'image' are skipped.`,
+	Example: `
+ork build                    Build all build-based services
+ork build api worker         Build specific services
+ork build --no-cache api     Build without using the cache
+ork build --pull             Pull newer base images before building`,
+
+	Run: func(cmd *cobra.Command, args []string) {
+		// Get flags
+		noCache, _ := cmd.Flags().GetBool("no-cache")
+		pull, _ := cmd.Flags().GetBool("pull")
+		progressValue, _ := cmd.Flags().GetString("progress")
+
+		progress, err := docker.ParseProgressMode(progressValue)
+		if err != nil {
+			handleUpError(err)
+			return
+		}
+
+		flags := buildFlags{noCache: noCache, pull: pull, progress: progress}
+		if err := runBuild(args, flags); err != nil {
+			handleUpError(err)
+			return
+		}
+	},
+}
+
+func init() {
+	// Register the 'build' command with the root command
+	rootCmd.AddCommand(buildCmd)
+
+	// Add flags
+	buildCmd.Flags().Bool("no-cache", false, "Do not use the build cache")
+	buildCmd.Flags().Bool("pull", false, "Always attempt to pull newer base images")
+	buildCmd.Flags().String("progress", string(docker.ProgressAuto), "Build output mode: auto or plain")
+}
+
+// ============================================================================
+// Main Orchestrator
+// ============================================================================
+
+// runBuild builds images for the selected build-based services
+func runBuild(serviceNames []string, flags buildFlags) error {
+	cfg, err := loadAndValidateConfig()
+	if err != nil {
+		return err
+	}
+
+	if err := validateServiceNames(serviceNames, cfg); err != nil {
+		return err
+	}
+
+	toBuild, skipped := selectBuildServices(cfg, serviceNames)
+	for _, name := range skipped {
+		ui.Info(fmt.Sprintf("Skipping %s %s", ui.Bold(name), ui.Dim("(uses an image, nothing to build)")))
+	}
+	if len(toBuild) == 0 {
+		ui.Warning("No services with a build section to build")
+		return nil
+	}
+
+	dockerClient, err := createDockerClient()
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if closeErr := dockerClient.Close(); closeErr != nil {
+			ui.Warning(fmt.Sprintf("Failed to close Docker client: %v", closeErr))
+		}
+	}()
+
+	ctx := context.Background()
+	buildKit, err := dockerClient.BuildKitAvailable(ctx)
+	if err != nil {
+		return utils.DockerError(
+			"build.docker",
+			"Lost the connection to the Docker daemon",
+			"Is Docker running? Try 'docker ps' or start Docker Desktop",
+			err,
+		)
+	}
+
+	for _, name := range toBuild {
+		opts := buildOptionsFor(cfg, name, flags)
+		opts.BuildKit = buildKit
+
+		ui.Info(fmt.Sprintf("Building %s", ui.Bold(name)))
+		tag, err := dockerClient.Build(ctx, opts)
+		if err != nil {
+			return utils.DockerError(
+				"build.image",
+				fmt.Sprintf("Failed to build service %s", name),
+				"Check the build output above for the failing step",
+				err,
+			)
+		}
+		ui.Success(fmt.Sprintf("Built %s %s", ui.Bold(name), ui.Dim(tag)))
+	}
+
+	return nil
+}
+
+// ============================================================================
+// Private Helpers
+// ============================================================================
+
+// selectBuildServices splits the requested services into those with a build section and the rest
+// With no names given, all services are considered; both results are sorted
+func selectBuildServices(cfg *config.Config, serviceNames []string) (toBuild, skipped []string) {
+	if len(serviceNames) == 0 {
+		serviceNames = getAvailableServicesList(cfg)
+	}
+
+	for _, name := range serviceNames {
+		if cfg.Services[name].Build != nil {
+			toBuild = append(toBuild, name)
+		} else {
+			skipped = append(skipped, name)
+		}
+	}
+
+	sort.Strings(toBuild)
+	sort.Strings(skipped)
+	return toBuild, skipped
+}
+
+// buildOptionsFor converts a service's build section and the command flags into build options
+func buildOptionsFor(cfg *config.Config, serviceName string, flags buildFlags) docker.BuildOptions {
+	opts := service.BuildOptionsFor(cfg.Project, serviceName, cfg.BaseDir, cfg.Services[serviceName])
+	opts.NoCache = flags.noCache
+	opts.Pull = flags.pull
+	opts.Progress = flags.progress
+	return opts
+}
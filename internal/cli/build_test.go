@@ -0,0 +1,89 @@
+package cli
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/ork-cli/ork/internal/config"
+	"github.com/ork-cli/ork/internal/docker"
+	"github.com/stretchr/testify/assert"
+)
+
+// buildTestConfig returns a config mixing build-based and image-only services
+func buildTestConfig() *config.Config {
+	return &config.Config{
+		Project: "shop",
+		Services: map[string]config.Service{
+			"api":    {Build: &config.Build{Context: "./api"}},
+			"worker": {Build: &config.Build{Context: "./worker"}},
+			"db":     {Image: "postgres:15"},
+		},
+	}
+}
+
+// ============================================================================
+// Service Selection Tests
+// ============================================================================
+
+func TestSelectBuildServices_AllServices(t *testing.T) {
+	toBuild, skipped := selectBuildServices(buildTestConfig(), nil)
+
+	assert.Equal(t, []string{"api", "worker"}, toBuild)
+	assert.Equal(t, []string{"db"}, skipped)
+}
+
+func TestSelectBuildServices_Subset(t *testing.T) {
+	toBuild, skipped := selectBuildServices(buildTestConfig(), []string{"worker", "db"})
+
+	assert.Equal(t, []string{"worker"}, toBuild)
+	assert.Equal(t, []string{"db"}, skipped)
+}
+
+func TestSelectBuildServices_OnlyImages(t *testing.T) {
+	toBuild, skipped := selectBuildServices(buildTestConfig(), []string{"db"})
+
+	assert.Empty(t, toBuild)
+	assert.Equal(t, []string{"db"}, skipped)
+}
+
+// ============================================================================
+// Build Options Tests
+// ============================================================================
+
+func TestBuildOptionsFor_FlagPlumbing(t *testing.T) {
+	target := "prod"
+	svc := config.Service{Build: &config.Build{
+		Context:    "./api",
+		Dockerfile: "Dockerfile.prod",
+		Args:       map[string]string{"VERSION": "1.2"},
+		Target:     &target,
+		CacheFrom:  []string{"ghcr.io/org/api:latest"},
+	}}
+	flags := buildFlags{noCache: true, pull: true, progress: docker.ProgressPlain}
+
+	cfg := &config.Config{Project: "shop", BaseDir: "/work/shop", Services: map[string]config.Service{"api": svc}}
+
+	opts := buildOptionsFor(cfg, "api", flags)
+
+	assert.Equal(t, filepath.Join("/work/shop", "api"), opts.Context)
+	assert.Equal(t, "Dockerfile.prod", opts.Dockerfile)
+	assert.Equal(t, "ork-shop-api:latest", opts.Tag)
+	assert.Equal(t, map[string]string{"VERSION": "1.2"}, opts.Args)
+	assert.Equal(t, "prod", opts.Target)
+	assert.Equal(t, []string{"ghcr.io/org/api:latest"}, opts.CacheFrom)
+	assert.True(t, opts.NoCache)
+	assert.True(t, opts.Pull)
+	assert.Equal(t, docker.ProgressPlain, opts.Progress)
+}
+
+func TestBuildOptionsFor_Defaults(t *testing.T) {
+	svc := config.Service{Build: &config.Build{Context: "./api"}}
+
+	cfg := &config.Config{Project: "shop", Services: map[string]config.Service{"api": svc}}
+
+	opts := buildOptionsFor(cfg, "api", buildFlags{})
+
+	assert.Empty(t, opts.Target)
+	assert.False(t, opts.NoCache)
+	assert.False(t, opts.Pull)
+}
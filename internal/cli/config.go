@@ -0,0 +1,282 @@
+package cli
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/ork-cli/ork/internal/config"
+	"github.com/ork-cli/ork/internal/ui"
+	"github.com/ork-cli/ork/pkg/utils"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+)
+
+// ============================================================================
+// Cobra Command Definition
+// ============================================================================
+
+var configCmd = &cobra.Command{
+	Use:   "config [service]",
+	Short: "Inspect the project configuration",
+	Long: `
+Inspect the configuration in ork.yml.
+
+Prints the validated configuration as YAML, exactly as Ork will use it
+(with volume paths resolved). Pass a service name (or --service) to print
+only that service.
+
+Use --resolve to replace each service's env block with the computed
+environment: the project .env, the service's .env.<service>, and the env
+from ork.yml, merged and interpolated. Combined with --service, this is the
+full configuration Ork starts that one service with.
+
+Use --profiles to list every profile declared across services, along with
+the services that belong to each one.
+
+Use --check-env to interpolate every service's environment and report each
+variable that references an undefined variable or ends up empty, so missing
+secrets are caught before starting anything. It exits non-zero when any are
+found, for use in CI.`,
+	Example: `
+ork config                   Print the full configuration
+ork config api               Print only the api service
+ork config --resolve         Include the computed env for each service
+ork config -s api --resolve  Print the api service exactly as Ork runs it
+ork config --profiles        List profiles and their services
+ork config --check-env       Report missing or empty environment variables`,
+
+	Args: cobra.MaximumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		// Get flags
+		showProfiles, _ := cmd.Flags().GetBool("profiles")
+		resolve, _ := cmd.Flags().GetBool("resolve")
+		resolveEnv, _ := cmd.Flags().GetBool("resolve-env")
+		checkEnv, _ := cmd.Flags().GetBool("check-env")
+		serviceFlag, _ := cmd.Flags().GetString("service")
+
+		var err error
+		if showProfiles {
+			err = runConfigProfiles()
+		} else {
+			var serviceName string
+			serviceName, err = configServiceName(args, serviceFlag)
+			if err == nil && checkEnv {
+				err = runConfigCheckEnv(serviceName)
+			} else if err == nil {
+				err = runConfigShow(serviceName, resolve || resolveEnv)
+			}
+		}
+
+		if err != nil {
+			handleUpError(err)
+			return
+		}
+	},
+}
+
+func init() {
+	// Register the 'config' command with the root command
+	rootCmd.AddCommand(configCmd)
+
+	// Add flags
+	configCmd.Flags().Bool("profiles", false, "List declared profiles and their services")
+	configCmd.Flags().StringP("service", "s", "", "Print only this service (same as passing its name)")
+	configCmd.Flags().Bool("resolve", false, "Show each service's computed env (.env files merged and interpolated)")
+	configCmd.Flags().Bool("resolve-env", false, "Alias for --resolve")
+	configCmd.Flags().Bool("check-env", false, "Report env variables that reference undefined variables or are empty")
+}
+
+// ============================================================================
+// Main Orchestrator
+// ============================================================================
+
+// runConfigShow prints the validated configuration (or a single service) as YAML
+// With resolveEnv, each service's env is replaced by its merged, interpolated environment
+func runConfigShow(serviceName string, resolveEnv bool) error {
+	// Status messages (like the no-services warning) go to stderr so the YAML can be redirected
+	ui.SetMessageOutput(os.Stderr)
+	defer ui.SetMessageOutput(nil)
+
+	cfg, err := loadConfigForInspection()
+	if err != nil {
+		return err
+	}
+
+	view, err := buildConfigView(cfg, serviceName, resolveEnv)
+	if err != nil {
+		return err
+	}
+
+	data, err := marshalConfigYAML(view)
+	if err != nil {
+		return fmt.Errorf("failed to render configuration: %w", err)
+	}
+
+	fmt.Print(string(data))
+	return nil
+}
+
+// runConfigProfiles lists all profiles declared in ork.yml and the services in each
+func runConfigProfiles() error {
+	cfg, err := loadConfigForInspection()
+	if err != nil {
+		return err
+	}
+
+	fmt.Print(formatProfiles(cfg))
+	return nil
+}
+
+// runConfigCheckEnv reports every environment variable, across services (or just serviceName),
+// that references an undefined variable or resolves to empty
+func runConfigCheckEnv(serviceName string) error {
+	cfg, err := loadConfigForInspection()
+	if err != nil {
+		return err
+	}
+
+	serviceNames := getAvailableServicesList(cfg)
+	if serviceName != "" {
+		if err := validateServiceNames([]string{serviceName}, cfg); err != nil {
+			return err
+		}
+		serviceNames = []string{serviceName}
+	}
+
+	var issues []config.EnvIssue
+	for _, name := range serviceNames {
+		serviceIssues, err := config.CheckEnvForService(cfg.BaseDir, name, cfg.Services[name].Env)
+		if err != nil {
+			return utils.ConfigError(
+				"config.env",
+				fmt.Sprintf("Failed to resolve env for service '%s'", name),
+				"Check the .env files next to ork.yml for syntax errors or circular references",
+				err,
+			)
+		}
+		issues = append(issues, serviceIssues...)
+	}
+
+	if len(issues) > 0 {
+		configErr := utils.ConfigError(
+			"config.env",
+			fmt.Sprintf("%d environment variable(s) are undefined or empty", len(issues)),
+			"Define them in .env, .env.<service>, or the shell environment, or give the reference a default like ${VAR:-value}",
+			nil,
+		)
+		configErr.Details = formatEnvIssues(issues)
+		return configErr
+	}
+
+	ui.Success(fmt.Sprintf("All environment variables resolved for %d service(s)", len(serviceNames)))
+	return nil
+}
+
+// ============================================================================
+// Private Helpers - Config View
+// ============================================================================
+
+// configServiceName picks the service to print from the positional argument or --service
+// Naming two different services is an error
+func configServiceName(args []string, serviceFlag string) (string, error) {
+	if len(args) == 0 {
+		return serviceFlag, nil
+	}
+	if serviceFlag != "" && serviceFlag != args[0] {
+		return "", utils.ConfigError(
+			"config.service",
+			fmt.Sprintf("Conflicting services: '%s' and --service %s", args[0], serviceFlag),
+			"Pass the service either as an argument or with --service, not both",
+			nil,
+		)
+	}
+	return args[0], nil
+}
+
+// buildConfigView copies the config for display, narrowed to one service if serviceName is set
+// The loaded config is left untouched
+func buildConfigView(cfg *config.Config, serviceName string, resolveEnv bool) (*config.Config, error) {
+	if serviceName != "" {
+		if err := validateServiceNames([]string{serviceName}, cfg); err != nil {
+			return nil, err
+		}
+	}
+
+	view := *cfg
+	view.Services = make(map[string]config.Service, len(cfg.Services))
+	for name, svc := range cfg.Services {
+		if serviceName != "" && name != serviceName {
+			continue
+		}
+
+		if resolveEnv {
+			env, err := config.LoadAllEnvForService(cfg.BaseDir, name, svc.Env)
+			if err != nil {
+				return nil, utils.ConfigError(
+					"config.env",
+					fmt.Sprintf("Failed to resolve env for service '%s'", name),
+					"Check the .env files next to ork.yml for syntax errors or circular references",
+					err,
+				)
+			}
+			if len(env) > 0 {
+				svc.Env = env
+			}
+		}
+
+		view.Services[name] = svc
+	}
+
+	return &view, nil
+}
+
+// marshalConfigYAML serializes a config as YAML with the two-space indent used in ork.yml
+func marshalConfigYAML(cfg *config.Config) ([]byte, error) {
+	var buf bytes.Buffer
+	encoder := yaml.NewEncoder(&buf)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(cfg); err != nil {
+		return nil, err
+	}
+	if err := encoder.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// ============================================================================
+// Private Helpers - Display
+// ============================================================================
+
+// formatEnvIssues describes each env issue on one line (e.g., "api: DATABASE_URL references undefined ${DB_PASSWORD}")
+func formatEnvIssues(issues []config.EnvIssue) []string {
+	lines := make([]string, 0, len(issues))
+	for _, issue := range issues {
+		if issue.Variable != "" {
+			lines = append(lines, fmt.Sprintf("%s: %s references undefined ${%s}", issue.Service, issue.Key, issue.Variable))
+		} else {
+			lines = append(lines, fmt.Sprintf("%s: %s is empty", issue.Service, issue.Key))
+		}
+	}
+	return lines
+}
+
+// formatProfiles renders the profile listing for a configuration
+func formatProfiles(cfg *config.Config) string {
+	profiles := cfg.ProfileServices()
+	if len(profiles) == 0 {
+		return ui.Dim(fmt.Sprintf("No profiles declared in project %s", cfg.Project)) + "\n"
+	}
+
+	rows := make([]ui.KeyValueRow, 0, len(profiles))
+	for _, profile := range cfg.ProfileNames() {
+		rows = append(rows, ui.KeyValueRow{
+			Key:   profile,
+			Value: strings.Join(profiles[profile], ", "),
+		})
+	}
+
+	return ui.KeyValueTable(fmt.Sprintf("%s Profiles for project: %s", ui.SymbolGear, ui.Bold(cfg.Project)), rows)
+}
@@ -0,0 +1,210 @@
+package cli
+
+import (
+	"errors"
+	"os"
+	"testing"
+
+	"github.com/ork-cli/ork/internal/config"
+	"github.com/ork-cli/ork/pkg/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+const configShowTestConfig = `version: "1.0"
+project: shop
+max_parallel: 2
+services:
+  api:
+    image: node:18
+    ports: ["3000:3000"]
+    env:
+      DATABASE_URL: postgres://${DB_HOST}:5432/shop
+    depends_on:
+      postgres:
+        condition: service_healthy
+    health:
+      endpoint: /health
+      interval: 5s
+      timeout: 3s
+      retries: 3
+    restart: on-failure:3
+    resources:
+      cpus: "0.5"
+      memory: 512m
+  postgres:
+    image: postgres:15
+    volumes: ["pgdata:/var/lib/postgresql/data"]
+    health:
+      command: ["pg_isready"]
+      interval: 2s
+      timeout: 1s
+      retries: 5
+`
+
+// ============================================================================
+// Config Show Tests
+// ============================================================================
+
+func TestRunConfigShow_RoundTrips(t *testing.T) {
+	writeTestConfig(t, configShowTestConfig)
+
+	out := captureStdout(t, func() {
+		require.NoError(t, runConfigShow("", false))
+	})
+
+	loaded, err := loadAndValidateConfig()
+	require.NoError(t, err)
+
+	var printed config.Config
+	require.NoError(t, yaml.Unmarshal([]byte(out), &printed), "output should be valid YAML: %q", out)
+	assert.Equal(t, loaded.Project, printed.Project)
+	assert.Equal(t, loaded.MaxParallel, printed.MaxParallel)
+	assert.Equal(t, loaded.Services, printed.Services)
+}
+
+func TestRunConfigShow_KeepsEnvAsWritten(t *testing.T) {
+	writeTestConfig(t, configShowTestConfig)
+
+	out := captureStdout(t, func() {
+		require.NoError(t, runConfigShow("api", false))
+	})
+
+	assert.Contains(t, out, "postgres://${DB_HOST}:5432/shop")
+}
+
+func TestRunConfigShow_ResolveEnvInterpolates(t *testing.T) {
+	writeTestConfig(t, configShowTestConfig)
+	require.NoError(t, os.WriteFile(".env", []byte("DB_HOST=db.internal\n"), 0o644))
+	require.NoError(t, os.WriteFile(".env.api", []byte("LOG_LEVEL=debug\n"), 0o644))
+
+	out := captureStdout(t, func() {
+		require.NoError(t, runConfigShow("api", true))
+	})
+
+	var printed config.Config
+	require.NoError(t, yaml.Unmarshal([]byte(out), &printed))
+	assert.Equal(t, map[string]string{
+		"DATABASE_URL": "postgres://db.internal:5432/shop",
+		"DB_HOST":      "db.internal",
+		"LOG_LEVEL":    "debug",
+	}, printed.Services["api"].Env)
+}
+
+func TestRunConfigShow_SingleService(t *testing.T) {
+	writeTestConfig(t, configShowTestConfig)
+
+	out := captureStdout(t, func() {
+		require.NoError(t, runConfigShow("postgres", false))
+	})
+
+	var printed config.Config
+	require.NoError(t, yaml.Unmarshal([]byte(out), &printed))
+	assert.Len(t, printed.Services, 1)
+	assert.Contains(t, printed.Services, "postgres")
+}
+
+func TestRunConfigShow_UnknownService(t *testing.T) {
+	writeTestConfig(t, configShowTestConfig)
+
+	err := runConfigShow("ap", false)
+
+	var orkErr *utils.OrkError
+	require.True(t, errors.As(err, &orkErr))
+	assert.Contains(t, orkErr.Suggestions, "api")
+}
+
+func TestRunConfigShow_NoServicesWarnsOnStderr(t *testing.T) {
+	writeTestConfig(t, noServicesTestConfig)
+
+	out := captureStdout(t, func() {
+		require.NoError(t, runConfigShow("", false))
+	})
+
+	var printed config.Config
+	require.NoError(t, yaml.Unmarshal([]byte(out), &printed), "stdout should only hold YAML: %q", out)
+	assert.Equal(t, "shop", printed.Project)
+	assert.NotContains(t, out, "No services defined")
+}
+
+func TestRunConfigShow_SingleServiceResolved(t *testing.T) {
+	writeTestConfig(t, configShowTestConfig)
+	require.NoError(t, os.WriteFile(".env", []byte("DB_HOST=db.internal\n"), 0o644))
+
+	out := captureStdout(t, func() {
+		require.NoError(t, runConfigShow("api", true))
+	})
+
+	var printed config.Config
+	require.NoError(t, yaml.Unmarshal([]byte(out), &printed))
+	require.Len(t, printed.Services, 1)
+
+	api := printed.Services["api"]
+	assert.Equal(t, "node:18", api.Image)
+	assert.Equal(t, []string{"3000:3000"}, api.Ports)
+	assert.Equal(t, "postgres://db.internal:5432/shop", api.Env["DATABASE_URL"])
+	assert.Equal(t, "db.internal", api.Env["DB_HOST"], "project .env values are merged in")
+	assert.NotContains(t, out, "postgres:15", "other services are left out")
+}
+
+// ============================================================================
+// Service Selection Tests
+// ============================================================================
+
+func TestConfigServiceName(t *testing.T) {
+	tests := []struct {
+		name        string
+		args        []string
+		serviceFlag string
+		expected    string
+		expectError bool
+	}{
+		{name: "neither", expected: ""},
+		{name: "argument", args: []string{"api"}, expected: "api"},
+		{name: "flag", serviceFlag: "api", expected: "api"},
+		{name: "both agree", args: []string{"api"}, serviceFlag: "api", expected: "api"},
+		{name: "both differ", args: []string{"api"}, serviceFlag: "postgres", expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			serviceName, err := configServiceName(tt.args, tt.serviceFlag)
+			if tt.expectError {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, serviceName)
+		})
+	}
+}
+
+// ============================================================================
+// Config Check Env Tests
+// ============================================================================
+
+func TestRunConfigCheckEnv_ReportsUndefinedVariable(t *testing.T) {
+	writeTestConfig(t, configShowTestConfig)
+
+	err := runConfigCheckEnv("")
+
+	var orkErr *utils.OrkError
+	require.True(t, errors.As(err, &orkErr))
+	assert.Contains(t, orkErr.Message, "1 environment variable(s)")
+	assert.Equal(t, []string{"api: DATABASE_URL references undefined ${DB_HOST}"}, orkErr.Details)
+}
+
+func TestRunConfigCheckEnv_FullyResolved(t *testing.T) {
+	writeTestConfig(t, configShowTestConfig)
+	require.NoError(t, os.WriteFile(".env", []byte("DB_HOST=db.internal\n"), 0o644))
+
+	require.NoError(t, runConfigCheckEnv(""))
+}
+
+func TestRunConfigCheckEnv_SingleService(t *testing.T) {
+	writeTestConfig(t, configShowTestConfig)
+
+	// postgres doesn't reference DB_HOST, so checking only it reports clean
+	require.NoError(t, runConfigCheckEnv("postgres"))
+}
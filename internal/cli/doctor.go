@@ -0,0 +1,337 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/ork-cli/ork/internal/config"
+	"github.com/ork-cli/ork/internal/docker"
+	"github.com/ork-cli/ork/internal/service"
+	"github.com/ork-cli/ork/internal/ui"
+	"github.com/ork-cli/ork/pkg/utils"
+	"github.com/spf13/cobra"
+)
+
+// ============================================================================
+// Cobra Command Definition
+// ============================================================================
+
+var doctorCmd = &cobra.Command{
+	Use:   "doctor",
+	Short: "Check that Docker and the project are ready to run",
+	Long: `
+Run health checks on everything 'ork up' relies on:
+
+  - Docker: the daemon is reachable
+  - Configuration: ork.yml exists and is valid
+  - Ports: every host port a service publishes can be bound
+  - Images: every image a service runs is present locally
+
+Ports already held by this project's own running containers pass. Missing
+images are a warning, since 'ork up' pulls them - unless offline mode
+(--no-pull) is on, where they fail.
+
+Exits with an error if any check fails.`,
+	Args: cobra.NoArgs,
+	Run: func(_ *cobra.Command, _ []string) {
+		if err := runDoctor(); err != nil {
+			handleDoctorError(err)
+			return
+		}
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(doctorCmd)
+}
+
+// Health check statuses understood by ui.HealthCheckTable
+const (
+	doctorPass = "pass"
+	doctorFail = "fail"
+	doctorWarn = "warn"
+)
+
+// ============================================================================
+// Main Command Logic
+// ============================================================================
+
+// runDoctor runs every check, prints one table per category, and fails if any check failed
+func runDoctor() error {
+	ctx := context.Background()
+
+	dockerRow, client := checkDocker(ctx)
+	if client != nil {
+		defer func() {
+			if closeErr := client.Close(); closeErr != nil {
+				ui.Warning(fmt.Sprintf("Failed to close Docker client: %v", closeErr))
+			}
+		}()
+	}
+	configRows, cfg := checkConfig()
+
+	sections := []struct {
+		category string
+		rows     []ui.HealthCheckRow
+	}{
+		{"Docker", []ui.HealthCheckRow{dockerRow}},
+		{"Configuration", configRows},
+		{"Ports", checkPorts(ctx, client, cfg)},
+		{"Images", checkImages(ctx, client, cfg)},
+	}
+
+	var failed, warned int
+	for _, section := range sections {
+		fmt.Print(ui.HealthCheckTable(section.category, section.rows))
+		ui.EmptyLine()
+		for _, row := range section.rows {
+			switch row.Status {
+			case doctorFail:
+				failed++
+			case doctorWarn:
+				warned++
+			}
+		}
+	}
+
+	if failed > 0 {
+		return doctorFailure(failed, dockerRow.Status == doctorFail)
+	}
+	if warned > 0 {
+		ui.Warning(fmt.Sprintf("All checks passed with %d warning(s)", warned))
+		return nil
+	}
+	ui.Success("All checks passed")
+	return nil
+}
+
+// doctorFailure reports failed checks as a Docker error when the daemon is unreachable,
+// and as a config error otherwise (ports, images, or ork.yml need fixing)
+func doctorFailure(failed int, dockerDown bool) error {
+	message := fmt.Sprintf("%d check(s) failed", failed)
+	if dockerDown {
+		return utils.DockerError(
+			"doctor.docker",
+			message,
+			"Start Docker and run 'ork doctor' again",
+			nil,
+		)
+	}
+	return utils.ConfigError(
+		"doctor.check",
+		message,
+		"Fix the failing checks above and run 'ork doctor' again",
+		nil,
+	)
+}
+
+// ============================================================================
+// Checks
+// ============================================================================
+
+// checkDocker connects to the Docker daemon and reports its version
+// Returns a nil client when Docker is unreachable, so later checks can be skipped
+func checkDocker(ctx context.Context) (ui.HealthCheckRow, *docker.Client) {
+	row := ui.HealthCheckRow{Check: "Docker daemon"}
+
+	client, err := docker.NewClient()
+	if err != nil {
+		row.Status = doctorFail
+		row.Detail = dockerFailureDetail(err)
+		return row, nil
+	}
+
+	version, err := client.ServerVersion(ctx)
+	if err != nil {
+		_ = client.Close()
+		row.Status = doctorFail
+		row.Detail = dockerFailureDetail(err)
+		return row, nil
+	}
+
+	row.Status = doctorPass
+	row.Detail = "Docker " + version
+	return row, client
+}
+
+// dockerFailureDetail describes why the Docker check failed, calling out a daemon that isn't running
+func dockerFailureDetail(err error) string {
+	if docker.IsDaemonUnavailable(err) {
+		return "Docker daemon is not running (start Docker Desktop or the docker service)"
+	}
+	return firstLine(err.Error())
+}
+
+// checkConfig loads and validates ork.yml using the global config flags
+// Returns a nil config if it is missing or invalid
+func checkConfig() ([]ui.HealthCheckRow, *config.Config) {
+	cfg, err := config.LoadWithOptions(config.LoadOptions{File: configFile, Strict: strictConfig})
+	if err != nil {
+		return []ui.HealthCheckRow{{Check: "ork.yml", Status: doctorFail, Detail: firstLine(err.Error())}}, nil
+	}
+	if projectOverride != "" {
+		cfg.Project = projectOverride
+	}
+
+	rows := []ui.HealthCheckRow{{Check: "ork.yml", Status: doctorPass, Detail: fmt.Sprintf("Project %s in %s", cfg.Project, cfg.BaseDir)}}
+
+	if err := cfg.Validate(); err != nil {
+		rows = append(rows, ui.HealthCheckRow{Check: "Valid configuration", Status: doctorFail, Detail: firstLine(err.Error())})
+		return rows, nil
+	}
+
+	rows = append(rows, ui.HealthCheckRow{Check: "Valid configuration", Status: doctorPass, Detail: fmt.Sprintf("%d service(s)", len(cfg.Services))})
+	return rows, cfg
+}
+
+// checkPorts tries to bind every host port the services publish
+// A port held by one of the project's own running containers is expected and passes
+func checkPorts(ctx context.Context, client *docker.Client, cfg *config.Config) []ui.HealthCheckRow {
+	if cfg == nil {
+		return []ui.HealthCheckRow{{Check: "Host ports", Status: doctorWarn, Detail: "Skipped - no valid ork.yml"}}
+	}
+
+	owners := runningPortOwners(ctx, client, cfg.Project)
+
+	var rows []ui.HealthCheckRow
+	for _, name := range sortedServiceNames(cfg) {
+		for _, hostPort := range service.HostPorts(cfg.Services[name]) {
+			row := ui.HealthCheckRow{Check: fmt.Sprintf("%s (%s)", hostPort, name)}
+
+			if err := docker.PortAvailable(hostPort); err == nil {
+				row.Status = doctorPass
+				row.Detail = "Available"
+			} else if owner, ok := owners[hostPort]; ok {
+				row.Status = doctorPass
+				row.Detail = fmt.Sprintf("In use by running service %s", owner)
+			} else {
+				row.Status = doctorFail
+				row.Detail = "In use by another process"
+			}
+
+			rows = append(rows, row)
+		}
+	}
+
+	if len(rows) == 0 {
+		return []ui.HealthCheckRow{{Check: "Host ports", Status: doctorPass, Detail: "No services publish ports"}}
+	}
+	return rows
+}
+
+// checkImages reports whether each service's image is already present locally
+// Build-based services pass, since their image is built by 'ork up'
+func checkImages(ctx context.Context, client *docker.Client, cfg *config.Config) []ui.HealthCheckRow {
+	if cfg == nil {
+		return []ui.HealthCheckRow{{Check: "Images", Status: doctorWarn, Detail: "Skipped - no valid ork.yml"}}
+	}
+	if client == nil {
+		return []ui.HealthCheckRow{{Check: "Images", Status: doctorWarn, Detail: "Skipped - Docker is not reachable"}}
+	}
+
+	var rows []ui.HealthCheckRow
+	for _, name := range sortedServiceNames(cfg) {
+		serviceCfg := cfg.Services[name]
+		if serviceCfg.Build != nil {
+			rows = append(rows, ui.HealthCheckRow{Check: name, Status: doctorPass, Detail: fmt.Sprintf("Built from %s", serviceCfg.Build.Context)})
+			continue
+		}
+		if serviceCfg.Image == "" {
+			continue // git-only services have nothing to run yet
+		}
+
+		row := ui.HealthCheckRow{Check: name}
+		present, err := client.ImageExists(ctx, serviceCfg.Image)
+		switch {
+		case err != nil:
+			row.Status = doctorFail
+			row.Detail = firstLine(err.Error())
+		case present:
+			row.Status = doctorPass
+			row.Detail = serviceCfg.Image
+		case client.IsOffline():
+			row.Status = doctorFail
+			row.Detail = fmt.Sprintf("%s is missing and offline mode prevents pulling it", serviceCfg.Image)
+		default:
+			row.Status = doctorWarn
+			row.Detail = fmt.Sprintf("%s is not pulled yet ('ork up' will pull it)", serviceCfg.Image)
+		}
+		rows = append(rows, row)
+	}
+
+	return rows
+}
+
+// ============================================================================
+// Private Helpers
+// ============================================================================
+
+// runningPortOwners maps the host ports published by the project's running containers to their service
+// Keys use the same form as service.HostPorts ("8080", "5353/udp"); empty without a Docker client
+func runningPortOwners(ctx context.Context, client *docker.Client, projectName string) map[string]string {
+	owners := make(map[string]string)
+	if client == nil {
+		return owners
+	}
+
+	containers, err := client.List(ctx, projectName)
+	if err != nil {
+		return owners
+	}
+
+	for _, c := range containers {
+		if !strings.HasPrefix(c.Status, "Up") {
+			continue
+		}
+		for _, published := range c.Ports {
+			// Published ports look like "8080:80/tcp"
+			hostPort, rest, ok := strings.Cut(published, ":")
+			if !ok {
+				continue
+			}
+			if strings.HasSuffix(rest, "/udp") {
+				hostPort += "/udp"
+			}
+			owners[hostPort] = c.Labels["ork.service"]
+		}
+	}
+	return owners
+}
+
+// sortedServiceNames returns the config's service names in alphabetical order
+func sortedServiceNames(cfg *config.Config) []string {
+	names := make([]string, 0, len(cfg.Services))
+	for name := range cfg.Services {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// firstLine drops the hint lines some errors carry after the message
+func firstLine(message string) string {
+	line, _, _ := strings.Cut(message, "\n")
+	return line
+}
+
+// ============================================================================
+// Private Helpers - Error Handling
+// ============================================================================
+
+// handleDoctorError formats and displays errors with hints
+func handleDoctorError(err error) {
+	commandErr = err
+
+	if orkErr, ok := err.(*utils.OrkError); ok {
+		// Display structured error with hints
+		ui.Error(orkErr.Message)
+		if orkErr.Hint != "" {
+			ui.Hint(orkErr.Hint)
+		}
+	} else {
+		// Fallback for non-Ork errors
+		ui.Error(fmt.Sprintf("Error: %v", err))
+	}
+}
@@ -0,0 +1,252 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strconv"
+	"testing"
+
+	"github.com/docker/docker/api/types/container"
+	"github.com/ork-cli/ork/internal/config"
+	"github.com/ork-cli/ork/internal/docker"
+	"github.com/ork-cli/ork/internal/docker/dockertest"
+	"github.com/ork-cli/ork/internal/ui"
+	"github.com/ork-cli/ork/pkg/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// ============================================================================
+// Test Helpers
+// ============================================================================
+
+// freePort returns a TCP port that nothing is listening on
+func freePort(t *testing.T) int {
+	t.Helper()
+
+	listener, err := net.Listen("tcp", ":0")
+	require.NoError(t, err)
+	port := listener.Addr().(*net.TCPAddr).Port
+	require.NoError(t, listener.Close())
+	return port
+}
+
+// holdPort listens on a free TCP port for the rest of the test and returns it
+func holdPort(t *testing.T) int {
+	t.Helper()
+
+	listener, err := net.Listen("tcp", ":0")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = listener.Close() })
+	return listener.Addr().(*net.TCPAddr).Port
+}
+
+// rowStatuses maps each row's check name to its status
+func rowStatuses(rows []ui.HealthCheckRow) map[string]string {
+	statuses := make(map[string]string, len(rows))
+	for _, row := range rows {
+		statuses[row.Check] = row.Status
+	}
+	return statuses
+}
+
+// ============================================================================
+// Docker Check Tests
+// ============================================================================
+
+func TestCheckDocker_Reachable(t *testing.T) {
+	dockertest.NewServer(t)
+
+	row, client := checkDocker(context.Background())
+
+	require.NotNil(t, client)
+	t.Cleanup(func() { _ = client.Close() })
+	assert.Equal(t, doctorPass, row.Status)
+	assert.Contains(t, row.Detail, "27.0.0-fake")
+}
+
+func TestCheckDocker_Unreachable(t *testing.T) {
+	t.Setenv("DOCKER_HOST", fmt.Sprintf("tcp://127.0.0.1:%d", freePort(t)))
+	t.Setenv("DOCKER_TLS_VERIFY", "")
+	t.Setenv("DOCKER_CERT_PATH", "")
+
+	row, client := checkDocker(context.Background())
+
+	assert.Nil(t, client)
+	assert.Equal(t, doctorFail, row.Status)
+	assert.Contains(t, row.Detail, "Docker daemon is not running")
+	assert.NotContains(t, row.Detail, "\n", "hint lines are dropped from the table")
+}
+
+// ============================================================================
+// Config Check Tests
+// ============================================================================
+
+func TestCheckConfig_Valid(t *testing.T) {
+	writeTestConfig(t, restartTestConfig)
+
+	rows, cfg := checkConfig()
+
+	require.NotNil(t, cfg)
+	assert.Equal(t, map[string]string{"ork.yml": doctorPass, "Valid configuration": doctorPass}, rowStatuses(rows))
+}
+
+func TestCheckConfig_Missing(t *testing.T) {
+	t.Chdir(t.TempDir())
+
+	rows, cfg := checkConfig()
+
+	assert.Nil(t, cfg)
+	assert.Equal(t, map[string]string{"ork.yml": doctorFail}, rowStatuses(rows))
+}
+
+func TestCheckConfig_Invalid(t *testing.T) {
+	writeTestConfig(t, "version: \"1.0\"\nproject: shop\nservices:\n  api:\n    image: node:18\n    ports: [\"8080\"]\n")
+
+	rows, cfg := checkConfig()
+
+	assert.Nil(t, cfg)
+	assert.Equal(t, map[string]string{"ork.yml": doctorPass, "Valid configuration": doctorFail}, rowStatuses(rows))
+}
+
+// ============================================================================
+// Port Check Tests
+// ============================================================================
+
+func TestCheckPorts(t *testing.T) {
+	free, held := strconv.Itoa(freePort(t)), strconv.Itoa(holdPort(t))
+	cfg := &config.Config{Project: "shop", Services: map[string]config.Service{
+		"api": {Image: "node:18", Ports: []string{free + ":8080"}},
+		"web": {Image: "nginx", Ports: []string{held + ":80"}},
+	}}
+
+	rows := checkPorts(context.Background(), nil, cfg)
+
+	assert.Equal(t, map[string]string{
+		free + " (api)": doctorPass,
+		held + " (web)": doctorFail,
+	}, rowStatuses(rows))
+}
+
+func TestCheckPorts_HeldByOwnRunningService(t *testing.T) {
+	fake, client := dockertest.NewServer(t)
+	held := holdPort(t)
+	fake.AddContainer("aaaaaaaaaaaa", "shop", "web", "Up 5 minutes")
+	fake.SetPorts("aaaaaaaaaaaa", []container.Port{{PrivatePort: 80, PublicPort: uint16(held), Type: "tcp"}})
+
+	cfg := &config.Config{Project: "shop", Services: map[string]config.Service{
+		"web": {Image: "nginx", Ports: []string{strconv.Itoa(held) + ":80"}},
+	}}
+
+	rows := checkPorts(context.Background(), client, cfg)
+
+	require.Len(t, rows, 1)
+	assert.Equal(t, doctorPass, rows[0].Status)
+	assert.Contains(t, rows[0].Detail, "web")
+}
+
+func TestCheckPorts_SkippedWithoutConfig(t *testing.T) {
+	rows := checkPorts(context.Background(), nil, nil)
+
+	require.Len(t, rows, 1)
+	assert.Equal(t, doctorWarn, rows[0].Status)
+}
+
+// ============================================================================
+// Image Check Tests
+// ============================================================================
+
+func TestCheckImages(t *testing.T) {
+	fake, client := dockertest.NewServer(t)
+	fake.RemoveImage("postgres:15")
+
+	cfg := &config.Config{Project: "shop", Services: map[string]config.Service{
+		"api": {Image: "node:18"},
+		"db":  {Image: "postgres:15"},
+		"web": {Build: &config.Build{Context: "./web"}},
+	}}
+
+	rows := checkImages(context.Background(), client, cfg)
+
+	assert.Equal(t, map[string]string{"api": doctorPass, "db": doctorWarn, "web": doctorPass}, rowStatuses(rows))
+	assert.False(t, fake.HasRequest("POST /images/create"), "doctor must never pull")
+}
+
+func TestCheckImages_OfflineMissingFails(t *testing.T) {
+	t.Setenv(docker.OfflineEnvVar, "1")
+	fake, client := dockertest.NewServer(t)
+	fake.RemoveImage("postgres:15")
+
+	cfg := &config.Config{Project: "shop", Services: map[string]config.Service{"db": {Image: "postgres:15"}}}
+
+	rows := checkImages(context.Background(), client, cfg)
+
+	assert.Equal(t, map[string]string{"db": doctorFail}, rowStatuses(rows))
+}
+
+func TestCheckImages_SkippedWithoutDocker(t *testing.T) {
+	cfg := &config.Config{Project: "shop", Services: map[string]config.Service{"db": {Image: "postgres:15"}}}
+
+	rows := checkImages(context.Background(), nil, cfg)
+
+	require.Len(t, rows, 1)
+	assert.Equal(t, doctorWarn, rows[0].Status)
+}
+
+// ============================================================================
+// Command Tests
+// ============================================================================
+
+func TestRunDoctor_FailsWhenACheckFails(t *testing.T) {
+	dockertest.NewServer(t)
+	held := holdPort(t)
+	writeTestConfig(t, fmt.Sprintf("version: \"1.0\"\nproject: shop\nservices:\n  web:\n    image: nginx\n    ports: [\"%d:80\"]\n", held))
+
+	var err error
+	out := captureStdout(t, func() {
+		err = runDoctor()
+	})
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "1 check(s) failed")
+	assert.True(t, utils.IsKind(err, utils.ErrorConfig))
+	assert.Contains(t, out, "Docker")
+	assert.Contains(t, out, "Ports")
+}
+
+func TestRunDoctor_DockerDownIsDockerError(t *testing.T) {
+	t.Setenv("DOCKER_HOST", "tcp://127.0.0.1:1")
+	writeTestConfig(t, "version: \"1.0\"\nproject: shop\nservices:\n  web:\n    image: nginx\n")
+
+	var err error
+	captureStdout(t, func() {
+		err = runDoctor()
+	})
+
+	require.Error(t, err)
+	assert.True(t, utils.IsKind(err, utils.ErrorDocker))
+}
+
+func TestHandleDoctorError_RecordsErrorForExitCode(t *testing.T) {
+	t.Cleanup(func() { commandErr = nil })
+
+	out := captureStdout(t, func() {
+		handleDoctorError(doctorFailure(2, false))
+	})
+
+	assert.Contains(t, out, "2 check(s) failed")
+	assert.Contains(t, out, "Fix the failing checks above")
+	assert.Equal(t, 2, finishWithError(commandErr))
+}
+
+func TestRunDoctor_AllPass(t *testing.T) {
+	dockertest.NewServer(t)
+	writeTestConfig(t, fmt.Sprintf("version: \"1.0\"\nproject: shop\nservices:\n  web:\n    image: nginx\n    ports: [\"%d:80\"]\n", freePort(t)))
+
+	out := captureStdout(t, func() {
+		require.NoError(t, runDoctor())
+	})
+
+	assert.Contains(t, out, "All checks passed")
+}
@@ -3,9 +3,14 @@ package cli
 import (
 	"context"
 	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
 
 	"github.com/ork-cli/ork/internal/config"
 	"github.com/ork-cli/ork/internal/docker"
+	"github.com/ork-cli/ork/internal/service"
 	"github.com/ork-cli/ork/internal/ui"
 	"github.com/ork-cli/ork/pkg/utils"
 	"github.com/spf13/cobra"
@@ -21,19 +26,49 @@ var downCmd = &cobra.Command{
 	Long: `
 Stop one or more services managed by Ork.
 
-If no services are specified, stops all services for the current project.
-By default, stopped containers are removed to keep your system clean.`,
+If no services are specified, stops all services for the current project
+and removes the project network. Services are stopped in reverse dependency
+order, one level at a time (the levels 'ork up' starts, walked backwards), so
+dependents go down before the services they rely on. Services within a level
+are stopped in parallel. By default, stopped containers are removed to keep
+your system clean.
+
+Each container gets its service's stop_timeout (default 10s) to shut down
+gracefully before it is killed. A service with a stop_grace_period is first
+sent its stop signal (the image's STOPSIGNAL, or SIGTERM) and given that long
+to exit on its own before its stop_timeout starts. Use --timeout to override
+both for every service.
+
+Use --profile NAME to stop every service that declares that profile
+(see 'ork config --profiles'), along with any services named.
+
+Use --volumes to also remove the services' named volumes. Named volumes are
+shared across the whole Docker host, so only volumes Ork created for this
+project are removed; one of the same name from another project is kept.
+
+Running 'ork down' when nothing is running is not an error.`,
 	Example: `
 ork down                     Stop all services in current project
 ork down redis               Stop specific service
 ork down redis postgres      Stop multiple services
-ork down --keep              Stop but keep containers for debugging`,
+ork down --keep              Stop but keep containers for debugging
+ork down --timeout 1s        Give every service only 1s to shut down
+ork down --volumes           Also remove named volumes (deletes their data)
+ork down --profile debug     Stop only the services in the debug profile`,
 
 	Run: func(cmd *cobra.Command, args []string) {
 		// Get flags
 		keepContainers, _ := cmd.Flags().GetBool("keep")
+		removeVolumes, _ := cmd.Flags().GetBool("volumes")
+		profiles, _ := cmd.Flags().GetStringArray("profile")
+
+		stopTimeout, err := stopTimeoutOverride(cmd)
+		if err != nil {
+			handleDownError(err)
+			return
+		}
 
-		if err := runDown(args, keepContainers); err != nil {
+		if err := runDown(args, profiles, keepContainers, removeVolumes, stopTimeout); err != nil {
 			handleDownError(err)
 			return
 		}
@@ -46,6 +81,9 @@ func init() {
 
 	// Add flags
 	downCmd.Flags().Bool("keep", false, "Keep stopped containers (don't remove)")
+	downCmd.Flags().BoolP("volumes", "v", false, "Remove named volumes declared by the services")
+	downCmd.Flags().Duration("timeout", 0, "Time each service gets to shut down before being killed (default: stop_timeout, or 10s)")
+	downCmd.Flags().StringArray("profile", nil, "Stop the services in this profile (repeatable)")
 }
 
 // ============================================================================
@@ -53,13 +91,31 @@ func init() {
 // ============================================================================
 
 // runDown stops (and optionally removes) Ork-managed containers
-func runDown(serviceNames []string, keepContainers bool) error {
+// With removeVolumes, the named volumes of the affected services are removed as well
+// A non-nil stopTimeout replaces every service's stop_timeout
+// profiles are the --profile values whose services are stopped along with serviceNames
+func runDown(serviceNames, profiles []string, keepContainers, removeVolumes bool, stopTimeout *time.Duration) error {
+	// Volumes can't be removed while a (stopped) container still references them
+	if keepContainers && removeVolumes {
+		return utils.ConfigError(
+			"down.flags",
+			"--volumes cannot be used with --keep",
+			"Drop --keep so containers are removed before their volumes",
+			nil,
+		)
+	}
+
 	// Load configuration to get the project name
-	cfg, err := loadConfigForDown()
+	cfg, err := loadConfigUnvalidated()
 	if err != nil {
 		return err
 	}
 
+	// Add the services of each --profile
+	if serviceNames, err = addProfileServices(serviceNames, profiles, cfg); err != nil {
+		return err
+	}
+
 	// Create a Docker client
 	dockerClient, err := createDockerClientForDown()
 	if err != nil {
@@ -83,66 +139,39 @@ func runDown(serviceNames []string, keepContainers bool) error {
 		)
 	}
 
-	if len(containers) == 0 {
-		ui.Info(fmt.Sprintf("No services running for project: %s", ui.Bold(cfg.Project)))
-		return nil
-	}
-
 	// Filter containers if specific services requested
 	containersToStop := filterContainersByService(containers, serviceNames)
+	var failed []string
 
 	if len(containersToStop) == 0 {
-		if len(serviceNames) > 0 {
+		if len(serviceNames) > 0 && len(containers) > 0 {
 			ui.Warning(fmt.Sprintf("No matching services found: %v", serviceNames))
 			ui.Hint("Use 'ork ps' to see running services")
 		} else {
 			ui.Info(fmt.Sprintf("No services running for project: %s", ui.Bold(cfg.Project)))
 		}
-		return nil
-	}
-
-	// Show what we're stopping
-	ui.EmptyLine()
-	ui.Info(fmt.Sprintf("Stopping %d service(s) for project: %s", len(containersToStop), ui.Bold(cfg.Project)))
-	ui.EmptyLine()
+	} else {
+		// Show what we're stopping
+		ui.EmptyLine()
+		ui.Info(fmt.Sprintf("Stopping %d service(s) for project: %s", len(containersToStop), ui.Bold(cfg.Project)))
+		ui.EmptyLine()
 
-	// Stop (and optionally remove) containers
-	if err := stopContainers(ctx, dockerClient, containersToStop, keepContainers); err != nil {
-		return err
+		// Stop (and optionally remove) containers level by level, dependents first
+		failed = stopContainersByLevel(ctx, dockerClient, containersToStop, cfg, keepContainers, stopTimeout)
 	}
 
 	// Clean up the network if we stopped all services
-	if len(serviceNames) == 0 && len(containersToStop) == len(containers) {
-		// All services have been stopped, remove the network
-		spinner := ui.ShowSpinner("Cleaning up project network...")
-		if err := dockerClient.DeleteNetwork(ctx, cfg.Project); err != nil {
-			spinner.Warning(fmt.Sprintf("Failed to remove network: %v", err))
-		} else {
-			spinner.Success(fmt.Sprintf("Removed network: ork-%s-network", cfg.Project))
-		}
+	if len(serviceNames) == 0 && len(failed) == 0 && len(containersToStop) > 0 && len(containersToStop) == len(containers) {
+		removeProjectNetwork(ctx, dockerClient, cfg.Project)
 	}
 
-	ui.EmptyLine()
-	ui.SuccessBox(fmt.Sprintf("Successfully stopped %d service(s)", len(containersToStop)))
-	return nil
-}
-
-// ============================================================================
-// Private Helpers - Configuration
-// ============================================================================
-
-// loadConfigForDown loads the ork.yml file
-func loadConfigForDown() (*config.Config, error) {
-	cfg, err := config.Load()
-	if err != nil {
-		return nil, utils.ConfigError(
-			"down.load",
-			"Failed to load configuration",
-			"Make sure ork.yml exists in the current directory",
-			err,
-		)
+	// Remove named volumes even when nothing was running, so 'down --volumes' always leaves a clean slate
+	if removeVolumes {
+		removeNamedVolumes(ctx, dockerClient, cfg.Project, namedVolumesFor(cfg.Services, serviceNames))
 	}
-	return cfg, nil
+
+	printDownSummary(len(containersToStop), failed)
+	return nil
 }
 
 // ============================================================================
@@ -163,6 +192,38 @@ func createDockerClientForDown() (*docker.Client, error) {
 	return client, nil
 }
 
+// ============================================================================
+// Private Helpers - Flags
+// ============================================================================
+
+// stopTimeoutOverride returns the --timeout flag value, or nil when it wasn't given
+// so each service falls back to its own stop_timeout
+func stopTimeoutOverride(cmd *cobra.Command) (*time.Duration, error) {
+	if !cmd.Flags().Changed("timeout") {
+		return nil, nil
+	}
+
+	timeout, _ := cmd.Flags().GetDuration("timeout")
+	if timeout < 0 {
+		return nil, utils.ConfigError(
+			"flags.timeout",
+			fmt.Sprintf("Invalid --timeout %s", timeout),
+			"Use a non-negative duration like 30s (0s kills containers immediately)",
+			nil,
+		)
+	}
+	return &timeout, nil
+}
+
+// resolveStopOptions returns how to stop a service's containers
+// A non-nil stopTimeout (the --timeout flag) wins over the service's stop_timeout and skips its stop_grace_period
+func resolveStopOptions(stopTimeout *time.Duration, service config.Service) docker.StopOptions {
+	return docker.StopOptions{
+		Timeout:     config.ResolveStopTimeout(stopTimeout, service),
+		GracePeriod: config.ResolveStopGracePeriod(stopTimeout, service),
+	}
+}
+
 // ============================================================================
 // Private Helpers - Filtering
 // ============================================================================
@@ -184,8 +245,7 @@ func filterContainersByService(containers []docker.ContainerInfo, serviceNames [
 	// Filter containers
 	filtered := make([]docker.ContainerInfo, 0)
 	for _, container := range containers {
-		serviceName := container.Labels["ork.service"]
-		if serviceSet[serviceName] {
+		if serviceSet[container.Labels["ork.service"]] {
 			filtered = append(filtered, container)
 		}
 	}
@@ -193,39 +253,230 @@ func filterContainersByService(containers []docker.ContainerInfo, serviceNames [
 	return filtered
 }
 
+// resolveContainerService returns the service a container belongs to
+// Falls back to the "ork-<project>-<service>" container name when the label is missing
+func resolveContainerService(container docker.ContainerInfo, projectName string) string {
+	if name := container.Labels["ork.service"]; name != "" {
+		return name
+	}
+
+	name := strings.TrimPrefix(container.Name, "/")
+	prefix := fmt.Sprintf("ork-%s-", projectName)
+	if strings.HasPrefix(name, prefix) && len(name) > len(prefix) {
+		return strings.TrimPrefix(name, prefix)
+	}
+	return name
+}
+
+// namedVolumesFor collects the named volumes of the given services (all services if none given)
+// Each volume appears once, in sorted order
+func namedVolumesFor(services map[string]config.Service, serviceNames []string) []string {
+	if len(serviceNames) == 0 {
+		for name := range services {
+			serviceNames = append(serviceNames, name)
+		}
+	}
+
+	seen := make(map[string]bool)
+	var volumes []string
+	for _, name := range serviceNames {
+		for _, volume := range services[name].NamedVolumes() {
+			if !seen[volume] {
+				seen[volume] = true
+				volumes = append(volumes, volume)
+			}
+		}
+	}
+
+	sort.Strings(volumes)
+	return volumes
+}
+
+// ============================================================================
+// Private Helpers - Ordering
+// ============================================================================
+
+// orderContainersForShutdown sorts containers so dependents stop before their dependencies
+// Containers for services no longer in ork.yml go first, since nothing in the config depends on them
+func orderContainersForShutdown(containers []docker.ContainerInfo, services map[string]config.Service, projectName string) []docker.ContainerInfo {
+	rank := shutdownRanks(services)
+
+	ordered := append([]docker.ContainerInfo(nil), containers...)
+	sort.SliceStable(ordered, func(i, j int) bool {
+		a := resolveContainerService(ordered[i], projectName)
+		b := resolveContainerService(ordered[j], projectName)
+
+		rankA, knownA := rank[a]
+		rankB, knownB := rank[b]
+		if knownA != knownB {
+			return !knownA
+		}
+		if rankA != rankB {
+			return rankA < rankB
+		}
+		return a < b
+	})
+
+	return ordered
+}
+
+// shutdownRanks maps each configured service to its position in the stop order (reverse start order)
+// If the dependency graph can't be resolved (e.g. a cycle), services are ranked by name instead
+func shutdownRanks(services map[string]config.Service) map[string]int {
+	names := make([]string, 0, len(services))
+	for name := range services {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	rank := make(map[string]int, len(names))
+	startOrder, err := service.ResolveDependencies(services, names)
+	if err != nil {
+		for i, name := range names {
+			rank[name] = i
+		}
+		return rank
+	}
+
+	for i, name := range startOrder {
+		rank[name] = len(startOrder) - 1 - i
+	}
+	return rank
+}
+
 // ============================================================================
 // Private Helpers - Stopping
 // ============================================================================
 
-// stopContainers stops (and optionally removes) the given containers
-func stopContainers(ctx context.Context, client *docker.Client, containers []docker.ContainerInfo, keepContainers bool) error {
-	for _, container := range containers {
-		serviceName := container.Labels["ork.service"]
-
-		if keepContainers {
-			// Just stop the container
-			spinner := ui.ShowSpinner(fmt.Sprintf("Stopping %s", ui.Bold(serviceName)))
-			if err := client.Stop(ctx, container.ID); err != nil {
-				spinner.Warning(fmt.Sprintf("Failed to stop %s: %v", serviceName, err))
-				continue
+// stopContainersByLevel stops (and optionally removes) the given containers, dependents first
+// Containers of services no longer in ork.yml go first, then the rest through the orchestrator's
+// reverse-level stop, announcing each level as it begins, like 'ork up' does on the way up
+// Returns the services whose containers failed to stop, sorted
+func stopContainersByLevel(ctx context.Context, client *docker.Client, containers []docker.ContainerInfo, cfg *config.Config, keepContainers bool, stopTimeout *time.Duration) []string {
+	var mu sync.Mutex // Protects failed, since a level is stopped in parallel
+	var failed []string
+
+	// Split off containers of services no longer in ork.yml, which nothing in the config depends on
+	ordered := orderContainersForShutdown(containers, cfg.Services, cfg.Project)
+	byService := make(map[string][]docker.ContainerInfo)
+	var configured []string
+	for _, container := range ordered {
+		serviceName := resolveContainerService(container, cfg.Project)
+		if _, ok := cfg.Services[serviceName]; !ok {
+			if err := stopContainer(ctx, client, container, serviceName, keepContainers, resolveStopOptions(stopTimeout, config.Service{})); err != nil {
+				failed = append(failed, serviceName)
 			}
-			spinner.Success(fmt.Sprintf("Stopped %s", ui.Bold(serviceName)))
-		} else {
-			// Stop and remove the container
-			spinner := ui.ShowSpinner(fmt.Sprintf("Stopping %s", ui.Bold(serviceName)))
-			if err := client.StopAndRemove(ctx, container.ID); err != nil {
-				spinner.Warning(fmt.Sprintf("Failed to stop/remove %s: %v", serviceName, err))
-				continue
+			continue
+		}
+		if _, seen := byService[serviceName]; !seen {
+			configured = append(configured, serviceName)
+		}
+		byService[serviceName] = append(byService[serviceName], container)
+	}
+
+	stopService := func(ctx context.Context, serviceName string) error {
+		stopOpts := resolveStopOptions(stopTimeout, cfg.Services[serviceName])
+		var stopErr error
+		for _, container := range byService[serviceName] {
+			if err := stopContainer(ctx, client, container, serviceName, keepContainers, stopOpts); err != nil {
+				stopErr = err
 			}
-			spinner.Success(fmt.Sprintf("Stopped and removed %s", ui.Bold(serviceName)))
+		}
+		if stopErr != nil {
+			mu.Lock()
+			failed = append(failed, serviceName)
+			mu.Unlock()
+		}
+		return stopErr
+	}
+
+	orchestrator := service.NewOrchestrator(cfg.Project, client, "")
+	_, err := orchestrator.StopInReverseLevels(ctx, configured, cfg.Services, stopService, func(level int, serviceNames []string) {
+		ui.Subheader(fmt.Sprintf("Stopping level %d: %s", level, ui.Dim(fmt.Sprintf("%v", serviceNames))))
+	})
+	if err != nil {
+		// The dependency graph can't be leveled (e.g. a cycle in an unvalidated ork.yml) - stop one by one
+		ui.Warning(fmt.Sprintf("Stopping services one by one: %v", err))
+		for _, serviceName := range configured {
+			_ = stopService(ctx, serviceName)
 		}
 	}
 
+	sort.Strings(failed)
+	return failed
+}
+
+// stopContainer stops (and unless keepContainers, removes) one container with a spinner
+func stopContainer(ctx context.Context, client *docker.Client, container docker.ContainerInfo, serviceName string, keepContainers bool, stopOpts docker.StopOptions) error {
+	spinner := ui.ShowSpinner(fmt.Sprintf("Stopping %s", ui.Bold(serviceName)))
+
+	if keepContainers {
+		// Just stop the container
+		if err := client.Stop(ctx, container.ID, stopOpts); err != nil {
+			spinner.Warning(fmt.Sprintf("Failed to stop %s: %v", serviceName, err))
+			return err
+		}
+		spinner.Success(fmt.Sprintf("Stopped %s", ui.Bold(serviceName)))
+		return nil
+	}
+
+	// Stop and remove the container
+	if err := client.StopAndRemove(ctx, container.ID, stopOpts); err != nil {
+		spinner.Warning(fmt.Sprintf("Failed to stop/remove %s: %v", serviceName, err))
+		return err
+	}
+	spinner.Success(fmt.Sprintf("Stopped and removed %s", ui.Bold(serviceName)))
 	return nil
 }
 
+// printDownSummary reports how many of the stopped services went down, naming any that didn't
+func printDownSummary(total int, failed []string) {
+	if total == 0 {
+		return
+	}
+
+	ui.EmptyLine()
+	if len(failed) > 0 {
+		ui.Warning(fmt.Sprintf("Stopped %d of %d service(s) - failed: %s", total-len(failed), total, strings.Join(failed, ", ")))
+		ui.Hint("Check 'ork ps' and retry 'ork down', or see 'docker ps' for the remaining containers")
+		return
+	}
+	ui.SuccessBox(fmt.Sprintf("Successfully stopped %d service(s)", total))
+}
+
+// removeProjectNetwork removes the project network (a missing network is not an error)
+func removeProjectNetwork(ctx context.Context, client *docker.Client, projectName string) {
+	spinner := ui.ShowSpinner("Cleaning up project network...")
+	if err := client.DeleteNetwork(ctx, projectName); err != nil {
+		spinner.Warning(fmt.Sprintf("Failed to remove network: %v", err))
+		return
+	}
+	spinner.Success(fmt.Sprintf("Removed network: ork-%s-network", projectName))
+}
+
+// removeNamedVolumes removes the given named volumes, warning (not failing) on errors
+// Named volumes are host-wide, so only volumes labeled with this project are removed; a volume
+// of the same name from another project (or created outside Ork) is left alone
+func removeNamedVolumes(ctx context.Context, client *docker.Client, projectName string, volumes []string) {
+	for _, volume := range volumes {
+		spinner := ui.ShowSpinner(fmt.Sprintf("Removing volume %s", ui.Bold(volume)))
+		removed, err := client.RemoveProjectVolume(ctx, volume, projectName)
+		if err != nil {
+			spinner.Warning(fmt.Sprintf("Failed to remove volume %s: %v", volume, err))
+			continue
+		}
+		if !removed {
+			spinner.Warning(fmt.Sprintf("Kept volume %s: it wasn't created by Ork for project %s", volume, projectName))
+			continue
+		}
+		spinner.Success(fmt.Sprintf("Removed volume %s", ui.Bold(volume)))
+	}
+}
+
 // handleDownError formats and displays errors with hints
 func handleDownError(err error) {
+	commandErr = err
+
 	if orkErr, ok := err.(*utils.OrkError); ok {
 		// Display structured error with hints
 		ui.Error(orkErr.Message)
@@ -0,0 +1,457 @@
+package cli
+
+import (
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ork-cli/ork/internal/config"
+	"github.com/ork-cli/ork/internal/docker"
+	"github.com/ork-cli/ork/internal/docker/dockertest"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const downTestConfig = `version: "1.0"
+project: shop
+services:
+  postgres:
+    image: postgres:15
+    volumes:
+      - pgdata:/var/lib/postgresql/data
+  api:
+    image: node:18
+    depends_on: [postgres]
+  frontend:
+    image: node:18
+    depends_on: [api]
+`
+
+// ============================================================================
+// Container Resolution Tests
+// ============================================================================
+
+func TestResolveContainerService(t *testing.T) {
+	tests := []struct {
+		name      string
+		container docker.ContainerInfo
+		expected  string
+	}{
+		{
+			name:      "from label",
+			container: docker.ContainerInfo{Name: "/something-else", Labels: map[string]string{"ork.service": "api"}},
+			expected:  "api",
+		},
+		{
+			name:      "from container name",
+			container: docker.ContainerInfo{Name: "/ork-shop-postgres"},
+			expected:  "postgres",
+		},
+		{
+			name:      "service name with dashes",
+			container: docker.ContainerInfo{Name: "ork-shop-payment-worker"},
+			expected:  "payment-worker",
+		},
+		{
+			name:      "foreign name is returned as-is",
+			container: docker.ContainerInfo{Name: "/ork-other-api"},
+			expected:  "ork-other-api",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, resolveContainerService(tt.container, "shop"))
+		})
+	}
+}
+
+func TestNamedVolumesFor(t *testing.T) {
+	services := map[string]config.Service{
+		"postgres": {Volumes: []string{"pgdata:/var/lib/postgresql/data", "./init:/init:ro"}},
+		"backup":   {Volumes: []string{"pgdata:/backup/source:ro", "archive:/archive"}},
+		"api":      {},
+	}
+
+	assert.Equal(t, []string{"archive", "pgdata"}, namedVolumesFor(services, nil))
+	assert.Equal(t, []string{"pgdata"}, namedVolumesFor(services, []string{"postgres"}))
+	assert.Empty(t, namedVolumesFor(services, []string{"api"}))
+}
+
+// ============================================================================
+// Shutdown Ordering Tests
+// ============================================================================
+
+func downTestContainer(service string) docker.ContainerInfo {
+	return docker.ContainerInfo{
+		ID:     service + "-id",
+		Name:   "/ork-shop-" + service,
+		Labels: map[string]string{"ork.service": service},
+	}
+}
+
+func containerServices(containers []docker.ContainerInfo) []string {
+	names := make([]string, 0, len(containers))
+	for _, c := range containers {
+		names = append(names, resolveContainerService(c, "shop"))
+	}
+	return names
+}
+
+func TestOrderContainersForShutdown_ReverseDependencyOrder(t *testing.T) {
+	services := map[string]config.Service{
+		"postgres": {Image: "postgres:15"},
+		"redis":    {Image: "redis:7"},
+		"api":      {Image: "node:18", DependsOn: config.DependsOnServices("postgres", "redis")},
+		"frontend": {Image: "node:18", DependsOn: config.DependsOnServices("api")},
+	}
+	containers := []docker.ContainerInfo{
+		downTestContainer("postgres"),
+		downTestContainer("api"),
+		downTestContainer("redis"),
+		downTestContainer("frontend"),
+	}
+
+	ordered := orderContainersForShutdown(containers, services, "shop")
+	names := containerServices(ordered)
+
+	require.Len(t, names, 4)
+	assert.Equal(t, "frontend", names[0])
+	assert.Equal(t, "api", names[1])
+	assert.ElementsMatch(t, []string{"postgres", "redis"}, names[2:])
+}
+
+func TestOrderContainersForShutdown_UnknownServicesFirst(t *testing.T) {
+	services := map[string]config.Service{
+		"postgres": {Image: "postgres:15"},
+		"api":      {Image: "node:18", DependsOn: config.DependsOnServices("postgres")},
+	}
+	containers := []docker.ContainerInfo{
+		downTestContainer("postgres"),
+		downTestContainer("legacy"),
+		downTestContainer("api"),
+	}
+
+	ordered := orderContainersForShutdown(containers, services, "shop")
+
+	assert.Equal(t, []string{"legacy", "api", "postgres"}, containerServices(ordered))
+}
+
+func TestOrderContainersForShutdown_CycleFallsBackToName(t *testing.T) {
+	services := map[string]config.Service{
+		"a": {DependsOn: config.DependsOnServices("b")},
+		"b": {DependsOn: config.DependsOnServices("a")},
+	}
+	containers := []docker.ContainerInfo{downTestContainer("b"), downTestContainer("a")}
+
+	ordered := orderContainersForShutdown(containers, services, "shop")
+
+	assert.Equal(t, []string{"a", "b"}, containerServices(ordered))
+}
+
+// ============================================================================
+// Command Tests
+// ============================================================================
+
+func TestRunDown_StopsInReverseDependencyOrder(t *testing.T) {
+	writeTestConfig(t, downTestConfig)
+	fake, _ := dockertest.NewServer(t)
+	fake.AddContainer("postgres0000", "shop", "postgres", "Up 5 minutes")
+	fake.AddContainer("api000000000", "shop", "api", "Up 5 minutes")
+	fake.AddContainer("frontend0000", "shop", "frontend", "Up 5 minutes")
+
+	captureStdout(t, func() {
+		require.NoError(t, runDown(nil, nil, false, false, nil))
+	})
+
+	var stops []string
+	for _, request := range fake.Mutations() {
+		if strings.HasSuffix(request, "/stop") {
+			stops = append(stops, request)
+		}
+	}
+	require.Len(t, stops, 3)
+	assert.Contains(t, stops[0], "frontend0000")
+	assert.Contains(t, stops[1], "api000000000")
+	assert.Contains(t, stops[2], "postgres0000")
+}
+
+func TestRunDown_ReportsTeardownLevels(t *testing.T) {
+	writeTestConfig(t, downTestConfig)
+	fake, _ := dockertest.NewServer(t)
+	fake.AddContainer("postgres0000", "shop", "postgres", "Up 5 minutes")
+	fake.AddContainer("api000000000", "shop", "api", "Up 5 minutes")
+	fake.AddContainer("frontend0000", "shop", "frontend", "Up 5 minutes")
+
+	out := captureStdout(t, func() {
+		require.NoError(t, runDown(nil, nil, false, false, nil))
+	})
+
+	level3 := strings.Index(out, "Stopping level 3: [frontend]")
+	level2 := strings.Index(out, "Stopping level 2: [api]")
+	level1 := strings.Index(out, "Stopping level 1: [postgres]")
+	require.NotEqual(t, -1, level3, out)
+	require.NotEqual(t, -1, level2, out)
+	require.NotEqual(t, -1, level1, out)
+	assert.Less(t, level3, level2)
+	assert.Less(t, level2, level1)
+	assert.Contains(t, out, "Successfully stopped 3 service(s)")
+}
+
+func TestRunDown_SummaryNamesFailedServices(t *testing.T) {
+	writeTestConfig(t, downTestConfig)
+	fake, _ := dockertest.NewServer(t)
+	fake.AddContainer("postgres0000", "shop", "postgres", "Up 5 minutes")
+	fake.AddContainer("api000000000", "shop", "api", "Up 5 minutes")
+	fake.Handle(http.MethodPost, "/containers/api000000000/stop", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+		_, _ = w.Write([]byte(`{"message":"boom"}`))
+	})
+
+	out := captureStdout(t, func() {
+		require.NoError(t, runDown(nil, nil, false, false, nil))
+	})
+
+	assert.Contains(t, out, "Stopped 1 of 2 service(s) - failed: api")
+	assert.NotContains(t, out, "Successfully stopped")
+	assert.True(t, fake.HasRequest("POST /containers/postgres0000/stop"), "a failure must not block lower levels")
+}
+
+func TestRunDown_NothingRunningIsNotAnError(t *testing.T) {
+	writeTestConfig(t, downTestConfig)
+	fake, _ := dockertest.NewServer(t)
+
+	captureStdout(t, func() {
+		require.NoError(t, runDown(nil, nil, false, false, nil))
+	})
+
+	assert.Empty(t, fake.Mutations())
+}
+
+func TestRunDown_RemovesVolumes(t *testing.T) {
+	writeTestConfig(t, downTestConfig)
+	fake, _ := dockertest.NewServer(t)
+	fake.AddContainer("postgres0000", "shop", "postgres", "Up 5 minutes")
+	fake.AddVolume("pgdata", map[string]string{"ork.project": "shop"})
+
+	captureStdout(t, func() {
+		require.NoError(t, runDown(nil, nil, false, true, nil))
+	})
+
+	assert.True(t, fake.HasRequest("DELETE /volumes/pgdata"))
+	assert.False(t, fake.HasVolume("pgdata"))
+}
+
+func TestRunDown_KeepsAnotherProjectsVolume(t *testing.T) {
+	writeTestConfig(t, downTestConfig)
+	fake, _ := dockertest.NewServer(t)
+	fake.AddVolume("pgdata", map[string]string{"ork.project": "blog"})
+
+	out := captureStdout(t, func() {
+		require.NoError(t, runDown(nil, nil, false, true, nil))
+	})
+
+	assert.False(t, fake.HasRequest("DELETE /volumes/pgdata"), "another project's volume of the same name must survive")
+	assert.True(t, fake.HasVolume("pgdata"))
+	assert.Contains(t, out, "Kept volume pgdata")
+}
+
+func TestRunDown_KeepsUnlabeledVolume(t *testing.T) {
+	writeTestConfig(t, downTestConfig)
+	fake, _ := dockertest.NewServer(t)
+	fake.AddVolume("pgdata", nil)
+
+	captureStdout(t, func() {
+		require.NoError(t, runDown(nil, nil, false, true, nil))
+	})
+
+	assert.True(t, fake.HasVolume("pgdata"), "a volume Ork didn't create is never removed")
+}
+
+func TestRunDown_MissingVolumeIsNotAnError(t *testing.T) {
+	writeTestConfig(t, downTestConfig)
+	dockertest.NewServer(t)
+
+	captureStdout(t, func() {
+		require.NoError(t, runDown(nil, nil, false, true, nil))
+	})
+}
+
+func TestRunDown_ProfileStopsOnlyItsServices(t *testing.T) {
+	writeTestConfig(t, `version: "1.0"
+project: shop
+services:
+  api:
+    image: node:18
+  debugger:
+    image: delve:latest
+    profiles: [debug]
+`)
+	fake, _ := dockertest.NewServer(t)
+	fake.AddContainer("api000000000", "shop", "api", "Up 5 minutes")
+	fake.AddContainer("debugger0000", "shop", "debugger", "Up 5 minutes")
+
+	captureStdout(t, func() {
+		require.NoError(t, runDown(nil, []string{"debug"}, false, false, nil))
+	})
+
+	assert.True(t, fake.HasRequest("POST /containers/debugger0000/stop"))
+	assert.False(t, fake.HasRequest("POST /containers/api000000000/stop"))
+}
+
+func TestRunDown_VolumesWithKeepRejected(t *testing.T) {
+	err := runDown(nil, nil, true, true, nil)
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "--keep")
+}
+
+func TestRunDown_UsesServiceStopTimeout(t *testing.T) {
+	writeTestConfig(t, stopTimeoutTestConfig)
+	fake, _ := dockertest.NewServer(t)
+	fake.AddContainer("queue0000000", "shop", "queue", "Up 5 minutes")
+	fake.AddContainer("api000000000", "shop", "api", "Up 5 minutes")
+	timeouts := recordStopTimeouts(fake)
+
+	captureStdout(t, func() {
+		require.NoError(t, runDown(nil, nil, false, false, nil))
+	})
+
+	assert.Equal(t, map[string]string{"queue0000000": "60", "api000000000": "10"}, timeouts())
+}
+
+func TestRunDown_TimeoutFlagOverridesServices(t *testing.T) {
+	writeTestConfig(t, stopTimeoutTestConfig)
+	fake, _ := dockertest.NewServer(t)
+	fake.AddContainer("queue0000000", "shop", "queue", "Up 5 minutes")
+	fake.AddContainer("api000000000", "shop", "api", "Up 5 minutes")
+	timeouts := recordStopTimeouts(fake)
+
+	override := 2 * time.Second
+	captureStdout(t, func() {
+		require.NoError(t, runDown(nil, nil, false, false, &override))
+	})
+
+	assert.Equal(t, map[string]string{"queue0000000": "2", "api000000000": "2"}, timeouts())
+}
+
+func TestRunDown_GracePeriodBeforeStop(t *testing.T) {
+	writeTestConfig(t, `version: "1.0"
+project: shop
+services:
+  queue:
+    image: rabbitmq:3
+    stop_timeout: 5s
+    stop_grace_period: 1m
+`)
+	fake, _ := dockertest.NewServer(t)
+	fake.AddContainer("queue0000000", "shop", "queue", "Up 5 minutes")
+
+	captureStdout(t, func() {
+		require.NoError(t, runDown(nil, nil, false, false, nil))
+	})
+
+	assert.True(t, fake.HasRequest("POST /containers/queue0000000/kill"), "SIGTERM starts the grace period")
+	assert.False(t, fake.HasRequest("POST /containers/queue0000000/stop"), "exited within the grace period")
+}
+
+func TestResolveStopOptions(t *testing.T) {
+	service := config.Service{StopTimeout: "5s", StopGracePeriod: "1m"}
+
+	t.Run("service config", func(t *testing.T) {
+		assert.Equal(t, docker.StopOptions{Timeout: 5 * time.Second, GracePeriod: time.Minute}, resolveStopOptions(nil, service))
+	})
+
+	t.Run("command timeout wins", func(t *testing.T) {
+		override := 2 * time.Second
+		assert.Equal(t, docker.StopOptions{Timeout: 2 * time.Second}, resolveStopOptions(&override, service))
+	})
+
+	t.Run("defaults", func(t *testing.T) {
+		assert.Equal(t, docker.StopOptions{Timeout: config.DefaultStopTimeout}, resolveStopOptions(nil, config.Service{}))
+	})
+}
+
+// ============================================================================
+// Flag Tests
+// ============================================================================
+
+func TestStopTimeoutOverride(t *testing.T) {
+	newCmd := func() *cobra.Command {
+		cmd := &cobra.Command{}
+		cmd.Flags().Duration("timeout", 0, "")
+		return cmd
+	}
+
+	t.Run("unset", func(t *testing.T) {
+		timeout, err := stopTimeoutOverride(newCmd())
+		require.NoError(t, err)
+		assert.Nil(t, timeout)
+	})
+
+	t.Run("set", func(t *testing.T) {
+		cmd := newCmd()
+		require.NoError(t, cmd.Flags().Set("timeout", "30s"))
+
+		timeout, err := stopTimeoutOverride(cmd)
+		require.NoError(t, err)
+		require.NotNil(t, timeout)
+		assert.Equal(t, 30*time.Second, *timeout)
+	})
+
+	t.Run("zero is an explicit override", func(t *testing.T) {
+		cmd := newCmd()
+		require.NoError(t, cmd.Flags().Set("timeout", "0s"))
+
+		timeout, err := stopTimeoutOverride(cmd)
+		require.NoError(t, err)
+		require.NotNil(t, timeout)
+		assert.Zero(t, *timeout)
+	})
+
+	t.Run("negative", func(t *testing.T) {
+		cmd := newCmd()
+		require.NoError(t, cmd.Flags().Set("timeout", "-1s"))
+
+		_, err := stopTimeoutOverride(cmd)
+		assert.Error(t, err)
+	})
+}
+
+// ============================================================================
+// Test Helpers
+// ============================================================================
+
+const stopTimeoutTestConfig = `version: "1.0"
+project: shop
+services:
+  queue:
+    image: rabbitmq:3
+    stop_timeout: 1m
+  api:
+    image: node:18
+`
+
+// recordStopTimeouts captures the "t" (timeout) query parameter of each container stop request
+func recordStopTimeouts(fake *dockertest.Server) func() map[string]string {
+	var mu sync.Mutex
+	timeouts := make(map[string]string)
+
+	fake.Handle(http.MethodPost, "/containers/", func(w http.ResponseWriter, r *http.Request) {
+		_, rest, _ := strings.Cut(r.URL.Path, "/containers/")
+		id := strings.TrimSuffix(rest, "/stop")
+		mu.Lock()
+		timeouts[id] = r.URL.Query().Get("t")
+		mu.Unlock()
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	return func() map[string]string {
+		mu.Lock()
+		defer mu.Unlock()
+		return timeouts
+	}
+}
@@ -0,0 +1,187 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/docker/docker/api/types/filters"
+	"github.com/ork-cli/ork/internal/docker"
+	"github.com/ork-cli/ork/internal/ui"
+	"github.com/ork-cli/ork/pkg/utils"
+	"github.com/spf13/cobra"
+)
+
+// ============================================================================
+// Cobra Command Definition
+// ============================================================================
+
+var eventsCmd = &cobra.Command{
+	Use:   "events",
+	Short: "Stream container events for Ork services",
+	Long: `
+Stream Docker events for Ork-managed containers as they happen.
+
+Shows when service containers start, exit (with their exit code), and change
+native health status. By default only events for the current project are
+shown; use --all to watch every Ork project on this host (no ork.yml needed).
+
+Press Ctrl+C to stop.`,
+	Example: `
+ork events                   Watch events for the current project
+ork events --all             Watch events for every Ork project`,
+
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		// Get flags
+		allProjects, _ := cmd.Flags().GetBool("all")
+
+		if err := runEvents(allProjects); err != nil {
+			handlePSError(err)
+			return
+		}
+	},
+}
+
+func init() {
+	// Register the 'events' command with the root command
+	rootCmd.AddCommand(eventsCmd)
+
+	// Add flags
+	eventsCmd.Flags().BoolP("all", "a", false, "Show events for every Ork project, not just the current one")
+}
+
+// ============================================================================
+// Main Orchestrator
+// ============================================================================
+
+// runEvents streams container events for the current project (or all projects) until interrupted
+func runEvents(allProjects bool) error {
+	projectName := ""
+	prefixWidth := 0
+	if !allProjects {
+		cfg, err := loadConfigUnvalidated()
+		if err != nil {
+			return err
+		}
+		projectName = cfg.Project
+		for name := range cfg.Services {
+			prefixWidth = maxInt(prefixWidth, len(name))
+		}
+	}
+
+	dockerClient, err := docker.NewClient()
+	if err != nil {
+		return utils.DockerError(
+			"events.docker",
+			"Failed to connect to Docker",
+			"Make sure Docker is running with 'docker ps' or run 'ork doctor'",
+			err,
+		)
+	}
+	defer func() {
+		if closeErr := dockerClient.Close(); closeErr != nil {
+			ui.Warning(fmt.Sprintf("Failed to close Docker client: %v", closeErr))
+		}
+	}()
+
+	if allProjects {
+		ui.Info("Watching events for all Ork projects (Ctrl+C to stop)")
+	} else {
+		ui.Info(fmt.Sprintf("Watching events for project %s (Ctrl+C to stop)", ui.Bold(projectName)))
+	}
+
+	filterArgs := docker.BuildEventFilters(projectName)
+	return streamEvents(context.Background(), dockerClient, filterArgs, os.Stdout, func(event docker.Event) string {
+		return formatEventLine(event, prefixWidth, allProjects)
+	})
+}
+
+// ============================================================================
+// Private Helpers - Streaming
+// ============================================================================
+
+// streamEvents writes one formatted line per event until the stream ends
+func streamEvents(ctx context.Context, client *docker.Client, filterArgs filters.Args, w io.Writer, format func(docker.Event) string) error {
+	events, errs := client.Events(ctx, filterArgs)
+	for event := range events {
+		if _, err := fmt.Fprintln(w, format(event)); err != nil {
+			return err
+		}
+	}
+
+	select {
+	case err := <-errs:
+		return utils.DockerError(
+			"events.stream",
+			"Lost connection to the Docker event stream",
+			"Check that Docker is still running, then run 'ork events' again",
+			err,
+		)
+	default:
+		return nil
+	}
+}
+
+// ============================================================================
+// Private Helpers - Display
+// ============================================================================
+
+// formatEventLine renders an event as "15:04:05 [service] description"
+// With showProject, the tag includes the project ("shop/api") to tell projects apart
+func formatEventLine(event docker.Event, prefixWidth int, showProject bool) string {
+	name := eventServiceName(event)
+	if showProject && event.Attributes["ork.project"] != "" {
+		name = event.Attributes["ork.project"] + "/" + name
+	}
+
+	return fmt.Sprintf("%s %s%s",
+		ui.Dim(event.Time.Local().Format("15:04:05")),
+		ui.FormatLogPrefix(name, prefixWidth),
+		describeEvent(event))
+}
+
+// eventServiceName returns the service an event is about, falling back to the container name or ID
+func eventServiceName(event docker.Event) string {
+	if service := event.Attributes["ork.service"]; service != "" {
+		return service
+	}
+	if name := event.Attributes["name"]; name != "" {
+		return name
+	}
+	if len(event.ContainerID) > 12 {
+		return event.ContainerID[:12]
+	}
+	return event.ContainerID
+}
+
+// describeEvent turns an event action into a short, colored description
+func describeEvent(event docker.Event) string {
+	switch {
+	case event.Action == docker.EventStart:
+		return ui.StatusRunning("started")
+
+	case event.Action == docker.EventDie:
+		exitCode := event.Attributes["exitCode"]
+		if exitCode == "" || exitCode == "0" {
+			return ui.StatusStopped("exited (code 0)")
+		}
+		return ui.StatusFailed(fmt.Sprintf("died (exit code %s)", exitCode))
+
+	case strings.HasPrefix(event.Action, docker.EventHealthStatus):
+		status := strings.TrimSpace(strings.TrimPrefix(event.Action, docker.EventHealthStatus+":"))
+		switch status {
+		case "healthy":
+			return ui.StatusRunning("health: healthy")
+		case "unhealthy":
+			return ui.StatusFailed("health: unhealthy")
+		default:
+			return ui.StatusStarting("health: " + status)
+		}
+
+	default:
+		return event.Action
+	}
+}
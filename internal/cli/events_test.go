@@ -0,0 +1,89 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/ork-cli/ork/internal/docker"
+	"github.com/ork-cli/ork/internal/docker/dockertest"
+	"github.com/ork-cli/ork/internal/ui"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// ============================================================================
+// Event Formatting Tests
+// ============================================================================
+
+func TestFormatEventLine(t *testing.T) {
+	at := time.Date(2026, 3, 1, 10, 4, 5, 0, time.Local)
+	labels := map[string]string{"ork.project": "shop", "ork.service": "api", "name": "ork-shop-api"}
+
+	tests := []struct {
+		name     string
+		action   string
+		extra    map[string]string
+		expected string
+	}{
+		{"start", "start", nil, "10:04:05 [api] " + ui.StatusRunning("started")},
+		{"clean exit", "die", map[string]string{"exitCode": "0"}, "10:04:05 [api] " + ui.StatusStopped("exited (code 0)")},
+		{"crash", "die", map[string]string{"exitCode": "137"}, "10:04:05 [api] " + ui.StatusFailed("died (exit code 137)")},
+		{"healthy", "health_status: healthy", nil, "10:04:05 [api] " + ui.StatusRunning("health: healthy")},
+		{"unhealthy", "health_status: unhealthy", nil, "10:04:05 [api] " + ui.StatusFailed("health: unhealthy")},
+		{"starting", "health_status: starting", nil, "10:04:05 [api] " + ui.StatusStarting("health: starting")},
+		{"other action", "oom", nil, "10:04:05 [api] oom"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			attrs := map[string]string{}
+			for k, v := range labels {
+				attrs[k] = v
+			}
+			for k, v := range tt.extra {
+				attrs[k] = v
+			}
+
+			line := formatEventLine(docker.Event{Time: at, Action: tt.action, Attributes: attrs}, 0, false)
+			assert.Equal(t, tt.expected, line)
+		})
+	}
+}
+
+func TestFormatEventLine_AlignsAndShowsProject(t *testing.T) {
+	at := time.Date(2026, 3, 1, 10, 4, 5, 0, time.Local)
+	event := docker.Event{Time: at, Action: "start", Attributes: map[string]string{"ork.project": "shop", "ork.service": "db"}}
+
+	started := ui.StatusRunning("started")
+	assert.Equal(t, "10:04:05 [db]       "+started, formatEventLine(event, 8, false))
+	assert.Equal(t, "10:04:05 [shop/db] "+started, formatEventLine(event, 0, true))
+}
+
+func TestEventServiceName_Fallbacks(t *testing.T) {
+	assert.Equal(t, "ork-shop-api", eventServiceName(docker.Event{Attributes: map[string]string{"name": "ork-shop-api"}}))
+	assert.Equal(t, "0123456789ab", eventServiceName(docker.Event{ContainerID: "0123456789abcdef"}))
+}
+
+// ============================================================================
+// Event Streaming Tests
+// ============================================================================
+
+func TestStreamEvents_WritesOneLinePerEvent(t *testing.T) {
+	fake, client := dockertest.NewServer(t)
+	fake.Handle(http.MethodGet, "/events", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"Type":"container","Action":"start","Actor":{"ID":"abc","Attributes":{"ork.service":"api"}},"time":1772359200}
+{"Type":"container","Action":"die","Actor":{"ID":"abc","Attributes":{"ork.service":"api","exitCode":"2"}},"time":1772359260}
+`))
+	})
+
+	var out bytes.Buffer
+	err := streamEvents(context.Background(), client, docker.BuildEventFilters("shop"), &out, func(event docker.Event) string {
+		return eventServiceName(event) + " " + event.Action
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "api start\napi die\n", out.String())
+}
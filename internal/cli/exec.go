@@ -0,0 +1,204 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/moby/term"
+	"github.com/ork-cli/ork/internal/docker"
+	"github.com/ork-cli/ork/internal/ui"
+	"github.com/ork-cli/ork/pkg/utils"
+	"github.com/spf13/cobra"
+)
+
+// ============================================================================
+// Constants
+// ============================================================================
+
+// defaultExecCommand is run when no command is given (e.g. 'ork exec api')
+var defaultExecCommand = []string{"sh"}
+
+// ============================================================================
+// Cobra Command Definition
+// ============================================================================
+
+var execCmd = &cobra.Command{
+	Use:   "exec <service> [command...]",
+	Short: "Run a command inside a running service",
+	Long: `
+Run a one-off command inside a running service container.
+
+Defaults to an interactive shell (sh) when no command is given. A TTY is
+allocated automatically when ork is run from a terminal; use --no-tty to
+disable it (e.g. when piping output).`,
+	Example: `
+ork exec api                 Open a shell in the api service
+ork exec api ls -la /app     Run a command in the api service
+ork exec -T db pg_dump app   Run without a TTY (for piping output)`,
+
+	Args: cobra.MinimumNArgs(1), // Require at least the service name
+	Run: func(cmd *cobra.Command, args []string) {
+		serviceName, command := parseExecArgs(args)
+
+		// Get flags
+		noTTY, _ := cmd.Flags().GetBool("no-tty")
+
+		if err := runExec(serviceName, command, !noTTY); err != nil {
+			handleExecError(err)
+			return
+		}
+	},
+}
+
+func init() {
+	// Register the 'exec' command with the root command
+	rootCmd.AddCommand(execCmd)
+
+	// Stop parsing flags at the service name so the command's own flags pass through
+	execCmd.Flags().SetInterspersed(false)
+
+	// Add flags
+	execCmd.Flags().BoolP("no-tty", "T", false, "Disable pseudo-TTY allocation")
+}
+
+// ============================================================================
+// Main Orchestrator
+// ============================================================================
+
+// runExec runs a command inside the container of a running service
+// A command that exits non-zero returns a *docker.ExecExitError, so ork exits with the same code
+func runExec(serviceName string, command []string, allowTTY bool) error {
+	// Load configuration to get the project name
+	cfg, err := loadAndValidateConfig()
+	if err != nil {
+		return err
+	}
+
+	// Create a Docker client
+	dockerClient, err := docker.NewClient()
+	if err != nil {
+		return utils.DockerError(
+			"exec.client",
+			"Failed to connect to Docker",
+			"Make sure Docker is running",
+			err,
+		)
+	}
+	defer func() {
+		if closeErr := dockerClient.Close(); closeErr != nil {
+			fmt.Printf("❌ Error closing Docker client: %v\n", closeErr)
+		}
+	}()
+
+	// Resolve the service to its running container
+	ctx := context.Background()
+	containers, err := dockerClient.List(ctx, cfg.Project)
+	if err != nil {
+		return utils.DockerError(
+			"exec.list",
+			"Failed to list containers",
+			"Try running 'ork doctor' to diagnose issues",
+			err,
+		)
+	}
+
+	containerID, err := resolveRunningContainer(containers, serviceName, "exec.resolve")
+	if err != nil {
+		return err
+	}
+
+	// Only allocate a TTY when we're actually attached to a terminal
+	_, stdinIsTerminal := term.GetFdInfo(os.Stdin)
+	opts := docker.ExecOptions{
+		Stdin:  os.Stdin,
+		Stdout: os.Stdout,
+		Stderr: os.Stderr,
+		TTY:    allowTTY && stdinIsTerminal,
+	}
+
+	if err := dockerClient.Exec(ctx, containerID, command, opts); err != nil {
+		// The command ran and failed: its own output already explains why, so pass its exit code on
+		var exitErr *docker.ExecExitError
+		if errors.As(err, &exitErr) {
+			return exitErr
+		}
+		return utils.ServiceError(
+			"exec.run",
+			fmt.Sprintf("Command failed in service '%s'", serviceName),
+			fmt.Sprintf("Check the command with 'ork logs %s'", serviceName),
+			err,
+		)
+	}
+
+	return nil
+}
+
+// ============================================================================
+// Private Helpers
+// ============================================================================
+
+// parseExecArgs splits the arguments into a service name and the command to run
+func parseExecArgs(args []string) (string, []string) {
+	if len(args) < 2 {
+		return args[0], defaultExecCommand
+	}
+	return args[0], args[1:]
+}
+
+// resolveRunningContainer finds the running container for a service by its ork.service label
+// op names the calling operation in the error (e.g., "exec.resolve")
+func resolveRunningContainer(containers []docker.ContainerInfo, serviceName, op string) (string, error) {
+	for _, container := range containers {
+		if container.Labels["ork.service"] != serviceName {
+			continue
+		}
+		if !strings.HasPrefix(container.Status, "Up") {
+			return "", utils.ServiceError(
+				op,
+				fmt.Sprintf("Service '%s' is not running", serviceName),
+				fmt.Sprintf("Start it with 'ork up %s', or check its state with 'ork ps'", serviceName),
+				nil,
+			)
+		}
+		return container.ID, nil
+	}
+
+	return "", utils.ServiceError(
+		op,
+		fmt.Sprintf("Service '%s' is not running", serviceName),
+		"Use 'ork ps' to see running services",
+		nil,
+	)
+}
+
+// ============================================================================
+// Private Helpers - Error Handling
+// ============================================================================
+
+// handleExecError displays errors in a user-friendly format
+func handleExecError(err error) {
+	commandErr = err
+
+	// The command's own failure isn't an Ork error: only its exit code is passed on
+	var exitErr *docker.ExecExitError
+	if errors.As(err, &exitErr) {
+		return
+	}
+
+	if orkErr, ok := err.(*utils.OrkError); ok {
+		// Display structured error with hints
+		ui.Error(orkErr.Message)
+		if orkErr.Hint != "" {
+			ui.Hint(orkErr.Hint)
+		}
+		if orkErr.Err != nil {
+			ui.List(orkErr.Err.Error())
+		}
+	} else {
+		// Fallback for non-Ork errors
+		ui.Error(fmt.Sprintf("Error: %v", err))
+	}
+}
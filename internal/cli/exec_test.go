@@ -0,0 +1,113 @@
+package cli
+
+import (
+	"testing"
+
+	"github.com/ork-cli/ork/internal/docker"
+	"github.com/ork-cli/ork/pkg/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// ============================================================================
+// Argument Parsing Tests
+// ============================================================================
+
+func TestParseExecArgs_DefaultsToShell(t *testing.T) {
+	service, command := parseExecArgs([]string{"api"})
+
+	assert.Equal(t, "api", service)
+	assert.Equal(t, []string{"sh"}, command)
+}
+
+func TestParseExecArgs_PassesCommandThrough(t *testing.T) {
+	service, command := parseExecArgs([]string{"api", "ls", "-la", "/app"})
+
+	assert.Equal(t, "api", service)
+	assert.Equal(t, []string{"ls", "-la", "/app"}, command)
+}
+
+func TestExecCmd_CommandFlagsAreNotParsed(t *testing.T) {
+	err := execCmd.Flags().Parse([]string{"-T", "api", "ls", "-la"})
+	require.NoError(t, err)
+
+	noTTY, _ := execCmd.Flags().GetBool("no-tty")
+	assert.True(t, noTTY)
+	assert.Equal(t, []string{"api", "ls", "-la"}, execCmd.Flags().Args())
+}
+
+// ============================================================================
+// Container Resolution Tests
+// ============================================================================
+
+func TestResolveRunningContainer_MatchesServiceLabel(t *testing.T) {
+	containers := []docker.ContainerInfo{
+		{ID: "db123", Status: "Up 5 minutes", Labels: map[string]string{"ork.service": "db"}},
+		{ID: "api123", Status: "Up 2 minutes", Labels: map[string]string{"ork.service": "api"}},
+	}
+
+	id, err := resolveRunningContainer(containers, "api", "exec.resolve")
+
+	require.NoError(t, err)
+	assert.Equal(t, "api123", id)
+}
+
+func TestResolveRunningContainer_NotFound(t *testing.T) {
+	containers := []docker.ContainerInfo{
+		{ID: "db123", Status: "Up 5 minutes", Labels: map[string]string{"ork.service": "db"}},
+	}
+
+	_, err := resolveRunningContainer(containers, "api", "exec.resolve")
+
+	require.Error(t, err)
+	orkErr, ok := err.(*utils.OrkError)
+	require.True(t, ok)
+	assert.Equal(t, utils.ErrorService, orkErr.Kind)
+	assert.Contains(t, orkErr.Hint, "ork ps")
+}
+
+func TestResolveRunningContainer_StoppedContainer(t *testing.T) {
+	containers := []docker.ContainerInfo{
+		{ID: "api123", Status: "Exited (1) 3 minutes ago", Labels: map[string]string{"ork.service": "api"}},
+	}
+
+	_, err := resolveRunningContainer(containers, "api", "exec.resolve")
+
+	require.Error(t, err)
+	orkErr, ok := err.(*utils.OrkError)
+	require.True(t, ok)
+	assert.Contains(t, orkErr.Message, "not running")
+	assert.Contains(t, orkErr.Hint, "ork ps")
+}
+
+// ============================================================================
+// Exit Status Tests
+// ============================================================================
+
+func TestHandleExecError_CommandExitCodePassedThrough(t *testing.T) {
+	verbose = true
+	t.Cleanup(func() {
+		verbose = false
+		commandErr = nil
+	})
+
+	var code int
+	out := captureStdout(t, func() {
+		handleExecError(&docker.ExecExitError{Code: 3})
+		code = finishWithError(commandErr)
+	})
+
+	assert.Equal(t, 3, code)
+	assert.Empty(t, out, "the command's own output explains the failure")
+}
+
+func TestHandleExecError_OrkErrorStillShown(t *testing.T) {
+	t.Cleanup(func() { commandErr = nil })
+
+	out := captureStdout(t, func() {
+		handleExecError(utils.ServiceError("exec.resolve", "Service 'api' is not running", "Use 'ork ps' to see running services", nil))
+	})
+
+	assert.Contains(t, out, "Service 'api' is not running")
+	assert.Contains(t, out, "Use 'ork ps' to see running services")
+}
@@ -0,0 +1,194 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/ork-cli/ork/internal/config"
+	"github.com/ork-cli/ork/internal/ui"
+	"github.com/ork-cli/ork/pkg/utils"
+)
+
+// ============================================================================
+// Global Configuration Flags
+// ============================================================================
+
+// Set by the global --file, --project, and --strict flags (see root.go)
+var (
+	configFile      string
+	projectOverride string
+	strictConfig    bool
+)
+
+// ============================================================================
+// Type Definitions
+// ============================================================================
+
+// configLoadOptions controls how a command loads ork.yml
+type configLoadOptions struct {
+	file     string // Explicit config file (empty searches the current directory and its parents)
+	project  string // Override the project name from the config
+	strict   bool   // Reject unknown fields in the config file
+	validate bool   // Validate the config after loading
+
+	allowNoServices bool // Accept a config without services, with a warning (only applies when validating)
+}
+
+// ============================================================================
+// Shared Config Loading
+// ============================================================================
+
+// loadAndValidateConfig loads and validates ork.yml using the global flags
+func loadAndValidateConfig() (*config.Config, error) {
+	opts := globalConfigLoadOptions()
+	opts.validate = true
+	return loadProjectConfig(opts)
+}
+
+// loadConfigForInspection loads and validates ork.yml, tolerating a config without services
+// Used by commands that only read the config (e.g., 'ork config'); up and restart still require services
+func loadConfigForInspection() (*config.Config, error) {
+	opts := globalConfigLoadOptions()
+	opts.validate = true
+	opts.allowNoServices = true
+	return loadProjectConfig(opts)
+}
+
+// loadConfigUnvalidated loads ork.yml using the global flags without validating it
+// Used by read-only commands (ps, logs, down) that only need the project name
+func loadConfigUnvalidated() (*config.Config, error) {
+	return loadProjectConfig(globalConfigLoadOptions())
+}
+
+// globalConfigLoadOptions builds load options from the global flags
+func globalConfigLoadOptions() configLoadOptions {
+	return configLoadOptions{
+		file:    configFile,
+		project: projectOverride,
+		strict:  strictConfig,
+	}
+}
+
+// loadProjectConfig is the single entrypoint commands use to load ork.yml
+func loadProjectConfig(opts configLoadOptions) (*config.Config, error) {
+	cfg, err := config.LoadWithOptions(config.LoadOptions{
+		File:   opts.file,
+		Strict: opts.strict,
+	})
+	if err != nil {
+		hint := "Make sure ork.yml exists in this directory or a parent directory, or pass --file"
+		if opts.file != "" {
+			hint = fmt.Sprintf("Check the --file path '%s'", opts.file)
+		}
+		return nil, utils.ConfigError(
+			"config.load",
+			"Failed to load configuration",
+			hint,
+			err,
+		)
+	}
+
+	if opts.project != "" {
+		cfg.Project = opts.project
+	}
+
+	if !opts.validate {
+		return cfg, nil
+	}
+
+	if err := cfg.ValidateWithOptions(config.ValidateOptions{AllowNoServices: opts.allowNoServices}); err != nil {
+		// Surface a validator-specific hint (e.g. the expected format of a field) when there is one,
+		// along with its details (e.g. the dependency cycle) and any "did you mean" suggestions
+		hint := "Check your ork.yml for errors"
+		var details, suggestions []string
+		var validationErr *utils.OrkError
+		if errors.As(err, &validationErr) {
+			if validationErr.Hint != "" {
+				hint = validationErr.Hint
+			}
+			details = validationErr.Details
+			suggestions = validationErr.Suggestions
+		}
+
+		configErr := utils.ConfigError(
+			"config.validate",
+			"Invalid configuration",
+			hint,
+			err,
+		)
+		configErr.Details = details
+		configErr.Suggestions = suggestions
+		return nil, configErr
+	}
+
+	if len(cfg.Services) == 0 {
+		ui.Warning(fmt.Sprintf("No services defined in project %s", cfg.Project))
+	}
+
+	return cfg, nil
+}
+
+// ============================================================================
+// Private Helpers - Service Validation
+// ============================================================================
+
+// validateServiceNames checks if all requested services exist in the config
+func validateServiceNames(serviceNames []string, cfg *config.Config) error {
+	for _, serviceName := range serviceNames {
+		if _, exists := cfg.Services[serviceName]; !exists {
+			availableServices := getAvailableServicesList(cfg)
+			suggestions := utils.FindSuggestions(serviceName, availableServices, 3)
+
+			err := utils.ErrServiceNotFound(serviceName, suggestions)
+			err.Details = []string{
+				fmt.Sprintf("Available services: %s", ui.Dim(fmt.Sprintf("%v", availableServices))),
+			}
+			return err
+		}
+	}
+	return nil
+}
+
+// getAvailableServicesList returns a slice of available service names
+func getAvailableServicesList(cfg *config.Config) []string {
+	services := make([]string, 0, len(cfg.Services))
+	for name := range cfg.Services {
+		services = append(services, name)
+	}
+	return services
+}
+
+// ============================================================================
+// Private Helpers - Profiles
+// ============================================================================
+
+// addProfileServices appends the services of the given --profile values to the requested services
+// Services already requested by name are kept once, in their original position; an unknown profile is an error
+func addProfileServices(serviceNames, profiles []string, cfg *config.Config) ([]string, error) {
+	if len(profiles) == 0 {
+		return serviceNames, nil
+	}
+
+	declared := cfg.ProfileNames()
+	for _, profile := range profiles {
+		if !slices.Contains(declared, profile) {
+			err := utils.ConfigError(
+				"config.profile",
+				fmt.Sprintf("Profile '%s' not found in configuration", profile),
+				"Run 'ork config --profiles' to list the declared profiles",
+				nil,
+			)
+			err.Suggestions = utils.FindSuggestions(profile, declared, 3)
+			return nil, err
+		}
+	}
+
+	combined := slices.Clone(serviceNames)
+	for _, name := range cfg.ServicesInProfiles(profiles) {
+		if !slices.Contains(combined, name) {
+			combined = append(combined, name)
+		}
+	}
+	return combined, nil
+}
@@ -0,0 +1,188 @@
+package cli
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ork-cli/ork/internal/config"
+	"github.com/ork-cli/ork/pkg/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const loadTestConfig = `version: "1.0"
+project: shop
+services:
+  api:
+    image: node:18
+`
+
+// ============================================================================
+// Config Loading Option Tests
+// ============================================================================
+
+func TestLoadProjectConfig_Defaults(t *testing.T) {
+	writeTestConfig(t, loadTestConfig)
+
+	cfg, err := loadProjectConfig(configLoadOptions{validate: true})
+
+	require.NoError(t, err)
+	assert.Equal(t, "shop", cfg.Project)
+	assert.Contains(t, cfg.Services, "api")
+}
+
+func TestLoadProjectConfig_ExplicitFile(t *testing.T) {
+	t.Chdir(t.TempDir()) // No ork.yml in the working directory
+	path := filepath.Join(t.TempDir(), "staging.yml")
+	require.NoError(t, os.WriteFile(path, []byte(loadTestConfig), 0o644))
+
+	cfg, err := loadProjectConfig(configLoadOptions{file: path})
+
+	require.NoError(t, err)
+	assert.Equal(t, "shop", cfg.Project)
+}
+
+func TestLoadProjectConfig_MissingFile(t *testing.T) {
+	_, err := loadProjectConfig(configLoadOptions{file: filepath.Join(t.TempDir(), "missing.yml")})
+
+	require.Error(t, err)
+	orkErr, ok := err.(*utils.OrkError)
+	require.True(t, ok)
+	assert.Equal(t, utils.ErrorConfig, orkErr.Kind)
+	assert.Contains(t, orkErr.Hint, "--file path")
+	assert.ErrorContains(t, orkErr.Err, "missing.yml not found")
+}
+
+func TestLoadProjectConfig_ProjectOverride(t *testing.T) {
+	writeTestConfig(t, loadTestConfig)
+
+	cfg, err := loadProjectConfig(configLoadOptions{project: "shop-staging", validate: true})
+
+	require.NoError(t, err)
+	assert.Equal(t, "shop-staging", cfg.Project)
+}
+
+func TestLoadProjectConfig_Strict(t *testing.T) {
+	writeTestConfig(t, loadTestConfig+"    imagee: typo\n")
+
+	_, err := loadProjectConfig(configLoadOptions{})
+	assert.NoError(t, err, "unknown fields are ignored by default")
+
+	_, err = loadProjectConfig(configLoadOptions{strict: true})
+	require.Error(t, err)
+	orkErr, ok := err.(*utils.OrkError)
+	require.True(t, ok)
+	assert.Contains(t, orkErr.Err.Error(), "imagee")
+}
+
+func TestLoadProjectConfig_Validate(t *testing.T) {
+	writeTestConfig(t, `version: "1.0"
+project: shop
+services:
+  api: {}
+`)
+
+	_, err := loadProjectConfig(configLoadOptions{})
+	assert.NoError(t, err, "read-only commands skip validation")
+
+	_, err = loadProjectConfig(configLoadOptions{validate: true})
+	require.Error(t, err)
+	orkErr, ok := err.(*utils.OrkError)
+	require.True(t, ok)
+	assert.Equal(t, "Invalid configuration", orkErr.Message)
+}
+
+func TestLoadAndValidateConfig_UsesGlobalFlags(t *testing.T) {
+	writeTestConfig(t, loadTestConfig)
+	projectOverride = "from-flag"
+	t.Cleanup(func() { projectOverride = "" })
+
+	cfg, err := loadAndValidateConfig()
+
+	require.NoError(t, err)
+	assert.Equal(t, "from-flag", cfg.Project)
+}
+
+// ============================================================================
+// Empty Services Tests
+// ============================================================================
+
+const noServicesTestConfig = `version: "1.0"
+project: shop
+services: {}
+`
+
+func TestLoadConfigForInspection_AllowsNoServices(t *testing.T) {
+	writeTestConfig(t, noServicesTestConfig)
+
+	var cfg *config.Config
+	var err error
+	out := captureStdout(t, func() {
+		cfg, err = loadConfigForInspection()
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "shop", cfg.Project)
+	assert.Empty(t, cfg.Services)
+	assert.Contains(t, out, "No services defined in project shop")
+}
+
+func TestLoadAndValidateConfig_RequiresServices(t *testing.T) {
+	writeTestConfig(t, noServicesTestConfig)
+
+	_, err := loadAndValidateConfig()
+
+	require.Error(t, err)
+	assert.Contains(t, errors.Unwrap(err).Error(), "at least one service")
+}
+
+func TestLoadConfigForInspection_StillValidatesServices(t *testing.T) {
+	writeTestConfig(t, `version: "1.0"
+project: shop
+services:
+  api:
+    ports: ["3000:3000"]
+`)
+
+	_, err := loadConfigForInspection()
+
+	require.Error(t, err, "a service without a source is still invalid")
+}
+
+func TestLoadProjectConfig_SurfacesCycleDetails(t *testing.T) {
+	writeTestConfig(t, `version: "1.0"
+project: shop
+services:
+  api:
+    image: node:18
+    depends_on: [web]
+  web:
+    image: nginx
+    depends_on: [api]
+`)
+
+	_, err := loadProjectConfig(configLoadOptions{validate: true})
+
+	var orkErr *utils.OrkError
+	require.True(t, errors.As(err, &orkErr))
+	assert.Equal(t, "Invalid configuration", orkErr.Message)
+	assert.Equal(t, []string{"Dependency cycle: api → web → api"}, orkErr.Details)
+}
+
+func TestLoadProjectConfig_SurfacesImageSuggestions(t *testing.T) {
+	writeTestConfig(t, `version: "1.0"
+project: shop
+services:
+  web:
+    image: "nginx :alpine"
+`)
+
+	_, err := loadProjectConfig(configLoadOptions{validate: true})
+
+	var orkErr *utils.OrkError
+	require.True(t, errors.As(err, &orkErr))
+	assert.Contains(t, orkErr.Hint, "not a valid image reference")
+	assert.Equal(t, []string{"nginx:alpine"}, orkErr.Suggestions)
+}
@@ -3,10 +3,13 @@ package cli
 import (
 	"context"
 	"fmt"
+	"sort"
+	"strings"
+	"time"
 
-	"github.com/ork-cli/ork/internal/config"
 	"github.com/ork-cli/ork/internal/docker"
 	"github.com/ork-cli/ork/internal/ui"
+	"github.com/ork-cli/ork/pkg/utils"
 	"github.com/spf13/cobra"
 )
 
@@ -15,30 +18,75 @@ import (
 // ============================================================================
 
 var logsCmd = &cobra.Command{
-	Use:   "logs <service>",
-	Short: "View logs from a service",
+	Use:   "logs [service...]",
+	Short: "View logs from one or more services",
 	Long: `
-View and stream logs from a running service container.
+View and stream logs from running service containers.
 
+With no service names, logs from every running service are interleaved,
+each line prefixed with its service name (like docker-compose).
 By default, shows all available logs. Use --tail to limit output,
-and --follow to stream logs continuously (like tail -f).`,
+and --follow to stream logs continuously (like tail -f).
+Use --since to only show logs from a recent window, given as a duration
+back from now (10m, 1h, 3d), Unix seconds, or an RFC3339 timestamp.
+Use --level to hide lines below a minimum detected log level.
+Use --container to format logs from any container ID (e.g., from 'docker ps')
+without loading ork.yml or resolving a service.`,
 	Example: `
+ork logs                     Interleave logs from all running services
+ork logs api web --follow    Stream logs from api and web together
 ork logs api                 Show all logs for api service
 ork logs api --follow        Stream logs continuously
 ork logs api --tail 100      Show last 100 lines
-ork logs api --timestamps    Show timestamps in output`,
+ork logs api --timestamps    Show timestamps in output
+ork logs api --since 10m     Show logs from the last 10 minutes
+ork logs api --level warn    Only show warnings and errors
+ork logs --container 3f2a1c  Show logs for a container by ID`,
 
-	Args: cobra.ExactArgs(1), // Require exactly one service name
 	Run: func(cmd *cobra.Command, args []string) {
-		serviceName := args[0]
-
 		// Get flags
 		follow, _ := cmd.Flags().GetBool("follow")
 		tail, _ := cmd.Flags().GetString("tail")
 		timestamps, _ := cmd.Flags().GetBool("timestamps")
+		level, _ := cmd.Flags().GetString("level")
+		sinceFlag, _ := cmd.Flags().GetString("since")
+		keepUnknown, _ := cmd.Flags().GetBool("keep-unknown")
+		containerID, _ := cmd.Flags().GetString("container")
+
+		if containerID != "" && len(args) > 0 {
+			handleLogsError(utils.ConfigError(
+				"logs.container",
+				"--container can't be combined with service names",
+				"Pass either a container ID with --container or service names, not both",
+				nil,
+			))
+			return
+		}
+
+		minLevel, levelErr := ui.ParseLogLevel(level)
+		if levelErr != nil {
+			handleLogsError(utils.ConfigError("logs.level", levelErr.Error(), "", nil))
+			return
+		}
+		filter := ui.LevelFilter{Min: minLevel, KeepUnknown: keepUnknown}
+
+		// Reject a bad time window before looking up containers or streaming anything
+		since, sinceErr := parseLogsSince(sinceFlag)
+		if sinceErr != nil {
+			handleLogsError(utils.ConfigError("logs.since", sinceErr.Error(), "", nil))
+			return
+		}
 
-		if err := runLogs(serviceName, follow, tail, timestamps); err != nil {
-			fmt.Printf("❌ Error: %v\n", err)
+		var err error
+		if containerID != "" {
+			err = runContainerLogs(containerID, follow, tail, timestamps, since, filter)
+		} else if len(args) == 1 {
+			err = runLogs(args[0], follow, tail, timestamps, since, filter)
+		} else {
+			err = runMultiLogs(args, follow, tail, timestamps, since, filter)
+		}
+		if err != nil {
+			handleLogsError(err)
 			return
 		}
 	},
@@ -52,6 +100,10 @@ func init() {
 	logsCmd.Flags().BoolP("follow", "f", false, "Stream logs continuously (like tail -f)")
 	logsCmd.Flags().StringP("tail", "n", "all", "Number of lines to show from the end")
 	logsCmd.Flags().BoolP("timestamps", "t", false, "Show timestamps in log output")
+	logsCmd.Flags().String("since", "", "Only show logs since a duration ago (10m, 1h, 3d), Unix seconds, or an RFC3339 timestamp")
+	logsCmd.Flags().String("level", "", "Minimum log level to show (trace, debug, info, warn, error)")
+	logsCmd.Flags().Bool("keep-unknown", true, "Keep lines with no detectable log level when --level is set")
+	logsCmd.Flags().String("container", "", "Show logs for a container ID directly, skipping service lookup")
 }
 
 // ============================================================================
@@ -59,9 +111,11 @@ func init() {
 // ============================================================================
 
 // runLogs retrieves and displays logs for a specific service
-func runLogs(serviceName string, follow bool, tail string, timestamps bool) error {
+// Lines rejected by the level filter are dropped before printing
+// since is a Docker timestamp from parseLogsSince (empty shows all logs)
+func runLogs(serviceName string, follow bool, tail string, timestamps bool, since string, filter ui.LevelFilter) error {
 	// Load configuration to get the project name
-	cfg, err := loadConfigForLogs()
+	cfg, err := loadConfigUnvalidated()
 	if err != nil {
 		return err
 	}
@@ -84,8 +138,28 @@ func runLogs(serviceName string, follow bool, tail string, timestamps bool) erro
 		return err
 	}
 
+	return streamContainerLogs(ctx, dockerClient, serviceName, containerID, follow, tail, timestamps, since, filter)
+}
+
+// runContainerLogs formats logs for a container ID as given, without loading ork.yml
+func runContainerLogs(containerID string, follow bool, tail string, timestamps bool, since string, filter ui.LevelFilter) error {
+	dockerClient, err := createDockerClientForLogs()
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if closeErr := dockerClient.Close(); closeErr != nil {
+			fmt.Printf("❌ Error closing Docker client: %v\n", closeErr)
+		}
+	}()
+
+	return streamContainerLogs(context.Background(), dockerClient, containerID, containerID, follow, tail, timestamps, since, filter)
+}
+
+// streamContainerLogs prints a header for one container and streams its formatted logs
+func streamContainerLogs(ctx context.Context, dockerClient *docker.Client, name, containerID string, follow bool, tail string, timestamps bool, since string, filter ui.LevelFilter) error {
 	// Print a beautiful service header
-	header := ui.FormatServiceHeader(serviceName, containerID, follow)
+	header := ui.FormatServiceHeader(name, containerID, follow)
 	fmt.Println(header)
 	ui.EmptyLine()
 
@@ -99,7 +173,12 @@ func runLogs(serviceName string, follow bool, tail string, timestamps bool) erro
 		Follow:     follow,
 		Tail:       tail,
 		Timestamps: timestamps,
+		Since:      since,
 		Formatter:  logFormatter,
+		Filter:     filter.Keep,
+		OnReconnect: func() {
+			fmt.Println(ui.FormatReconnectNotice(name))
+		},
 	}
 
 	// Stream logs
@@ -115,17 +194,84 @@ func runLogs(serviceName string, follow bool, tail string, timestamps bool) erro
 	return nil
 }
 
+// runMultiLogs interleaves logs from several services, prefixing each line with its service
+// With no service names, every running service in the project is included
+func runMultiLogs(serviceNames []string, follow bool, tail string, timestamps bool, since string, filter ui.LevelFilter) error {
+	// Load configuration to get the project name
+	cfg, err := loadConfigUnvalidated()
+	if err != nil {
+		return err
+	}
+
+	// Create a Docker client
+	dockerClient, err := createDockerClientForLogs()
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if closeErr := dockerClient.Close(); closeErr != nil {
+			fmt.Printf("❌ Error closing Docker client: %v\n", closeErr)
+		}
+	}()
+
+	// Find the containers to stream from
+	ctx := context.Background()
+	refs, err := findContainersForServices(ctx, dockerClient, cfg.Project, serviceNames)
+	if err != nil {
+		return err
+	}
+
+	// Print a header per service, and align prefixes on the longest name
+	width := 0
+	for _, ref := range refs {
+		fmt.Println(ui.FormatServiceHeader(ref.Name, ref.ID, follow))
+		width = max(width, len(ref.Name))
+	}
+	ui.EmptyLine()
+
+	logOpts := docker.LogsOptions{
+		Follow:     follow,
+		Tail:       tail,
+		Timestamps: timestamps,
+		Since:      since,
+		Formatter: func(line string) string {
+			return ui.FormatLogLine(line, timestamps)
+		},
+		Filter: filter.Keep,
+		Prefix: func(name string) string {
+			return ui.FormatLogPrefix(name, width)
+		},
+	}
+
+	// Stream all containers at once
+	if err := dockerClient.LogsMulti(ctx, refs, logOpts); err != nil {
+		return fmt.Errorf("failed to retrieve logs: %w", err)
+	}
+
+	// Show streaming footer if following
+	if follow {
+		fmt.Println(ui.FormatStreamingFooter())
+	}
+
+	return nil
+}
+
 // ============================================================================
-// Private Helpers - Configuration
+// Private Helpers - Flags
 // ============================================================================
 
-// loadConfigForLogs loads the ork.yml file
-func loadConfigForLogs() (*config.Config, error) {
-	cfg, err := config.Load()
+// parseLogsSince converts a --since value into a timestamp the Docker API accepts (empty means no limit)
+// Accepts the same forms as every other --since flag (see utils.ParseSince)
+func parseLogsSince(value string) (string, error) {
+	if value == "" {
+		return "", nil
+	}
+
+	since, err := utils.ParseSince(value)
 	if err != nil {
-		return nil, fmt.Errorf("failed to load config: %w\n💡 Make sure ork.yml exists in current directory", err)
+		return "", err
 	}
-	return cfg, nil
+	return since.Format(time.RFC3339Nano), nil
 }
 
 // ============================================================================
@@ -147,19 +293,78 @@ func createDockerClientForLogs() (*docker.Client, error) {
 
 // findContainerForService finds the container ID for a given service name
 func findContainerForService(ctx context.Context, client *docker.Client, projectName, serviceName string) (string, error) {
-	// List all containers for this project
-	containers, err := client.List(ctx, projectName)
+	// List only this service's containers
+	containers, err := client.ListByService(ctx, projectName, serviceName)
 	if err != nil {
 		return "", fmt.Errorf("failed to list containers: %w", err)
 	}
 
-	// Find the container matching the service name
-	for _, container := range containers {
-		if container.Labels["ork.service"] == serviceName {
-			return container.ID, nil
-		}
+	if len(containers) > 0 {
+		return containers[0].ID, nil
 	}
 
 	// Service not found
 	return "", fmt.Errorf("service '%s' not found\n💡 Use 'ork ps' to see running services", serviceName)
 }
+
+// findContainersForServices resolves service names to their containers, sorted by service name
+// With no service names, every running container in the project is returned
+func findContainersForServices(ctx context.Context, client *docker.Client, projectName string, serviceNames []string) ([]docker.ContainerRef, error) {
+	containers, err := client.List(ctx, projectName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list containers: %w", err)
+	}
+
+	// Index containers by service
+	byService := make(map[string]docker.ContainerInfo, len(containers))
+	for _, container := range containers {
+		byService[container.Labels["ork.service"]] = container
+	}
+
+	var refs []docker.ContainerRef
+	if len(serviceNames) == 0 {
+		// All running services
+		for name, container := range byService {
+			if strings.HasPrefix(container.Status, "Up") {
+				refs = append(refs, docker.ContainerRef{ID: container.ID, Name: name})
+			}
+		}
+		if len(refs) == 0 {
+			return nil, fmt.Errorf("no running services in project '%s'\n💡 Use 'ork up <service>' to start one", projectName)
+		}
+	} else {
+		// Only the requested services
+		for _, name := range serviceNames {
+			container, ok := byService[name]
+			if !ok {
+				return nil, fmt.Errorf("service '%s' not found\n💡 Use 'ork ps' to see running services", name)
+			}
+			refs = append(refs, docker.ContainerRef{ID: container.ID, Name: name})
+		}
+	}
+
+	sort.Slice(refs, func(i, j int) bool { return refs[i].Name < refs[j].Name })
+	return refs, nil
+}
+
+// handleLogsError formats and displays errors with hints
+func handleLogsError(err error) {
+	commandErr = err
+
+	if orkErr, ok := err.(*utils.OrkError); ok {
+		// Display structured error with hints
+		ui.Error(orkErr.Message)
+		if orkErr.Hint != "" {
+			ui.Hint(orkErr.Hint)
+		}
+		if len(orkErr.Details) > 0 {
+			ui.EmptyLine()
+			for _, detail := range orkErr.Details {
+				ui.List(detail)
+			}
+		}
+	} else {
+		// Fallback for non-Ork errors
+		ui.Error(fmt.Sprintf("Error: %v", err))
+	}
+}
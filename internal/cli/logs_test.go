@@ -0,0 +1,106 @@
+package cli
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/docker/docker/pkg/stdcopy"
+	"github.com/ork-cli/ork/internal/docker/dockertest"
+	"github.com/ork-cli/ork/internal/ui"
+	"github.com/ork-cli/ork/pkg/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// ============================================================================
+// Container Passthrough Tests
+// ============================================================================
+
+func TestRunContainerLogs_SkipsConfigResolution(t *testing.T) {
+	// An unparseable ork.yml proves the config is never loaded
+	writeTestConfig(t, "services: [not, a, map")
+	fake, _ := dockertest.NewServer(t)
+
+	const containerID = "3f2a1c9b8d7e6f5a4b3c2d1e"
+	fake.Handle(http.MethodGet, "/containers/"+containerID+"/logs", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = stdcopy.NewStdWriter(w, stdcopy.Stdout).Write([]byte("listening on 8080\n"))
+	})
+
+	out := captureStdout(t, func() {
+		require.NoError(t, runContainerLogs(containerID, false, "all", false, "", ui.LevelFilter{}))
+	})
+
+	assert.Contains(t, out, "listening on 8080")
+	assert.Contains(t, out, containerID[:12])
+	assert.True(t, fake.HasRequest("GET /containers/"+containerID+"/logs"))
+	assert.False(t, fake.HasRequest("GET /containers/json"), "no service lookup should happen")
+}
+
+func TestRunLogs_ResolvesServiceThroughConfig(t *testing.T) {
+	writeTestConfig(t, "services: [not, a, map")
+	dockertest.NewServer(t)
+
+	err := runLogs("api", false, "all", false, "", ui.LevelFilter{})
+	assert.Error(t, err, "the service path still needs a valid ork.yml")
+}
+
+// ============================================================================
+// Error Handling Tests
+// ============================================================================
+
+func TestLogsCommand_InvalidFlagsSetExitCode(t *testing.T) {
+	tests := []struct {
+		name    string
+		flag    string
+		value   string
+		args    []string
+		wantOut string
+	}{
+		{name: "level", flag: "level", value: "loud", wantOut: "invalid log level"},
+		{name: "since", flag: "since", value: "yesterday", wantOut: "yesterday"},
+		{name: "container with services", flag: "container", value: "3f2a1c", args: []string{"api"}, wantOut: "--container can't be combined"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Cleanup(func() {
+				commandErr = nil
+				require.NoError(t, logsCmd.Flags().Set(tt.flag, ""))
+			})
+			require.NoError(t, logsCmd.Flags().Set(tt.flag, tt.value))
+
+			out := captureStdout(t, func() {
+				logsCmd.Run(logsCmd, tt.args)
+			})
+
+			assert.Contains(t, out, tt.wantOut)
+			assert.Equal(t, 2, utils.ExitCode(commandErr), "a bad flag exits with the config error code")
+		})
+	}
+}
+
+func TestParseLogsSince(t *testing.T) {
+	since, err := parseLogsSince("")
+	require.NoError(t, err)
+	assert.Empty(t, since, "no --since means no limit")
+
+	since, err = parseLogsSince("2026-03-01T12:00:00+01:00")
+	require.NoError(t, err)
+	assert.Equal(t, "2026-03-01T12:00:00+01:00", since)
+
+	since, err = parseLogsSince("1772362800")
+	require.NoError(t, err)
+	parsed, err := time.Parse(time.RFC3339Nano, since)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1772362800), parsed.Unix())
+
+	since, err = parseLogsSince("3d")
+	require.NoError(t, err)
+	parsed, err = time.Parse(time.RFC3339Nano, since)
+	require.NoError(t, err)
+	assert.WithinDuration(t, time.Now().Add(-72*time.Hour), parsed, time.Minute)
+
+	_, err = parseLogsSince("yesterday")
+	assert.Error(t, err)
+}
@@ -0,0 +1,146 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/ork-cli/ork/internal/docker"
+	"github.com/ork-cli/ork/internal/ui"
+	"github.com/ork-cli/ork/pkg/utils"
+	"github.com/spf13/cobra"
+)
+
+// ============================================================================
+// Cobra Command Definition
+// ============================================================================
+
+var projectsCmd = &cobra.Command{
+	Use:     "projects",
+	Aliases: []string{"ls"},
+	Short:   "List all Ork projects on this host",
+	Long: `
+List every Ork project that has containers on this host.
+
+Unlike 'ork ps', this does not need an ork.yml - it looks at all Ork-managed
+containers and groups them by project, showing how many services are running
+and stopped in each.`,
+	Example: `
+ork projects                 List all projects with running/stopped counts
+ork ls                       Same as above`,
+
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		if err := runProjects(); err != nil {
+			handlePSError(err)
+			return
+		}
+	},
+}
+
+func init() {
+	// Register the 'projects' command with the root command
+	rootCmd.AddCommand(projectsCmd)
+}
+
+// ============================================================================
+// Main Orchestrator
+// ============================================================================
+
+// runProjects lists all Ork projects with per-project service counts
+func runProjects() error {
+	// Create a Docker client
+	dockerClient, err := docker.NewClient()
+	if err != nil {
+		return utils.DockerError(
+			"projects.docker",
+			"Failed to connect to Docker",
+			"Make sure Docker is running. Try 'docker ps' or run 'ork doctor'",
+			err,
+		)
+	}
+	defer func() {
+		if closeErr := dockerClient.Close(); closeErr != nil {
+			ui.Warning(fmt.Sprintf("Failed to close Docker client: %v", closeErr))
+		}
+	}()
+
+	// List containers across all projects (no project filter)
+	ctx := context.Background()
+	containers, err := dockerClient.List(ctx, "")
+	if err != nil {
+		return utils.DockerError(
+			"projects.list",
+			"Failed to list containers",
+			"Try running 'ork doctor' to diagnose issues",
+			err,
+		)
+	}
+
+	// Aggregate and display
+	summaries := summarizeProjects(containers)
+	fmt.Print(ui.ProjectTable(toProjectRows(summaries)))
+
+	return nil
+}
+
+// ============================================================================
+// Private Helpers - Aggregation
+// ============================================================================
+
+// projectSummary holds the service counts for a single project
+type projectSummary struct {
+	name    string
+	running int
+	stopped int
+}
+
+// summarizeProjects groups containers by their ork.project label and counts running/stopped services
+// Results are sorted by project name; containers without a project label are ignored
+func summarizeProjects(containers []docker.ContainerInfo) []projectSummary {
+	byProject := make(map[string]*projectSummary)
+
+	for _, container := range containers {
+		projectName := container.Labels["ork.project"]
+		if projectName == "" {
+			continue
+		}
+
+		summary, exists := byProject[projectName]
+		if !exists {
+			summary = &projectSummary{name: projectName}
+			byProject[projectName] = summary
+		}
+
+		// Docker status starts with "Up" for running containers
+		if strings.HasPrefix(container.Status, "Up") {
+			summary.running++
+		} else {
+			summary.stopped++
+		}
+	}
+
+	summaries := make([]projectSummary, 0, len(byProject))
+	for _, summary := range byProject {
+		summaries = append(summaries, *summary)
+	}
+	sort.Slice(summaries, func(i, j int) bool {
+		return summaries[i].name < summaries[j].name
+	})
+
+	return summaries
+}
+
+// toProjectRows converts project summaries to table rows
+func toProjectRows(summaries []projectSummary) []ui.ProjectRow {
+	rows := make([]ui.ProjectRow, 0, len(summaries))
+	for _, summary := range summaries {
+		rows = append(rows, ui.ProjectRow{
+			Project: summary.name,
+			Running: summary.running,
+			Stopped: summary.stopped,
+		})
+	}
+	return rows
+}
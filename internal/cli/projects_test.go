@@ -0,0 +1,79 @@
+package cli
+
+import (
+	"testing"
+
+	"github.com/ork-cli/ork/internal/docker"
+	"github.com/stretchr/testify/assert"
+)
+
+// projectContainer builds a container listing entry for a project service
+func projectContainer(project, service, status string) docker.ContainerInfo {
+	return docker.ContainerInfo{
+		Status: status,
+		Labels: map[string]string{
+			"ork.managed": "true",
+			"ork.project": project,
+			"ork.service": service,
+		},
+	}
+}
+
+func TestSummarizeProjects(t *testing.T) {
+	tests := []struct {
+		name       string
+		containers []docker.ContainerInfo
+		want       []projectSummary
+	}{
+		{
+			name:       "no containers",
+			containers: nil,
+			want:       []projectSummary{},
+		},
+		{
+			name: "single project all running",
+			containers: []docker.ContainerInfo{
+				projectContainer("shop", "api", "Up 5 minutes"),
+				projectContainer("shop", "db", "Up 2 hours"),
+			},
+			want: []projectSummary{{name: "shop", running: 2, stopped: 0}},
+		},
+		{
+			name: "multiple projects sorted by name with mixed states",
+			containers: []docker.ContainerInfo{
+				projectContainer("webapp", "frontend", "Exited (0) 3 minutes ago"),
+				projectContainer("blog", "db", "Up 1 hour"),
+				projectContainer("webapp", "api", "Up 10 seconds"),
+				projectContainer("webapp", "redis", "Created"),
+			},
+			want: []projectSummary{
+				{name: "blog", running: 1, stopped: 0},
+				{name: "webapp", running: 1, stopped: 2},
+			},
+		},
+		{
+			name: "containers without a project label are ignored",
+			containers: []docker.ContainerInfo{
+				{Status: "Up 1 minute", Labels: map[string]string{"ork.managed": "true"}},
+				projectContainer("shop", "api", "Up 1 minute"),
+			},
+			want: []projectSummary{{name: "shop", running: 1, stopped: 0}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := summarizeProjects(tt.containers)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestToProjectRows(t *testing.T) {
+	rows := toProjectRows([]projectSummary{{name: "shop", running: 2, stopped: 1}})
+
+	assert.Len(t, rows, 1)
+	assert.Equal(t, "shop", rows[0].Project)
+	assert.Equal(t, 2, rows[0].Running)
+	assert.Equal(t, 1, rows[0].Stopped)
+}
@@ -3,10 +3,20 @@ package cli
 import (
 	"context"
 	"fmt"
+	"os"
+	"os/signal"
+	"regexp"
+	"sort"
+	"strconv"
 	"strings"
+	"sync"
+	"syscall"
+	"time"
 
+	"github.com/moby/term"
 	"github.com/ork-cli/ork/internal/config"
 	"github.com/ork-cli/ork/internal/docker"
+	"github.com/ork-cli/ork/internal/service"
 	"github.com/ork-cli/ork/internal/ui"
 	"github.com/ork-cli/ork/pkg/utils"
 	"github.com/spf13/cobra"
@@ -23,16 +33,40 @@ var psCmd = &cobra.Command{
 List all services managed by Ork for the current project.
 
 Shows container status, ports, and other information for all services
-defined in your ork.yml configuration file.`,
+defined in your ork.yml configuration file. Uptime is measured from when each
+container actually started (e.g., "2h14m"); stopped services show "-".
+
+Running services with a health check are probed once (for at most 2s), and
+the STATUS column shows the result, e.g. "Running (unhealthy)". Services
+without an Ork health check show the image's own HEALTHCHECK status, if any.
+
+Use --watch to keep the table on screen, redrawn every --interval (default
+2s), until you press Ctrl+C.`,
 	Example: `
 ork ps                       List all services in current project
-ork ps --all                 Include stopped containers`,
+ork ps --all                 Include stopped containers
+ork ps --sort status         Group services by status
+ork ps --sort uptime         Longest-running services first
+ork ps -o wide               Add image, full container ID, created time, restarts, all ports
+ork ps --watch               Refresh the table every 2 seconds
+ork ps -w --interval 5s      Refresh every 5 seconds
+ork ps --json                Output services as JSON (for scripting)`,
 
 	Run: func(cmd *cobra.Command, args []string) {
 		// Get flags
 		showAll, _ := cmd.Flags().GetBool("all")
-
-		if err := runPS(showAll); err != nil {
+		sortKey, _ := cmd.Flags().GetString("sort")
+		format, _ := cmd.Flags().GetString("format")
+		watch, _ := cmd.Flags().GetBool("watch")
+		interval, _ := cmd.Flags().GetDuration("interval")
+
+		var err error
+		if watch {
+			err = runPSWatch(showAll, sortKey, format, interval)
+		} else {
+			err = runPS(showAll, outputJSON, sortKey, format)
+		}
+		if err != nil {
 			handlePSError(err)
 			return
 		}
@@ -45,16 +79,48 @@ func init() {
 
 	// Add flags
 	psCmd.Flags().BoolP("all", "a", false, "Show all containers (including stopped)")
+	psCmd.Flags().String("sort", psSortName, "Sort services by: name, status, uptime")
+	psCmd.Flags().StringP("format", "o", psFormatTable, "Output format: table, wide")
+	psCmd.Flags().BoolP("watch", "w", false, "Redraw the table periodically until Ctrl+C")
+	psCmd.Flags().Duration("interval", psWatchInterval, "Refresh interval for --watch")
+	addJSONFlag(psCmd)
 }
 
+// Sort keys accepted by 'ork ps --sort'
+const (
+	psSortName   = "name"
+	psSortStatus = "status"
+	psSortUptime = "uptime"
+)
+
+// Output formats accepted by 'ork ps --format'
+const (
+	psFormatTable = "table"
+	psFormatWide  = "wide"
+)
+
+// psWatchInterval is the default pause between refreshes of 'ork ps --watch'
+const psWatchInterval = 2 * time.Second
+
 // ============================================================================
 // Main Orchestrator
 // ============================================================================
 
 // runPS lists all Ork-managed containers for the current project
-func runPS(showAll bool) error {
+// With jsonOutput, rows are written to stdout as a JSON array instead of a table
+// Rows are ordered by sortKey (name, status, or uptime) before rendering
+// The wide format inspects each container for its image, created time, restart count, and ports
+func runPS(showAll, jsonOutput bool, sortKey, format string) error {
+	// Reject unknown sort keys and formats before touching Docker
+	if err := validatePSSort(sortKey); err != nil {
+		return err
+	}
+	if err := validatePSFormat(format); err != nil {
+		return err
+	}
+
 	// Load configuration to get the project name
-	cfg, err := loadConfig()
+	cfg, err := loadConfigUnvalidated()
 	if err != nil {
 		return err
 	}
@@ -70,11 +136,148 @@ func runPS(showAll bool) error {
 		}
 	}()
 
-	// List containers
+	// Collect and display rows
 	ctx := context.Background()
+	rows, containers, err := collectServiceRows(ctx, dockerClient, cfg, showAll, sortKey)
+	if err != nil {
+		return err
+	}
+	if format == psFormatWide {
+		wideRows := buildWideServiceRows(ctx, dockerClient, containers, rows)
+		if jsonOutput {
+			return ui.WriteJSON(os.Stdout, wideRows)
+		}
+		fmt.Print(ui.WideServiceTable(cfg.Project, wideRows))
+		return nil
+	}
+	if jsonOutput {
+		return ui.WriteJSON(os.Stdout, rows)
+	}
+	fmt.Print(ui.ServiceTable(cfg.Project, rows))
+
+	return nil
+}
+
+// runPSWatch redraws the service table every interval until interrupted (Ctrl+C)
+// The terminal is redrawn immediately when it's resized, rather than waiting for the next tick
+func runPSWatch(showAll bool, sortKey, format string, interval time.Duration) error {
+	if err := validatePSSort(sortKey); err != nil {
+		return err
+	}
+	if err := validatePSFormat(format); err != nil {
+		return err
+	}
+	if interval <= 0 {
+		return utils.ConfigError(
+			"ps.interval",
+			fmt.Sprintf("Invalid refresh interval %s", interval),
+			"Use a positive duration, e.g. --interval 2s",
+			nil,
+		)
+	}
+	if outputJSON {
+		return utils.ConfigError(
+			"ps.watch",
+			"Cannot combine --watch with --json",
+			"Drop --watch to print the services as JSON once",
+			nil,
+		)
+	}
+
+	cfg, err := loadConfigUnvalidated()
+	if err != nil {
+		return err
+	}
+
+	dockerClient, err := createDockerClientForPS()
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if closeErr := dockerClient.Close(); closeErr != nil {
+			ui.Warning(fmt.Sprintf("Failed to close Docker client: %v", closeErr))
+		}
+	}()
+
+	// Stop watching on Ctrl+C
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	resize := make(chan os.Signal, 1)
+	notifyResize(resize)
+	defer signal.Stop(resize)
+
+	status := func(ctx context.Context) (string, error) {
+		return renderServiceTable(ctx, dockerClient, cfg, showAll, sortKey, format)
+	}
+	footer := ui.Dim(fmt.Sprintf("Refreshing every %s · Press Ctrl+C to exit", interval))
+	draw := func(view string) {
+		clearScreen()
+		fmt.Print(view)
+		fmt.Println(footer)
+	}
+
+	return watchPS(ctx, ticker.C, resize, status, draw)
+}
+
+// ============================================================================
+// Private Helpers - Watching
+// ============================================================================
+
+// psStatusFunc renders the current service table (a fake stands in for Docker in tests)
+type psStatusFunc func(ctx context.Context) (string, error)
+
+// watchPS draws the view once, then refreshes it on every tick and redraws it on every resize
+// Returns nil once ctx is cancelled, or the first error from status
+func watchPS(ctx context.Context, ticks <-chan time.Time, resize <-chan os.Signal, status psStatusFunc, draw func(view string)) error {
+	view, err := status(ctx)
+	if err != nil {
+		return err
+	}
+	draw(view)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-resize:
+			draw(view)
+		case <-ticks:
+			view, err = status(ctx)
+			if err != nil {
+				// Interrupted mid-refresh; not a failure
+				if ctx.Err() != nil {
+					return nil
+				}
+				return err
+			}
+			draw(view)
+		}
+	}
+}
+
+// clearScreen moves the cursor home and clears the screen, so the next frame redraws in place
+// When stdout isn't a terminal (e.g. piped to a file), frames are simply appended instead
+func clearScreen() {
+	if _, isTerminal := term.GetFdInfo(os.Stdout); !isTerminal {
+		return
+	}
+	fmt.Print("\033[H\033[2J")
+}
+
+// ============================================================================
+// Private Helpers - Collection
+// ============================================================================
+
+// collectServiceRows lists the project's containers (running only, unless showAll) as sorted rows
+// Also returns the containers, which the wide format needs for image names
+func collectServiceRows(ctx context.Context, dockerClient *docker.Client, cfg *config.Config, showAll bool, sortKey string) ([]ui.ServiceRow, []docker.ContainerInfo, error) {
 	containers, err := dockerClient.List(ctx, cfg.Project)
 	if err != nil {
-		return utils.DockerError(
+		return nil, nil, utils.DockerError(
 			"ps.list",
 			"Failed to list containers",
 			"Try running 'ork doctor' to diagnose issues",
@@ -87,28 +290,23 @@ func runPS(showAll bool) error {
 		containers = filterRunningContainers(containers)
 	}
 
-	// Display results
-	displayContainers(containers, cfg.Project)
-
-	return nil
+	rows := buildServiceRows(containers)
+	applyUptimes(ctx, dockerClient, rows, time.Now())
+	applyHealth(ctx, dockerClient, cfg, rows)
+	sortServiceRows(rows, sortKey)
+	return rows, containers, nil
 }
 
-// ============================================================================
-// Private Helpers - Configuration
-// ============================================================================
-
-// loadConfig loads the ork.yml file (validation not required for ps)
-func loadConfig() (*config.Config, error) {
-	cfg, err := config.Load()
+// renderServiceTable collects the project's service rows and renders them in the given format
+func renderServiceTable(ctx context.Context, dockerClient *docker.Client, cfg *config.Config, showAll bool, sortKey, format string) (string, error) {
+	rows, containers, err := collectServiceRows(ctx, dockerClient, cfg, showAll, sortKey)
 	if err != nil {
-		return nil, utils.ConfigError(
-			"ps.load",
-			"Failed to load configuration",
-			"Make sure ork.yml exists in current directory",
-			err,
-		)
+		return "", err
 	}
-	return cfg, nil
+	if format == psFormatWide {
+		return ui.WideServiceTable(cfg.Project, buildWideServiceRows(ctx, dockerClient, containers, rows)), nil
+	}
+	return ui.ServiceTable(cfg.Project, rows), nil
 }
 
 // ============================================================================
@@ -151,27 +349,267 @@ func filterRunningContainers(containers []docker.ContainerInfo) []docker.Contain
 // Private Helpers - Display
 // ============================================================================
 
-// displayContainers prints containers in a beautiful table format
-func displayContainers(containers []docker.ContainerInfo, projectName string) {
-	// Convert containers to table rows
-	var rows []ui.ServiceRow
+// buildServiceRows converts containers to table rows
+// Always returns a non-nil slice so JSON output is [] rather than null
+func buildServiceRows(containers []docker.ContainerInfo) []ui.ServiceRow {
+	rows := make([]ui.ServiceRow, 0, len(containers))
 	for _, c := range containers {
-		serviceName := extractServiceName(c.Labels)
-		status := normalizeStatus(c.Status)
-		uptime := extractUptime(c.Status)
+		ports := c.Ports
+		if ports == nil {
+			ports = []string{}
+		}
 
 		rows = append(rows, ui.ServiceRow{
-			Service:     serviceName,
-			Status:      status,
-			Ports:       c.Ports,
+			Service:     extractServiceName(c.Labels),
+			Status:      normalizeStatus(c.Status),
+			Ports:       ports,
 			ContainerID: c.ID,
-			Uptime:      uptime,
+			Uptime:      extractUptime(c.Status),
 		})
 	}
+	return rows
+}
+
+// applyUptimes replaces each running row's uptime with one computed from the container's real start time
+// Rows whose container can't be inspected (or reports no start time) keep Docker's status text
+func applyUptimes(ctx context.Context, dockerClient *docker.Client, rows []ui.ServiceRow, now time.Time) {
+	for i := range rows {
+		if rows[i].Status != "running" {
+			continue
+		}
+
+		details, err := dockerClient.Inspect(ctx, rows[i].ContainerID)
+		if err != nil {
+			continue
+		}
+		if uptime := formatUptime(details.StartedAt, now); uptime != "" {
+			rows[i].Uptime = uptime
+		}
+	}
+}
+
+// psHealthTimeout bounds each service's health probe, so ps stays quick when a service hangs
+const psHealthTimeout = 2 * time.Second
+
+// applyHealth fills in the health of each running row whose service is in ork.yml
+// Services are probed in parallel; a probe that can't run leaves the row's health empty
+func applyHealth(ctx context.Context, dockerClient *docker.Client, cfg *config.Config, rows []ui.ServiceRow) {
+	var wg sync.WaitGroup
+	for i := range rows {
+		serviceCfg, ok := cfg.Services[rows[i].Service]
+		if rows[i].Status != "running" || !ok {
+			continue
+		}
+
+		wg.Add(1)
+		go func(row *ui.ServiceRow) {
+			defer wg.Done()
+			row.Health = probeHealth(ctx, dockerClient, cfg.Project, row.Service, serviceCfg)
+		}(&rows[i])
+	}
+	wg.Wait()
+}
+
+// probeHealth runs a single, short health check against a running service
+// Services without an Ork health check report the image's native HEALTHCHECK status, if any
+// Returns "healthy", "unhealthy", "starting", or "" when there's nothing to check or the probe can't run
+func probeHealth(ctx context.Context, dockerClient *docker.Client, projectName, serviceName string, serviceCfg config.Service) string {
+	if serviceCfg.Health.Disabled() {
+		return ""
+	}
+
+	// One attempt, rather than the retries 'ork up' waits through
+	if serviceCfg.Health != nil {
+		health := *serviceCfg.Health
+		health.Retries = 1
+		serviceCfg.Health = &health
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, psHealthTimeout)
+	defer cancel()
+
+	svc := service.New(serviceName, projectName, serviceCfg)
+	if err := svc.Reconcile(ctx, dockerClient); err != nil || !svc.IsRunning() {
+		return ""
+	}
+	if serviceCfg.Health.Kind() != config.HealthCheckNone {
+		_ = svc.CheckHealth(ctx, dockerClient) // A failed check is recorded as unhealthy
+	}
+
+	switch health := svc.GetHealthStatus(); health {
+	case service.HealthHealthy, service.HealthUnhealthy, service.HealthStarting:
+		return string(health)
+	default:
+		return ""
+	}
+}
+
+// formatUptime renders how long a container has been up as of now (e.g., "2h14m")
+// Returns an empty string when the start time is unknown
+func formatUptime(startedAt, now time.Time) string {
+	if startedAt.IsZero() {
+		return ""
+	}
+	return ui.FormatDuration(now.Sub(startedAt))
+}
+
+// buildWideServiceRows adds inspect details to already-sorted rows
+// A container that can't be inspected (e.g., removed since listing) keeps its list data
+func buildWideServiceRows(ctx context.Context, dockerClient *docker.Client, containers []docker.ContainerInfo, rows []ui.ServiceRow) []ui.WideServiceRow {
+	images := make(map[string]string, len(containers))
+	for _, c := range containers {
+		images[c.ID] = c.Image
+	}
+
+	wideRows := make([]ui.WideServiceRow, 0, len(rows))
+	for _, row := range rows {
+		details, err := dockerClient.Inspect(ctx, row.ContainerID)
+		if err != nil {
+			wideRows = append(wideRows, ui.WideServiceRow{ServiceRow: row, Image: images[row.ContainerID]})
+			continue
+		}
+		wideRows = append(wideRows, buildWideServiceRow(row, images[row.ContainerID], details))
+	}
+	return wideRows
+}
+
+// buildWideServiceRow combines a list row with its container's inspect details
+// The image comes from the list, since inspect reports the image ID rather than its name
+func buildWideServiceRow(row ui.ServiceRow, image string, details *docker.ContainerDetails) ui.WideServiceRow {
+	// Inspect reports every port, including ones that aren't published
+	row.ContainerID = details.ID
+	if len(details.Ports) > 0 {
+		row.Ports = details.Ports
+	}
+
+	return ui.WideServiceRow{
+		ServiceRow:   row,
+		Image:        image,
+		Created:      details.Created,
+		RestartCount: details.RestartCount,
+	}
+}
+
+// ============================================================================
+// Private Helpers - Sorting
+// ============================================================================
+
+// statusOrder ranks normalized statuses for --sort status (running first)
+var statusOrder = map[string]int{
+	"running":  0,
+	"starting": 1,
+	"exited":   2,
+	"stopped":  3,
+}
+
+// validatePSSort checks that the sort key is one we know how to apply
+func validatePSSort(sortKey string) error {
+	switch sortKey {
+	case psSortName, psSortStatus, psSortUptime:
+		return nil
+	}
+	return utils.ConfigError(
+		"ps.sort",
+		fmt.Sprintf("Unknown sort key '%s'", sortKey),
+		"Use one of: name, status, uptime",
+		nil,
+	)
+}
+
+// validatePSFormat checks that the output format is one we know how to render
+func validatePSFormat(format string) error {
+	switch format {
+	case psFormatTable, psFormatWide:
+		return nil
+	}
+	return utils.ConfigError(
+		"ps.format",
+		fmt.Sprintf("Unknown output format '%s'", format),
+		"Use one of: table, wide",
+		nil,
+	)
+}
+
+// sortServiceRows orders rows in place by the given key
+// Ties (and rows without an uptime) fall back to service name so output is stable
+func sortServiceRows(rows []ui.ServiceRow, sortKey string) {
+	sort.SliceStable(rows, func(i, j int) bool {
+		a, b := rows[i], rows[j]
+
+		switch sortKey {
+		case psSortStatus:
+			if statusOrder[a.Status] != statusOrder[b.Status] {
+				return statusOrder[a.Status] < statusOrder[b.Status]
+			}
+		case psSortUptime:
+			// Longest-running first; stopped containers have no uptime and sort last
+			ua, ub := parseUptime(a.Uptime), parseUptime(b.Uptime)
+			if ua != ub {
+				return ua > ub
+			}
+		}
+
+		return a.Service < b.Service
+	})
+}
 
-	// Render beautiful table
-	table := ui.ServiceTable(projectName, rows)
-	fmt.Print(table)
+// uptimeUnits maps Docker's human-readable duration units to their length
+var uptimeUnits = map[string]time.Duration{
+	"second": time.Second,
+	"minute": time.Minute,
+	"hour":   time.Hour,
+	"day":    24 * time.Hour,
+	"week":   7 * 24 * time.Hour,
+	"month":  30 * 24 * time.Hour,
+	"year":   365 * 24 * time.Hour,
+}
+
+// compactUptimePattern matches uptimes rendered by ui.FormatDuration (e.g., "2h14m", "3d4h")
+var compactUptimePattern = regexp.MustCompile(`^(\d+[dhms])+$`)
+
+// compactUptimePart matches a single count and unit within a compact uptime
+var compactUptimePart = regexp.MustCompile(`(\d+)([dhms])`)
+
+// compactUptimeUnits maps compact uptime suffixes to their length
+var compactUptimeUnits = map[string]time.Duration{
+	"d": 24 * time.Hour,
+	"h": time.Hour,
+	"m": time.Minute,
+	"s": time.Second,
+}
+
+// parseUptime converts uptime text to a duration, either our compact form ("2h14m")
+// or Docker's status text ("5 minutes", "About an hour")
+// Returns 0 when the text is empty or not recognized
+func parseUptime(uptime string) time.Duration {
+	if compactUptimePattern.MatchString(uptime) {
+		var total time.Duration
+		for _, part := range compactUptimePart.FindAllStringSubmatch(uptime, -1) {
+			count, _ := strconv.Atoi(part[1])
+			total += time.Duration(count) * compactUptimeUnits[part[2]]
+		}
+		return total
+	}
+
+	fields := strings.Fields(strings.ToLower(uptime))
+
+	// "Less than a second"
+	if len(fields) >= 1 && fields[0] == "less" {
+		return 0
+	}
+	// "About a minute" / "About an hour"
+	if len(fields) == 3 && fields[0] == "about" {
+		return uptimeUnits[strings.TrimSuffix(fields[2], "s")]
+	}
+	if len(fields) != 2 {
+		return 0
+	}
+
+	count, err := strconv.Atoi(fields[0])
+	if err != nil {
+		return 0
+	}
+	return time.Duration(count) * uptimeUnits[strings.TrimSuffix(fields[1], "s")]
 }
 
 // extractServiceName gets the service name from labels
@@ -182,11 +620,18 @@ func extractServiceName(labels map[string]string) string {
 	return "unknown"
 }
 
+// exitedStatus matches the exit code in a Docker status (e.g., "Exited (137) 2 hours ago")
+var exitedStatus = regexp.MustCompile(`^Exited \((-?\d+)\)`)
+
 // normalizeStatus converts Docker status to our normalized format
+// An exited container counts as "exited" only if it crashed, matching how services reconcile their state
 func normalizeStatus(status string) string {
 	if strings.HasPrefix(status, "Up") {
 		return "running"
-	} else if strings.HasPrefix(status, "Exited") {
+	} else if match := exitedStatus.FindStringSubmatch(status); match != nil {
+		if exitCode, err := strconv.Atoi(match[1]); err == nil && service.ExitedUnexpectedly(exitCode) {
+			return string(service.StateExited)
+		}
 		return "stopped"
 	} else if strings.Contains(strings.ToLower(status), "restarting") {
 		return "starting"
@@ -211,6 +656,8 @@ func extractUptime(status string) string {
 
 // handlePSError formats and displays errors with hints
 func handlePSError(err error) {
+	commandErr = err
+
 	if orkErr, ok := err.(*utils.OrkError); ok {
 		// Display structured error with hints
 		ui.Error(orkErr.Message)
@@ -0,0 +1,504 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/ork-cli/ork/internal/docker"
+	"github.com/ork-cli/ork/internal/docker/dockertest"
+	"github.com/ork-cli/ork/internal/ui"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const psTestConfig = `version: "1.0"
+project: shop
+services:
+  api:
+    image: node:18
+`
+
+// ============================================================================
+// JSON Output Tests
+// ============================================================================
+
+func TestRunPS_JSONSchema(t *testing.T) {
+	writeTestConfig(t, psTestConfig)
+	fake, _ := dockertest.NewServer(t)
+	fake.AddContainer("aaaaaaaaaaaa", "shop", "api", "Up 5 minutes")
+
+	var runErr error
+	out := captureStdout(t, func() {
+		runErr = runPS(false, true, psSortName, psFormatTable)
+	})
+	require.NoError(t, runErr)
+
+	var rows []map[string]any
+	require.NoError(t, json.Unmarshal([]byte(out), &rows), "stdout should be pure JSON: %q", out)
+	require.Len(t, rows, 1)
+
+	keys := make([]string, 0, len(rows[0]))
+	for key := range rows[0] {
+		keys = append(keys, key)
+	}
+	assert.ElementsMatch(t, []string{"service", "status", "ports", "container_id", "uptime"}, keys)
+	assert.Equal(t, "api", rows[0]["service"])
+	assert.Equal(t, "running", rows[0]["status"])
+	assert.Equal(t, "5 minutes", rows[0]["uptime"])
+}
+
+func TestRunPS_JSONSuppressesHeader(t *testing.T) {
+	writeTestConfig(t, psTestConfig)
+	fake, _ := dockertest.NewServer(t)
+	fake.AddContainer("aaaaaaaaaaaa", "shop", "api", "Up 5 minutes")
+
+	out := captureStdout(t, func() {
+		require.NoError(t, runPS(false, true, psSortName, psFormatTable))
+	})
+
+	assert.NotContains(t, out, "Services for project")
+	assert.NotContains(t, out, "\x1b[", "JSON output should not contain ANSI styling")
+}
+
+func TestRunPS_JSONEmptyIsArray(t *testing.T) {
+	writeTestConfig(t, psTestConfig)
+	dockertest.NewServer(t)
+
+	out := captureStdout(t, func() {
+		require.NoError(t, runPS(false, true, psSortName, psFormatTable))
+	})
+
+	assert.JSONEq(t, "[]", out)
+}
+
+func TestRunPS_TableHasHeader(t *testing.T) {
+	writeTestConfig(t, psTestConfig)
+	fake, _ := dockertest.NewServer(t)
+	fake.AddContainer("aaaaaaaaaaaa", "shop", "api", "Up 5 minutes")
+
+	out := captureStdout(t, func() {
+		require.NoError(t, runPS(false, false, psSortName, psFormatTable))
+	})
+
+	assert.Contains(t, out, "Services for project")
+}
+
+// ============================================================================
+// Sorting Tests
+// ============================================================================
+
+// samplePSRows returns rows in an order that matches none of the sort keys
+func samplePSRows() []ui.ServiceRow {
+	return []ui.ServiceRow{
+		{Service: "worker", Status: "stopped", Uptime: ""},
+		{Service: "api", Status: "running", Uptime: "2 hours"},
+		{Service: "redis", Status: "starting", Uptime: ""},
+		{Service: "postgres", Status: "running", Uptime: "3 days"},
+		{Service: "frontend", Status: "running", Uptime: "About a minute"},
+	}
+}
+
+func serviceNames(rows []ui.ServiceRow) []string {
+	names := make([]string, 0, len(rows))
+	for _, row := range rows {
+		names = append(names, row.Service)
+	}
+	return names
+}
+
+func TestSortServiceRows_Name(t *testing.T) {
+	rows := samplePSRows()
+	sortServiceRows(rows, psSortName)
+
+	assert.Equal(t, []string{"api", "frontend", "postgres", "redis", "worker"}, serviceNames(rows))
+}
+
+func TestSortServiceRows_Status(t *testing.T) {
+	rows := samplePSRows()
+	sortServiceRows(rows, psSortStatus)
+
+	assert.Equal(t, []string{"api", "frontend", "postgres", "redis", "worker"}, serviceNames(rows))
+	assert.Equal(t, "starting", rows[3].Status)
+	assert.Equal(t, "stopped", rows[4].Status)
+}
+
+func TestSortServiceRows_Uptime(t *testing.T) {
+	rows := samplePSRows()
+	sortServiceRows(rows, psSortUptime)
+
+	assert.Equal(t, []string{"postgres", "api", "frontend", "redis", "worker"}, serviceNames(rows))
+}
+
+func TestNormalizeStatus(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"Up 5 minutes", "running"},
+		{"Up 2 hours (healthy)", "running"},
+		{"Exited (0) 2 hours ago", "stopped"},
+		{"Exited (143) 5 seconds ago", "stopped"},
+		{"Exited (137) 5 seconds ago", "stopped"},
+		{"Exited (1) 5 seconds ago", "exited"},
+		{"Restarting (1) 2 seconds ago", "starting"},
+		{"Created", "stopped"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			assert.Equal(t, tt.expected, normalizeStatus(tt.input))
+		})
+	}
+}
+
+func TestParseUptime(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected time.Duration
+	}{
+		{"", 0},
+		{"Less than a second", 0},
+		{"45 seconds", 45 * time.Second},
+		{"About a minute", time.Minute},
+		{"5 minutes", 5 * time.Minute},
+		{"About an hour", time.Hour},
+		{"2 hours", 2 * time.Hour},
+		{"3 days", 72 * time.Hour},
+		{"45s", 45 * time.Second},
+		{"2h14m", 2*time.Hour + 14*time.Minute},
+		{"3d4h", 76 * time.Hour},
+		{"soon", 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			assert.Equal(t, tt.expected, parseUptime(tt.input))
+		})
+	}
+}
+
+func TestRunPS_SortByUptime(t *testing.T) {
+	writeTestConfig(t, psTestConfig)
+	fake, _ := dockertest.NewServer(t)
+	fake.AddContainer("aaaaaaaaaaaa", "shop", "api", "Up 5 minutes")
+	fake.AddContainer("bbbbbbbbbbbb", "shop", "db", "Up 2 hours")
+
+	out := captureStdout(t, func() {
+		require.NoError(t, runPS(false, true, psSortUptime, psFormatTable))
+	})
+
+	var rows []ui.ServiceRow
+	require.NoError(t, json.Unmarshal([]byte(out), &rows))
+	assert.Equal(t, []string{"db", "api"}, serviceNames(rows))
+}
+
+// ============================================================================
+// Uptime Tests
+// ============================================================================
+
+func TestFormatUptime(t *testing.T) {
+	now := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+
+	assert.Equal(t, "2h14m", formatUptime(now.Add(-2*time.Hour-14*time.Minute-30*time.Second), now))
+	assert.Equal(t, "45s", formatUptime(now.Add(-45*time.Second), now))
+	assert.Equal(t, "3d4h", formatUptime(now.Add(-76*time.Hour), now))
+	assert.Empty(t, formatUptime(time.Time{}, now))
+}
+
+func TestApplyUptimes_UsesStartTime(t *testing.T) {
+	now := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	fake, client := dockertest.NewServer(t)
+	fake.AddContainer("aaaaaaaaaaaa", "shop", "api", "Up 2 hours")
+	fake.AddContainer("bbbbbbbbbbbb", "shop", "db", "Exited (0) 5 minutes ago")
+	fake.AddContainer("cccccccccccc", "shop", "cache", "Up 5 minutes")
+	fake.SetStartedAt("aaaaaaaaaaaa", now.Add(-2*time.Hour-14*time.Minute))
+
+	rows := []ui.ServiceRow{
+		{Service: "api", Status: "running", ContainerID: "aaaaaaaaaaaa", Uptime: "2 hours"},
+		{Service: "db", Status: "stopped", ContainerID: "bbbbbbbbbbbb"},
+		{Service: "cache", Status: "running", ContainerID: "cccccccccccc", Uptime: "5 minutes"},
+	}
+	applyUptimes(context.Background(), client, rows, now)
+
+	assert.Equal(t, "2h14m", rows[0].Uptime)
+	assert.Empty(t, rows[1].Uptime, "stopped services have no uptime")
+	assert.Equal(t, "5 minutes", rows[2].Uptime, "without a start time, Docker's status text is kept")
+}
+
+func TestRunPS_UptimeFromStartTime(t *testing.T) {
+	writeTestConfig(t, psTestConfig)
+	fake, _ := dockertest.NewServer(t)
+	fake.AddContainer("aaaaaaaaaaaa", "shop", "api", "Up 2 hours")
+	fake.AddContainer("bbbbbbbbbbbb", "shop", "db", "Up 3 hours")
+	fake.SetStartedAt("aaaaaaaaaaaa", time.Now().Add(-26*time.Hour-30*time.Second))
+	fake.SetStartedAt("bbbbbbbbbbbb", time.Now().Add(-3*time.Hour-30*time.Second))
+
+	out := captureStdout(t, func() {
+		require.NoError(t, runPS(false, true, psSortUptime, psFormatTable))
+	})
+
+	var rows []ui.ServiceRow
+	require.NoError(t, json.Unmarshal([]byte(out), &rows))
+	assert.Equal(t, []string{"api", "db"}, serviceNames(rows))
+	assert.Equal(t, "1d2h", rows[0].Uptime)
+	assert.Equal(t, "3h", rows[1].Uptime)
+}
+
+func TestRunPS_InvalidSort(t *testing.T) {
+	err := runPS(false, true, "size", psFormatTable)
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "size")
+}
+
+// ============================================================================
+// Wide Format Tests
+// ============================================================================
+
+func TestBuildWideServiceRow_AssemblesAllFields(t *testing.T) {
+	created := time.Date(2026, 3, 1, 10, 0, 0, 0, time.UTC)
+	row := ui.ServiceRow{
+		Service:     "api",
+		Status:      "running",
+		Ports:       []string{"3000:3000/tcp"},
+		ContainerID: "aaaaaaaaaaaa",
+		Uptime:      "5 minutes",
+	}
+	details := &docker.ContainerDetails{
+		ID:           "aaaaaaaaaaaa0123456789abcdef",
+		Image:        "sha256:deadbeef",
+		Created:      created,
+		RestartCount: 2,
+		Ports:        []string{"3000:3000/tcp", "9229/tcp"},
+	}
+
+	wide := buildWideServiceRow(row, "node:18", details)
+
+	assert.Equal(t, "api", wide.Service)
+	assert.Equal(t, "running", wide.Status)
+	assert.Equal(t, "5 minutes", wide.Uptime)
+	assert.Equal(t, "aaaaaaaaaaaa0123456789abcdef", wide.ContainerID, "wide shows the full container ID")
+	assert.Equal(t, "node:18", wide.Image, "image name comes from the list, not inspect's image ID")
+	assert.Equal(t, created, wide.Created)
+	assert.Equal(t, 2, wide.RestartCount)
+	assert.Equal(t, []string{"3000:3000/tcp", "9229/tcp"}, wide.Ports, "wide includes unpublished ports")
+}
+
+func TestRunPS_WideJSON(t *testing.T) {
+	writeTestConfig(t, psTestConfig)
+	fake, _ := dockertest.NewServer(t)
+	fake.AddContainer("aaaaaaaaaaaa0123456789abcdef", "shop", "api", "Up 5 minutes")
+	fake.Handle(http.MethodGet, "/containers/aaaaaaaaaaaa/json", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{
+			"Id": "aaaaaaaaaaaa0123456789abcdef",
+			"Name": "/ork-shop-api",
+			"Image": "sha256:deadbeef",
+			"Created": "2026-03-01T10:00:00Z",
+			"RestartCount": 4,
+			"State": {"Status": "running", "Running": true},
+			"NetworkSettings": {"Ports": {"3000/tcp": [{"HostIp": "0.0.0.0", "HostPort": "3000"}], "9229/tcp": null}}
+		}`))
+	})
+
+	out := captureStdout(t, func() {
+		require.NoError(t, runPS(false, true, psSortName, psFormatWide))
+	})
+
+	var rows []ui.WideServiceRow
+	require.NoError(t, json.Unmarshal([]byte(out), &rows), "stdout should be pure JSON: %q", out)
+	require.Len(t, rows, 1)
+	assert.Equal(t, "api", rows[0].Service)
+	assert.Equal(t, "fake:latest", rows[0].Image)
+	assert.Equal(t, "aaaaaaaaaaaa0123456789abcdef", rows[0].ContainerID)
+	assert.Equal(t, 4, rows[0].RestartCount)
+	assert.Equal(t, []string{"3000:3000/tcp", "9229/tcp"}, rows[0].Ports)
+	assert.True(t, rows[0].Created.Equal(time.Date(2026, 3, 1, 10, 0, 0, 0, time.UTC)))
+}
+
+func TestRunPS_WideTable(t *testing.T) {
+	writeTestConfig(t, psTestConfig)
+	fake, _ := dockertest.NewServer(t)
+	fake.AddContainer("aaaaaaaaaaaa0123456789abcdef", "shop", "api", "Up 5 minutes")
+
+	out := captureStdout(t, func() {
+		require.NoError(t, runPS(false, false, psSortName, psFormatWide))
+	})
+
+	assert.Contains(t, out, "IMAGE")
+	assert.Contains(t, out, "RESTARTS")
+	assert.Contains(t, out, "aaaaaaaaaaaa0123456789abcdef")
+}
+
+func TestRunPS_InvalidFormat(t *testing.T) {
+	err := runPS(false, true, psSortName, "yaml")
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "yaml")
+}
+
+// ============================================================================
+// Health Tests
+// ============================================================================
+
+func TestRunPS_ReportsHealth(t *testing.T) {
+	// Open port: a listener accepts the TCP check
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = listener.Close() })
+	openPort := strconv.Itoa(listener.Addr().(*net.TCPAddr).Port)
+
+	// Closed port: grab a free port, then release it
+	closedListener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	closedPort := strconv.Itoa(closedListener.Addr().(*net.TCPAddr).Port)
+	require.NoError(t, closedListener.Close())
+
+	writeTestConfig(t, fmt.Sprintf(`version: "1.0"
+project: shop
+services:
+  api:
+    image: node:18
+    ports: ["%s:3000"]
+    health:
+      type: tcp
+  cache:
+    image: redis:7
+    ports: ["%s:6379"]
+    health:
+      type: tcp
+  web:
+    image: nginx:alpine
+`, openPort, closedPort))
+	fake, _ := dockertest.NewServer(t)
+	fake.AddContainer("aaaaaaaaaaaa", "shop", "api", "Up 5 minutes")
+	fake.AddContainer("bbbbbbbbbbbb", "shop", "cache", "Up 5 minutes")
+	fake.AddContainer("cccccccccccc", "shop", "web", "Up 5 minutes")
+
+	out := captureStdout(t, func() {
+		require.NoError(t, runPS(false, true, psSortName, psFormatTable))
+	})
+
+	var rows []ui.ServiceRow
+	require.NoError(t, json.Unmarshal([]byte(out), &rows), "stdout should be pure JSON: %q", out)
+	health := make(map[string]string, len(rows))
+	for _, row := range rows {
+		health[row.Service] = row.Health
+	}
+	assert.Equal(t, map[string]string{"api": "healthy", "cache": "unhealthy", "web": ""}, health)
+}
+
+func TestRunPS_ReportsNativeHealth(t *testing.T) {
+	writeTestConfig(t, psTestConfig)
+	fake, _ := dockertest.NewServer(t)
+	fake.AddContainer("aaaaaaaaaaaa", "shop", "api", "Up 5 minutes")
+	fake.Handle(http.MethodGet, "/containers/aaaaaaaaaaaa/json", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"Id": "aaaaaaaaaaaa", "State": {"Status": "running", "Running": true, "Health": {"Status": "unhealthy"}}}`))
+	})
+
+	out := captureStdout(t, func() {
+		require.NoError(t, runPS(false, false, psSortName, psFormatTable))
+	})
+
+	assert.Contains(t, out, "Running (unhealthy)")
+}
+
+// ============================================================================
+// Watch Tests
+// ============================================================================
+
+// fakePSStatus returns numbered views ("view 1", "view 2", ...) and counts its calls
+type fakePSStatus struct {
+	calls int
+	err   error // Returned from the second call onwards
+}
+
+func (f *fakePSStatus) status(ctx context.Context) (string, error) {
+	f.calls++
+	if f.err != nil && f.calls > 1 {
+		return "", f.err
+	}
+	return fmt.Sprintf("view %d", f.calls), nil
+}
+
+// runWatchPS runs watchPS in the background, returning channels to drive it and collect its result
+func runWatchPS(ctx context.Context, fake *fakePSStatus) (chan time.Time, chan os.Signal, <-chan string, <-chan error) {
+	ticks := make(chan time.Time)
+	resize := make(chan os.Signal)
+	draws := make(chan string, 10)
+	done := make(chan error, 1)
+
+	go func() {
+		done <- watchPS(ctx, ticks, resize, fake.status, func(view string) { draws <- view })
+	}()
+	return ticks, resize, draws, done
+}
+
+func TestWatchPS_RefreshesOnEachTick(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	fake := &fakePSStatus{}
+	ticks, _, draws, done := runWatchPS(ctx, fake)
+
+	assert.Equal(t, "view 1", <-draws, "draws immediately, before the first tick")
+	ticks <- time.Now()
+	assert.Equal(t, "view 2", <-draws)
+	ticks <- time.Now()
+	assert.Equal(t, "view 3", <-draws)
+
+	cancel()
+	require.NoError(t, <-done)
+	assert.Equal(t, 3, fake.calls)
+}
+
+func TestWatchPS_RedrawsOnResizeWithoutRefreshing(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	fake := &fakePSStatus{}
+	_, resize, draws, done := runWatchPS(ctx, fake)
+
+	assert.Equal(t, "view 1", <-draws)
+	resize <- os.Interrupt // Any signal; the real channel carries SIGWINCH
+	assert.Equal(t, "view 1", <-draws)
+
+	cancel()
+	require.NoError(t, <-done)
+	assert.Equal(t, 1, fake.calls)
+}
+
+func TestWatchPS_StopsWhenCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	fake := &fakePSStatus{}
+	_, _, draws, done := runWatchPS(ctx, fake)
+
+	require.NoError(t, <-done)
+	assert.Equal(t, "view 1", <-draws)
+}
+
+func TestWatchPS_ReturnsStatusError(t *testing.T) {
+	fake := &fakePSStatus{err: errors.New("daemon went away")}
+	ticks, _, draws, done := runWatchPS(context.Background(), fake)
+
+	assert.Equal(t, "view 1", <-draws)
+	ticks <- time.Now()
+	assert.EqualError(t, <-done, "daemon went away")
+}
+
+func TestClearScreen_SkippedWhenNotATerminal(t *testing.T) {
+	out := captureStdout(t, clearScreen)
+
+	assert.Empty(t, out, "piped output must not contain escape sequences")
+}
+
+func TestRunPSWatch_RejectsInvalidInterval(t *testing.T) {
+	err := runPSWatch(false, psSortName, psFormatTable, 0)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "Invalid refresh interval")
+}
@@ -0,0 +1,190 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/ork-cli/ork/internal/config"
+	"github.com/ork-cli/ork/internal/docker"
+	"github.com/ork-cli/ork/internal/service"
+	"github.com/ork-cli/ork/internal/ui"
+	"github.com/ork-cli/ork/pkg/utils"
+	"github.com/spf13/cobra"
+)
+
+// ============================================================================
+// Type Definitions
+// ============================================================================
+
+// imagePull is one image to pull, with the services that use it
+type imagePull struct {
+	Image    string
+	Services []string
+}
+
+// ============================================================================
+// Cobra Command Definition
+// ============================================================================
+
+var pullCmd = &cobra.Command{
+	Use:   "pull [service...]",
+	Short: "Pull the images used by services, without starting them",
+	Long: `
+Pull the latest version of each service's image, so a later 'ork up'
+starts without waiting on downloads.
+
+With no arguments, the images of all services are pulled. Images are pulled
+in parallel, up to max_parallel at a time (default 4), and an image shared
+by several services is only pulled once. Services that use a 'build'
+section have no image to pull and are skipped.`,
+	Example: `
+ork pull                     Pull the images of all services
+ork pull api web             Pull the images of specific services`,
+
+	Run: func(cmd *cobra.Command, args []string) {
+		if err := runPull(args); err != nil {
+			handleUpError(err)
+			return
+		}
+	},
+}
+
+func init() {
+	// Register the 'pull' command with the root command
+	rootCmd.AddCommand(pullCmd)
+}
+
+// ============================================================================
+// Main Orchestrator
+// ============================================================================
+
+// runPull pulls the images of the selected image-based services in parallel
+func runPull(serviceNames []string) error {
+	// Pulling reaches out to the registry, which offline mode forbids
+	if noPull {
+		return utils.ConfigError(
+			"pull.offline",
+			"Cannot pull images with --no-pull",
+			"Drop --no-pull (or unset ORK_OFFLINE) to pull images",
+			nil,
+		)
+	}
+
+	cfg, err := loadAndValidateConfig()
+	if err != nil {
+		return err
+	}
+
+	if err := validateServiceNames(serviceNames, cfg); err != nil {
+		return err
+	}
+
+	pulls, skipped := selectPullImages(cfg, serviceNames)
+	for _, name := range skipped {
+		ui.Info(fmt.Sprintf("Skipping %s %s", ui.Bold(name), ui.Dim("(built from source, nothing to pull)")))
+	}
+	if len(pulls) == 0 {
+		ui.Warning("No services with an image to pull")
+		return nil
+	}
+
+	dockerClient, err := createDockerClient()
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if closeErr := dockerClient.Close(); closeErr != nil {
+			ui.Warning(fmt.Sprintf("Failed to close Docker client: %v", closeErr))
+		}
+	}()
+
+	failed := pullImagesInParallel(context.Background(), dockerClient, pulls, cfg.MaxParallel)
+	if len(failed) > 0 {
+		return utils.DockerError(
+			"pull.image",
+			fmt.Sprintf("Failed to pull %d of %d image(s): %s", len(failed), len(pulls), strings.Join(failed, ", ")),
+			"Check the image names and your registry access",
+			nil,
+		)
+	}
+
+	ui.EmptyLine()
+	ui.Success(fmt.Sprintf("Pulled %d image(s)", len(pulls)))
+	return nil
+}
+
+// ============================================================================
+// Private Helpers
+// ============================================================================
+
+// selectPullImages groups the requested image-based services by image, and lists the build-based ones
+// With no names given, all services are considered; both results are sorted
+func selectPullImages(cfg *config.Config, serviceNames []string) (pulls []imagePull, skipped []string) {
+	if len(serviceNames) == 0 {
+		serviceNames = getAvailableServicesList(cfg)
+	}
+
+	byImage := make(map[string][]string)
+	for _, name := range serviceNames {
+		svc := cfg.Services[name]
+		if svc.Build != nil {
+			skipped = append(skipped, name)
+			continue
+		}
+		byImage[svc.Image] = append(byImage[svc.Image], name)
+	}
+
+	for imageName, services := range byImage {
+		sort.Strings(services)
+		pulls = append(pulls, imagePull{Image: imageName, Services: services})
+	}
+
+	sort.Slice(pulls, func(i, j int) bool { return pulls[i].Image < pulls[j].Image })
+	sort.Strings(skipped)
+	return pulls, skipped
+}
+
+// pullImagesInParallel pulls each image with its own spinner, at most maxParallel at once
+// Returns the images that failed to pull, sorted
+func pullImagesInParallel(ctx context.Context, client *docker.Client, pulls []imagePull, maxParallel int) []string {
+	if maxParallel <= 0 {
+		maxParallel = service.DefaultMaxParallel
+	}
+
+	var wg sync.WaitGroup
+	var mu sync.Mutex // Protects failed
+	var failed []string
+	semaphore := make(chan struct{}, maxParallel)
+
+	for _, pull := range pulls {
+		wg.Add(1)
+		go func(pull imagePull) {
+			defer wg.Done()
+
+			// Wait for a free slot
+			semaphore <- struct{}{}
+			defer func() { <-semaphore }()
+
+			label := fmt.Sprintf("%s %s", ui.Bold(strings.Join(pull.Services, ", ")), ui.Dim(pull.Image))
+			spinner := ui.ShowSpinner(fmt.Sprintf("Pulling %s", label))
+			err := client.PullImageWithProgress(ctx, pull.Image, func(status string) {
+				spinner.UpdateMessage(fmt.Sprintf("Pulling %s %s", label, ui.Dim(status)))
+			})
+			if err != nil {
+				spinner.Error(fmt.Sprintf("Failed to pull %s: %v", label, err))
+				mu.Lock()
+				failed = append(failed, pull.Image)
+				mu.Unlock()
+				return
+			}
+			spinner.Success(fmt.Sprintf("Pulled %s", label))
+		}(pull)
+	}
+
+	wg.Wait()
+	sort.Strings(failed)
+	return failed
+}
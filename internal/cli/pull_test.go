@@ -0,0 +1,105 @@
+package cli
+
+import (
+	"testing"
+
+	"github.com/ork-cli/ork/internal/config"
+	"github.com/ork-cli/ork/internal/docker/dockertest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const pullTestConfig = `version: "1.0"
+project: shop
+services:
+  api:
+    build:
+      context: .
+  web:
+    image: nginx:alpine
+  proxy:
+    image: nginx:alpine
+  db:
+    image: postgres:15
+`
+
+// pullSelectionConfig returns a config mixing build-based services and image services sharing an image
+func pullSelectionConfig() *config.Config {
+	return &config.Config{
+		Project: "shop",
+		Services: map[string]config.Service{
+			"api":   {Build: &config.Build{Context: "./api"}},
+			"web":   {Image: "nginx:alpine"},
+			"proxy": {Image: "nginx:alpine"},
+			"db":    {Image: "postgres:15"},
+		},
+	}
+}
+
+// ============================================================================
+// Service Selection Tests
+// ============================================================================
+
+func TestSelectPullImages_AllServices(t *testing.T) {
+	pulls, skipped := selectPullImages(pullSelectionConfig(), nil)
+
+	assert.Equal(t, []imagePull{
+		{Image: "nginx:alpine", Services: []string{"proxy", "web"}},
+		{Image: "postgres:15", Services: []string{"db"}},
+	}, pulls, "a shared image is pulled once")
+	assert.Equal(t, []string{"api"}, skipped)
+}
+
+func TestSelectPullImages_Subset(t *testing.T) {
+	pulls, skipped := selectPullImages(pullSelectionConfig(), []string{"db", "api"})
+
+	assert.Equal(t, []imagePull{{Image: "postgres:15", Services: []string{"db"}}}, pulls)
+	assert.Equal(t, []string{"api"}, skipped)
+}
+
+func TestSelectPullImages_OnlyBuilds(t *testing.T) {
+	pulls, skipped := selectPullImages(pullSelectionConfig(), []string{"api"})
+
+	assert.Empty(t, pulls)
+	assert.Equal(t, []string{"api"}, skipped)
+}
+
+// ============================================================================
+// Command Tests
+// ============================================================================
+
+func TestRunPull_PullsEachImageOnce(t *testing.T) {
+	writeTestConfig(t, pullTestConfig)
+	fake, _ := dockertest.NewServer(t)
+
+	out := captureStdout(t, func() {
+		require.NoError(t, runPull(nil))
+	})
+
+	assert.Equal(t, 2, fake.RequestCount("POST /images/create"), "nginx:alpine is shared, postgres:15 is separate")
+	assert.Contains(t, out, "Skipping api")
+	assert.False(t, fake.HasRequest("POST /containers/create"), "pull never starts anything")
+	assert.False(t, fake.HasRequest("POST /build"), "build services are not built")
+}
+
+func TestRunPull_OnlyBuildServices(t *testing.T) {
+	writeTestConfig(t, pullTestConfig)
+	fake, _ := dockertest.NewServer(t)
+
+	out := captureStdout(t, func() {
+		require.NoError(t, runPull([]string{"api"}))
+	})
+
+	assert.Contains(t, out, "No services with an image to pull")
+	assert.False(t, fake.HasRequest("POST /images/create"))
+}
+
+func TestRunPull_RejectsOfflineMode(t *testing.T) {
+	writeTestConfig(t, pullTestConfig)
+	noPull = true
+	t.Cleanup(func() { noPull = false })
+
+	err := runPull(nil)
+
+	assert.ErrorContains(t, err, "--no-pull")
+}
@@ -0,0 +1,6 @@
+package cli
+
+import "os"
+
+// notifyResize is a no-op on Windows, which has no resize signal; the next refresh redraws instead
+func notifyResize(ch chan<- os.Signal) {}
@@ -3,6 +3,9 @@ package cli
 import (
 	"context"
 	"fmt"
+	"os"
+	"strings"
+	"time"
 
 	"github.com/ork-cli/ork/internal/config"
 	"github.com/ork-cli/ork/internal/docker"
@@ -30,18 +33,59 @@ configuration, picking up changes to:
   - Commands and entrypoints
   - Build configuration (with --force-rebuild)
 
-Only the specified services are restarted - dependencies are not affected.`,
+Use --pull to fetch the latest version of each service's image before
+recreating it (useful for tags like :latest that move upstream). The pull
+happens before the old container is stopped, so a failed pull leaves it running.
+
+Named volumes are kept when the old container is removed, so the recreated
+container mounts the same data. Bind mounts whose host path no longer exists
+are warned about, since Docker would mount an empty directory in its place.
+
+The old container gets the service's stop_timeout (default 10s) to shut down
+gracefully before it is killed, after its stop_grace_period if it has one;
+--timeout overrides both for every service.
+
+Services with a health check are waited on until they report healthy, so
+restart only returns once they are ready; --no-wait returns as soon as the
+new containers have started.
+
+Only the specified services are restarted - dependencies are not affected.
+When several services are given, they are restarted in dependency order
+(dependencies first), regardless of the order they were listed in.
+Use --env to override environment variables for the recreated containers
+without editing ork.yml (overrides take precedence over every other source).`,
 	Example: `
 ork restart api                  Restart API service
 ork restart api frontend         Restart multiple services
-ork restart api --force-rebuild  Rebuild image from source before restarting`,
+ork restart api --force-rebuild  Rebuild image from source before restarting
+ork restart api --pull           Pull the latest image before restarting
+ork restart api --timeout 1m     Give the old container a minute to shut down
+ork restart api --dry-run        Show what would be restarted without changing anything
+ork restart api --no-wait        Return without waiting for the health check to pass
+ork restart api -e DEBUG=true    Restart with an environment override (repeatable)`,
 
 	Args: cobra.MinimumNArgs(1), // Require at least one service name
 	Run: func(cmd *cobra.Command, args []string) {
 		// Get flags
 		forceRebuild, _ := cmd.Flags().GetBool("force-rebuild")
+		pull, _ := cmd.Flags().GetBool("pull")
+		dryRun, _ := cmd.Flags().GetBool("dry-run")
+		noWait, _ := cmd.Flags().GetBool("no-wait")
+		envFlags, _ := cmd.Flags().GetStringArray("env")
 
-		if err := runRestart(args, forceRebuild); err != nil {
+		envOverrides, err := parseEnvOverrides(envFlags)
+		if err != nil {
+			handleRestartError(err)
+			return
+		}
+
+		stopTimeout, err := stopTimeoutOverride(cmd)
+		if err != nil {
+			handleRestartError(err)
+			return
+		}
+
+		if err := runRestart(args, forceRebuild, pull, dryRun, !noWait, envOverrides, stopTimeout); err != nil {
 			handleRestartError(err)
 			return
 		}
@@ -54,6 +98,11 @@ func init() {
 
 	// Add flags
 	restartCmd.Flags().Bool("force-rebuild", false, "Force rebuild image even if no changes detected")
+	restartCmd.Flags().Bool("pull", false, "Pull the latest image before recreating (image-based services)")
+	restartCmd.Flags().Bool("dry-run", false, "Print the restart plan without touching any containers")
+	restartCmd.Flags().Bool("no-wait", false, "Don't wait for restarted services to become healthy")
+	restartCmd.Flags().StringArrayP("env", "e", nil, "Override an environment variable (KEY=VALUE, repeatable)")
+	restartCmd.Flags().Duration("timeout", 0, "Time the old container gets to shut down before being killed (default: stop_timeout, or 10s)")
 }
 
 // ============================================================================
@@ -61,7 +110,21 @@ func init() {
 // ============================================================================
 
 // runRestart orchestrates the service restart process
-func runRestart(serviceNames []string, forceRebuild bool) error {
+// envOverrides are merged into each restarted service's env with the highest precedence
+// With pull, image-based services get a fresh pull of their image before being recreated
+// A non-nil stopTimeout replaces every service's stop_timeout when stopping the old containers
+// With wait, each service with a health check must become healthy before the next one restarts
+func runRestart(serviceNames []string, forceRebuild, pull, dryRun, wait bool, envOverrides map[string]string, stopTimeout *time.Duration) error {
+	// --pull reaches out to the registry, which offline mode forbids
+	if pull && noPull {
+		return utils.ConfigError(
+			"restart.pull",
+			"Cannot use --pull together with --no-pull",
+			"Drop one of the flags (or unset ORK_OFFLINE)",
+			nil,
+		)
+	}
+
 	// Load and validate configuration (fresh read to detect changes)
 	cfg, err := loadAndValidateConfig()
 	if err != nil {
@@ -73,6 +136,15 @@ func runRestart(serviceNames []string, forceRebuild bool) error {
 		return err
 	}
 
+	// Restart dependencies before their dependents, whatever order they were given in
+	serviceNames, err = restartOrder(cfg, serviceNames)
+	if err != nil {
+		return err
+	}
+
+	// Apply ad-hoc env overrides to the services being restarted
+	applyEnvOverrides(cfg, serviceNames, envOverrides)
+
 	// Create a Docker client
 	dockerClient, err := createDockerClient()
 	if err != nil {
@@ -84,8 +156,18 @@ func runRestart(serviceNames []string, forceRebuild bool) error {
 		}
 	}()
 
-	// Get the network ID for the project
+	// In dry-run mode, only inspect current state and print the plan
 	ctx := context.Background()
+	if dryRun {
+		plan, err := planRestart(ctx, cfg, serviceNames, dockerClient, forceRebuild, pull)
+		if err != nil {
+			return err
+		}
+		printRestartPlan(cfg, plan)
+		return nil
+	}
+
+	// Get the network ID for the project
 	networkID, err := getProjectNetworkID(ctx, dockerClient, cfg.Project)
 	if err != nil {
 		// If the network doesn't exist, we'll need to create it when restarting
@@ -101,7 +183,7 @@ func runRestart(serviceNames []string, forceRebuild bool) error {
 
 	// Restart each service
 	for _, serviceName := range serviceNames {
-		if err := restartService(ctx, cfg, serviceName, dockerClient, networkID, forceRebuild); err != nil {
+		if err := restartService(ctx, cfg, serviceName, dockerClient, networkID, forceRebuild, pull, wait, stopTimeout); err != nil {
 			return err
 		}
 	}
@@ -112,44 +194,125 @@ func runRestart(serviceNames []string, forceRebuild bool) error {
 }
 
 // ============================================================================
-// Private Helpers - Service Restart Logic
+// Private Helpers - Restart Order
 // ============================================================================
 
-// restartService restarts a single service with smart config change detection
-func restartService(ctx context.Context, cfg *config.Config, serviceName string, client *docker.Client, networkID string, forceRebuild bool) error {
-	newServiceCfg := cfg.Services[serviceName]
-
-	// Get the current running container (if any)
-	containers, err := client.List(ctx, cfg.Project)
+// restartOrder sorts the requested services into dependency order, dropping duplicates
+// Only the requested services are returned - dependencies are used for ordering but not restarted
+func restartOrder(cfg *config.Config, serviceNames []string) ([]string, error) {
+	ordered, err := service.ResolveDependencies(cfg.Services, serviceNames)
 	if err != nil {
-		return utils.DockerError(
-			"restart.list",
-			"Failed to list containers",
-			"Try running 'ork doctor' to diagnose issues",
+		return nil, utils.ServiceError(
+			"restart.dependencies",
+			"Failed to resolve service dependencies",
+			"Check your service dependencies in ork.yml",
 			err,
 		)
 	}
 
-	var currentContainer *docker.ContainerInfo
-	for _, container := range containers {
-		if container.Labels["ork.service"] == serviceName {
-			currentContainer = &container
-			break
+	requested := make(map[string]bool, len(serviceNames))
+	for _, name := range serviceNames {
+		requested[name] = true
+	}
+
+	restricted := make([]string, 0, len(requested))
+	for _, name := range ordered {
+		if requested[name] {
+			restricted = append(restricted, name)
 		}
 	}
+	return restricted, nil
+}
+
+// ============================================================================
+// Private Helpers - Env Overrides
+// ============================================================================
+
+// parseEnvOverrides parses repeated --env KEY=VALUE flags into a map
+// Later flags win when a key is repeated; values may contain '=' and may be empty
+func parseEnvOverrides(flags []string) (map[string]string, error) {
+	if len(flags) == 0 {
+		return nil, nil
+	}
+
+	overrides := make(map[string]string, len(flags))
+	for _, flag := range flags {
+		key, value, ok := strings.Cut(flag, "=")
+		key = strings.TrimSpace(key)
+		if !ok || key == "" {
+			return nil, utils.ConfigError(
+				"restart.env",
+				fmt.Sprintf("Invalid --env value '%s'", flag),
+				"Use the form --env KEY=VALUE",
+				nil,
+			)
+		}
+		overrides[key] = value
+	}
+
+	return overrides, nil
+}
+
+// applyEnvOverrides merges env overrides into the config of each named service
+// The service env maps are copied, so the loaded config's maps are never mutated in place
+func applyEnvOverrides(cfg *config.Config, serviceNames []string, overrides map[string]string) {
+	if len(overrides) == 0 {
+		return
+	}
+
+	for _, name := range serviceNames {
+		svc := cfg.Services[name]
+
+		env := make(map[string]string, len(svc.Env)+len(overrides))
+		for key, value := range svc.Env {
+			env[key] = value
+		}
+		for key, value := range overrides {
+			env[key] = value
+		}
+
+		svc.Env = env
+		cfg.Services[name] = svc
+	}
+}
+
+// ============================================================================
+// Private Helpers - Service Restart Logic
+// ============================================================================
+
+// restartService restarts a single service with smart config change detection
+func restartService(ctx context.Context, cfg *config.Config, serviceName string, client *docker.Client, networkID string, forceRebuild, pull, wait bool, stopTimeout *time.Duration) error {
+	newServiceCfg := cfg.Services[serviceName]
+	warnMissingBindSources(serviceName, newServiceCfg)
+
+	// Pull before stopping anything, so a failed pull leaves the current container running
+	if pull {
+		if err := pullServiceImage(ctx, client, serviceName, newServiceCfg); err != nil {
+			return err
+		}
+	}
+
+	// Get the current running container (if any)
+	currentContainer, err := findServiceContainer(ctx, client, cfg.Project, serviceName)
+	if err != nil {
+		return err
+	}
 
 	// If the service is not running, just start it
 	if currentContainer == nil {
 		ui.Info(fmt.Sprintf("%s is not running, starting it...", ui.Bold(serviceName)))
-		return startSingleService(ctx, cfg, serviceName, client, networkID)
+		return startSingleService(ctx, cfg, serviceName, client, networkID, forceRebuild, wait)
 	}
 
+	// Show what changed since the container was created
+	printConfigChanges(serviceName, currentContainer.Labels, newServiceCfg)
+
 	// Determine if we need to rebuild the image
-	needsRebuild := forceRebuild || newServiceCfg.Build != nil
+	needsRebuild := serviceNeedsRebuild(newServiceCfg, forceRebuild)
 
 	// Stop the current container
 	spinner := ui.ShowSpinner(fmt.Sprintf("Stopping %s", ui.Bold(serviceName)))
-	if err := client.StopAndRemove(ctx, currentContainer.ID); err != nil {
+	if err := client.StopAndRemove(ctx, currentContainer.ID, resolveStopOptions(stopTimeout, newServiceCfg)); err != nil {
 		spinner.Error(fmt.Sprintf("Failed to stop %s", serviceName))
 		return utils.DockerError(
 			"restart.stop",
@@ -160,17 +323,104 @@ func restartService(ctx context.Context, cfg *config.Config, serviceName string,
 	}
 	spinner.Success(fmt.Sprintf("Stopped %s", ui.Bold(serviceName)))
 
-	// TODO: Handle rebuild if needsRebuild is true (Phase 5 - build from source)
-	if needsRebuild {
-		ui.Warning("Build from source not yet implemented, will use image instead")
+	// Build-based services are rebuilt when the service starts; image-based ones have nothing to build
+	if needsRebuild && newServiceCfg.Build == nil {
+		ui.Info(fmt.Sprintf("%s uses an image, nothing to rebuild", ui.Bold(serviceName)))
+	}
+
+	// Create and start the new container (rebuilding the image first if needed)
+	return startSingleService(ctx, cfg, serviceName, client, networkID, forceRebuild, wait)
+}
+
+// pullServiceImage pulls the latest version of a service's image
+// Build-based services have no image to pull, so they are skipped
+func pullServiceImage(ctx context.Context, client *docker.Client, serviceName string, serviceCfg config.Service) error {
+	if serviceCfg.Build != nil {
+		ui.Info(fmt.Sprintf("%s is built from source, nothing to pull", ui.Bold(serviceName)))
+		return nil
 	}
 
-	// Create and start the new container
-	return startSingleService(ctx, cfg, serviceName, client, networkID)
+	if err := client.PullImage(ctx, serviceCfg.Image); err != nil {
+		return utils.DockerError(
+			"restart.pull",
+			fmt.Sprintf("Failed to pull image %s for %s", serviceCfg.Image, serviceName),
+			"Check the image name and your registry access - the running container was left untouched",
+			err,
+		)
+	}
+	return nil
+}
+
+// findServiceContainer returns the current container for a service, or nil if there is none
+func findServiceContainer(ctx context.Context, client *docker.Client, projectName, serviceName string) (*docker.ContainerInfo, error) {
+	containers, err := client.ListByService(ctx, projectName, serviceName)
+	if err != nil {
+		return nil, utils.DockerError(
+			"restart.list",
+			"Failed to list containers",
+			"Try running 'ork doctor' to diagnose issues",
+			err,
+		)
+	}
+
+	if len(containers) == 0 {
+		return nil, nil
+	}
+	return &containers[0], nil
+}
+
+// printConfigChanges prints a concise diff between a container's recorded config and the new config
+// Containers created before config labels existed are skipped silently
+func printConfigChanges(serviceName string, labels map[string]string, newServiceCfg config.Service) {
+	diff, ok := service.DiffConfig(labels, newServiceCfg)
+	if !ok {
+		return
+	}
+
+	if diff.IsEmpty() {
+		ui.Info(fmt.Sprintf("No config changes detected for %s", ui.Bold(serviceName)))
+		return
+	}
+
+	ui.Info(fmt.Sprintf("Config changes detected for %s:", ui.Bold(serviceName)))
+	for _, line := range diff.Lines() {
+		ui.ListItem(ui.SymbolArrow, line)
+	}
+}
+
+// warnMissingBindSources warns about each bind mount whose host path doesn't exist
+// Docker would create an empty directory in its place, hiding the data the service expects
+func warnMissingBindSources(serviceName string, serviceCfg config.Service) {
+	for _, source := range missingBindSources(serviceCfg) {
+		ui.Warning(fmt.Sprintf("%s mounts %s, which no longer exists - Docker will mount an empty directory there", ui.Bold(serviceName), source))
+	}
+}
+
+// missingBindSources returns the host paths of a service's bind mounts that don't exist, in declaration order
+// Named volumes are managed by Docker and are never reported
+func missingBindSources(serviceCfg config.Service) []string {
+	var missing []string
+	for _, spec := range serviceCfg.Volumes {
+		mount, err := config.ParseVolume(spec)
+		if err != nil || mount.IsNamedVolume() {
+			continue
+		}
+		if _, err := os.Stat(mount.Source); os.IsNotExist(err) {
+			missing = append(missing, mount.Source)
+		}
+	}
+	return missing
+}
+
+// serviceNeedsRebuild reports whether restarting a service should rebuild its image
+func serviceNeedsRebuild(serviceCfg config.Service, forceRebuild bool) bool {
+	return forceRebuild || serviceCfg.Build != nil
 }
 
 // startSingleService starts a single service (helper for restart)
-func startSingleService(ctx context.Context, cfg *config.Config, serviceName string, client *docker.Client, networkID string) error {
+// With forceRebuild, a build-based service is rebuilt even when hash_tag finds an image for unchanged inputs
+// With wait, it blocks until the service's health check passes (services without one return right away)
+func startSingleService(ctx context.Context, cfg *config.Config, serviceName string, client *docker.Client, networkID string, forceRebuild, wait bool) error {
 	// If we don't have a network ID, create the network
 	if networkID == "" {
 		spinner := ui.ShowSpinner("Creating project network...")
@@ -190,6 +440,8 @@ func startSingleService(ctx context.Context, cfg *config.Config, serviceName str
 
 	// Create a service instance
 	svc := service.New(serviceName, cfg.Project, cfg.Services[serviceName])
+	svc.BaseDir = cfg.BaseDir
+	svc.Rebuild = forceRebuild
 
 	// Start the service
 	spinner := ui.ShowSpinner(fmt.Sprintf("Starting %s", ui.Bold(serviceName)))
@@ -209,9 +461,126 @@ func startSingleService(ctx context.Context, cfg *config.Config, serviceName str
 	}
 	spinner.Success(fmt.Sprintf("Started %s %s", ui.Bold(serviceName), ui.Dim(containerID)))
 
+	if !wait || !svc.NeedsHealthWait() {
+		return nil
+	}
+
+	// Wait for the recreated container to report healthy
+	spinner = ui.ShowSpinner(fmt.Sprintf("Waiting for %s to become healthy", ui.Bold(serviceName)))
+	if err := service.WaitForHealthy(ctx, client, svc); err != nil {
+		spinner.Error(fmt.Sprintf("%s did not become healthy", serviceName))
+		return utils.ServiceError(
+			"restart.health",
+			fmt.Sprintf("Service %s started but did not become healthy", serviceName),
+			"Check logs with 'ork logs "+serviceName+"', or use --no-wait to skip waiting",
+			err,
+		)
+	}
+	spinner.Success(fmt.Sprintf("%s is healthy", ui.Bold(serviceName)))
+
 	return nil
 }
 
+// ============================================================================
+// Private Helpers - Dry Run
+// ============================================================================
+
+// restartPlan describes what restart would do for a single service
+type restartPlan struct {
+	serviceName   string
+	containerID   string   // Current container to stop and remove (empty if not running)
+	rebuild       bool     // Whether the image would be rebuilt
+	pull          bool     // Whether the image would be pulled first
+	changes       []string // Config changes since the current container was created
+	image         string   // Image the new container would run
+	createNetwork bool     // Whether the project network would be created first
+}
+
+// planRestart inspects the current containers and network and builds a restart plan
+// It only performs read-only Docker calls, so nothing is stopped, started, or created
+func planRestart(ctx context.Context, cfg *config.Config, serviceNames []string, client *docker.Client, forceRebuild, pull bool) ([]restartPlan, error) {
+	_, networkErr := getProjectNetworkID(ctx, client, cfg.Project)
+	needsNetwork := networkErr != nil
+
+	plans := make([]restartPlan, 0, len(serviceNames))
+	for _, serviceName := range serviceNames {
+		serviceCfg := cfg.Services[serviceName]
+
+		currentContainer, err := findServiceContainer(ctx, client, cfg.Project, serviceName)
+		if err != nil {
+			return nil, err
+		}
+
+		// Build-based services run the tag their build produces, not an image from ork.yml
+		image := serviceCfg.Image
+		if serviceCfg.Build != nil {
+			image = docker.ImageTag(cfg.Project, serviceName)
+		}
+
+		plan := restartPlan{
+			serviceName:   serviceName,
+			image:         image,
+			pull:          pull && serviceCfg.Build == nil,
+			rebuild:       serviceCfg.Build != nil, // Starting a build-based service always builds it
+			createNetwork: needsNetwork,
+		}
+		if currentContainer != nil {
+			plan.containerID = currentContainer.ID
+			plan.rebuild = serviceNeedsRebuild(serviceCfg, forceRebuild)
+			if diff, ok := service.DiffConfig(currentContainer.Labels, serviceCfg); ok {
+				plan.changes = diff.Lines()
+			}
+		}
+
+		// The network only needs to be created once, before the first service starts
+		needsNetwork = false
+		plans = append(plans, plan)
+	}
+
+	return plans, nil
+}
+
+// printRestartPlan displays the steps restart would perform
+func printRestartPlan(cfg *config.Config, plans []restartPlan) {
+	ui.EmptyLine()
+	ui.Info(fmt.Sprintf("Project: %s (v%s)", ui.Bold(cfg.Project), cfg.Version))
+	ui.Info(fmt.Sprintf("Dry run - restart plan for %d service(s), no changes will be made", len(plans)))
+	ui.EmptyLine()
+
+	for _, plan := range plans {
+		ui.Subheader(plan.serviceName)
+		for _, step := range plan.steps() {
+			ui.ListItem(ui.SymbolArrow, step)
+		}
+	}
+}
+
+// steps returns the human-readable steps of a restart plan in execution order
+func (p restartPlan) steps() []string {
+	var steps []string
+
+	if p.pull {
+		steps = append(steps, fmt.Sprintf("Pull latest %s", ui.Highlight(p.image)))
+	}
+	if p.containerID != "" {
+		steps = append(steps, fmt.Sprintf("Stop and remove container %s", ui.Dim(p.containerID)))
+		for _, change := range p.changes {
+			steps = append(steps, "Apply config change: "+change)
+		}
+	} else {
+		steps = append(steps, "Not running - would be started fresh")
+	}
+	if p.rebuild {
+		steps = append(steps, "Rebuild image from source")
+	}
+	if p.createNetwork {
+		steps = append(steps, "Create project network")
+	}
+	steps = append(steps, fmt.Sprintf("Start new container from %s", ui.Highlight(p.image)))
+
+	return steps
+}
+
 // ============================================================================
 // Private Helpers - Network Operations
 // ============================================================================
@@ -223,6 +592,8 @@ func getProjectNetworkID(ctx context.Context, client *docker.Client, projectName
 
 // handleRestartError formats and displays errors with hints
 func handleRestartError(err error) {
+	commandErr = err
+
 	if orkErr, ok := err.(*utils.OrkError); ok {
 		// Display structured error with hints
 		ui.Error(orkErr.Message)
@@ -0,0 +1,536 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/ork-cli/ork/internal/config"
+	"github.com/ork-cli/ork/internal/docker/dockertest"
+	"github.com/ork-cli/ork/internal/service"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// ============================================================================
+// Test Helpers
+// ============================================================================
+
+const restartTestConfig = `version: "1.0"
+project: shop
+services:
+  api:
+    image: node:18-alpine
+    ports:
+      - "8080:8080"
+  web:
+    build:
+      context: ./web
+`
+
+// writeTestConfig writes an ork.yml into a fresh temp directory and makes it the working directory
+func writeTestConfig(t *testing.T, contents string) {
+	t.Helper()
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "ork.yml"), []byte(contents), 0o644))
+	t.Chdir(dir)
+}
+
+// captureStdout runs fn and returns everything it wrote to stdout
+func captureStdout(t *testing.T, fn func()) string {
+	t.Helper()
+
+	r, w, err := os.Pipe()
+	require.NoError(t, err)
+
+	original := os.Stdout
+	os.Stdout = w
+	defer func() { os.Stdout = original }()
+
+	fn()
+
+	require.NoError(t, w.Close())
+	out, err := io.ReadAll(r)
+	require.NoError(t, err)
+	return string(out)
+}
+
+// ============================================================================
+// Dry Run Tests
+// ============================================================================
+
+func TestRunRestart_DryRunMakesNoMutations(t *testing.T) {
+	writeTestConfig(t, restartTestConfig)
+	fake, _ := dockertest.NewServer(t)
+	fake.AddContainer("aaaaaaaaaaaa", "shop", "api", "Up 5 minutes")
+	fake.AddNetwork("ork-shop-network", "net-1")
+
+	var err error
+	out := captureStdout(t, func() {
+		err = runRestart([]string{"api"}, false, false, true, true, nil, nil)
+	})
+	require.NoError(t, err)
+
+	assert.Empty(t, fake.Mutations(), "dry run must not stop, remove, create, or start anything")
+	assert.Contains(t, out, "Dry run")
+	assert.Contains(t, out, "Stop and remove container")
+	assert.Contains(t, out, "aaaaaaaaaaaa")
+	assert.Contains(t, out, "node:18-alpine")
+}
+
+func TestRunRestart_DryRunShowsBuildTag(t *testing.T) {
+	writeTestConfig(t, restartTestConfig)
+	fake, _ := dockertest.NewServer(t)
+	fake.AddNetwork("ork-shop-network", "net-1")
+
+	out := captureStdout(t, func() {
+		require.NoError(t, runRestart([]string{"web"}, false, false, true, true, nil, nil))
+	})
+
+	assert.Contains(t, out, "Rebuild image from source")
+	assert.Contains(t, out, "Start new container from ork-shop-web")
+}
+
+func TestRunRestart_WithoutDryRunStopsAndStarts(t *testing.T) {
+	writeTestConfig(t, restartTestConfig)
+	fake, _ := dockertest.NewServer(t)
+	fake.AddContainer("aaaaaaaaaaaa", "shop", "api", "Up 5 minutes")
+	fake.AddNetwork("ork-shop-network", "net-1")
+
+	var err error
+	captureStdout(t, func() {
+		err = runRestart([]string{"api"}, false, false, false, true, nil, nil)
+	})
+	require.NoError(t, err)
+
+	assert.True(t, fake.HasRequest("POST /containers/aaaaaaaaaaaa/stop"))
+	assert.True(t, fake.HasRequest("POST /containers/create"))
+}
+
+// ============================================================================
+// Restart Order Tests
+// ============================================================================
+
+const restartOrderTestConfig = `version: "1.0"
+project: shop
+services:
+  db:
+    image: postgres:15
+  cache:
+    image: redis:7
+    depends_on: [db]
+  api:
+    image: node:18-alpine
+    depends_on: [cache]
+  frontend:
+    image: nginx:alpine
+    depends_on: [api]
+`
+
+func TestRestartOrder_SortsJumbledArguments(t *testing.T) {
+	writeTestConfig(t, restartOrderTestConfig)
+	cfg, err := loadAndValidateConfig()
+	require.NoError(t, err)
+
+	tests := []struct {
+		name string
+		args []string
+		want []string
+	}{
+		{"reversed chain", []string{"frontend", "api", "db"}, []string{"db", "api", "frontend"}},
+		{"jumbled", []string{"api", "frontend", "db"}, []string{"db", "api", "frontend"}},
+		{"indirect dependency is ordered but not added", []string{"api", "db"}, []string{"db", "api"}},
+		{"duplicates are dropped", []string{"api", "db", "api"}, []string{"db", "api"}},
+		{"single service", []string{"frontend"}, []string{"frontend"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ordered, err := restartOrder(cfg, tt.args)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, ordered)
+		})
+	}
+}
+
+func TestRunRestart_RestartsInDependencyOrder(t *testing.T) {
+	writeTestConfig(t, restartOrderTestConfig)
+	fake, _ := dockertest.NewServer(t)
+	fake.AddContainer("aaaaaaaaaaaa", "shop", "api", "Up 5 minutes")
+	fake.AddContainer("dddddddddddd", "shop", "db", "Up 5 minutes")
+	fake.AddNetwork("ork-shop-network", "net-1")
+
+	var err error
+	out := captureStdout(t, func() {
+		err = runRestart([]string{"api", "db"}, false, false, false, true, nil, nil)
+	})
+	require.NoError(t, err)
+
+	requests := fake.Requests()
+	dbStop := slices.Index(requests, "POST /containers/dddddddddddd/stop")
+	apiStop := slices.Index(requests, "POST /containers/aaaaaaaaaaaa/stop")
+	require.True(t, dbStop >= 0 && apiStop >= 0, "both services should be restarted")
+	assert.Less(t, dbStop, apiStop, "db must be restarted before api, which depends on it")
+	assert.Contains(t, out, "[db api]")
+}
+
+// ============================================================================
+// Pull Tests
+// ============================================================================
+
+func TestRunRestart_PullPullsLocalImageBeforeStopping(t *testing.T) {
+	writeTestConfig(t, restartTestConfig)
+	fake, _ := dockertest.NewServer(t)
+	fake.AddContainer("aaaaaaaaaaaa", "shop", "api", "Up 5 minutes")
+	fake.AddNetwork("ork-shop-network", "net-1")
+
+	var err error
+	captureStdout(t, func() {
+		err = runRestart([]string{"api"}, false, true, false, true, nil, nil)
+	})
+	require.NoError(t, err)
+
+	// The fake has every image locally, so only a forced pull reaches /images/create
+	assert.Equal(t, 1, fake.RequestCount("POST /images/create"))
+
+	requests := fake.Requests()
+	pullAt, stopAt := -1, -1
+	for i, r := range requests {
+		if pullAt < 0 && strings.HasPrefix(r, "POST /images/create") {
+			pullAt = i
+		}
+		if stopAt < 0 && strings.HasPrefix(r, "POST /containers/aaaaaaaaaaaa/stop") {
+			stopAt = i
+		}
+	}
+	assert.Less(t, pullAt, stopAt, "the image should be pulled before the old container is stopped")
+}
+
+func TestRunRestart_WithoutPullDoesNotPull(t *testing.T) {
+	writeTestConfig(t, restartTestConfig)
+	fake, _ := dockertest.NewServer(t)
+	fake.AddContainer("aaaaaaaaaaaa", "shop", "api", "Up 5 minutes")
+	fake.AddNetwork("ork-shop-network", "net-1")
+
+	var err error
+	captureStdout(t, func() {
+		err = runRestart([]string{"api"}, false, false, false, true, nil, nil)
+	})
+	require.NoError(t, err)
+
+	assert.Zero(t, fake.RequestCount("POST /images/create"))
+}
+
+func TestRunRestart_PullConflictsWithNoPull(t *testing.T) {
+	writeTestConfig(t, restartTestConfig)
+	fake, _ := dockertest.NewServer(t)
+	noPull = true
+	t.Cleanup(func() { noPull = false })
+
+	err := runRestart([]string{"api"}, false, true, false, true, nil, nil)
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "--no-pull")
+	assert.False(t, fake.HasRequest("GET /containers"), "the conflict is rejected before touching Docker")
+}
+
+func TestPlanRestart_Pull(t *testing.T) {
+	writeTestConfig(t, restartTestConfig)
+	fake, client := dockertest.NewServer(t)
+	fake.AddContainer("aaaaaaaaaaaa", "shop", "api", "Up 5 minutes")
+	cfg, err := loadAndValidateConfig()
+	require.NoError(t, err)
+
+	plans, err := planRestart(context.Background(), cfg, []string{"api", "web"}, client, false, true)
+	require.NoError(t, err)
+
+	require.Len(t, plans, 2)
+	assert.True(t, plans[0].pull)
+	assert.Contains(t, plans[0].steps()[0], "Pull latest")
+	assert.False(t, plans[1].pull, "build-based services have no image to pull")
+}
+
+func TestPlanRestart(t *testing.T) {
+	writeTestConfig(t, restartTestConfig)
+	fake, client := dockertest.NewServer(t)
+	fake.AddContainer("bbbbbbbbbbbb", "shop", "web", "Up 1 hour")
+
+	cfg, err := config.Load()
+	require.NoError(t, err)
+
+	plans, err := planRestart(context.Background(), cfg, []string{"api", "web"}, client, false, false)
+	require.NoError(t, err)
+	require.Len(t, plans, 2)
+
+	// api is not running and the network is missing
+	assert.Equal(t, "api", plans[0].serviceName)
+	assert.Empty(t, plans[0].containerID)
+	assert.True(t, plans[0].createNetwork)
+	assert.False(t, plans[0].rebuild)
+
+	// web is running and built from source, so it would be rebuilt
+	assert.Equal(t, "web", plans[1].serviceName)
+	assert.Equal(t, "bbbbbbbbbbbb", plans[1].containerID)
+	assert.False(t, plans[1].createNetwork, "network should only be created once")
+	assert.True(t, plans[1].rebuild)
+
+	assert.Empty(t, fake.Mutations())
+}
+
+func TestPlanRestart_ForceRebuild(t *testing.T) {
+	writeTestConfig(t, restartTestConfig)
+	fake, client := dockertest.NewServer(t)
+	fake.AddContainer("aaaaaaaaaaaa", "shop", "api", "Up 5 minutes")
+	fake.AddNetwork("ork-shop-network", "net-1")
+
+	cfg, err := config.Load()
+	require.NoError(t, err)
+
+	plans, err := planRestart(context.Background(), cfg, []string{"api"}, client, true, false)
+	require.NoError(t, err)
+	require.Len(t, plans, 1)
+
+	assert.True(t, plans[0].rebuild)
+	assert.False(t, plans[0].createNetwork)
+	assert.Contains(t, plans[0].steps(), "Rebuild image from source")
+}
+
+func TestPlanRestart_ReportsConfigChanges(t *testing.T) {
+	writeTestConfig(t, restartTestConfig)
+	fake, client := dockertest.NewServer(t)
+	fake.AddNetwork("ork-shop-network", "net-1")
+
+	// Start api from an older config so its labels record the previous image and port
+	old := service.New("api", "shop", config.Service{Image: "node:16-alpine", Ports: []string{"3000:8080"}})
+	require.NoError(t, old.Start(context.Background(), client, "net-1"))
+
+	cfg, err := config.Load()
+	require.NoError(t, err)
+
+	plans, err := planRestart(context.Background(), cfg, []string{"api"}, client, false, false)
+	require.NoError(t, err)
+	require.Len(t, plans, 1)
+
+	assert.Equal(t, []string{"image: node:16-alpine → node:18-alpine", "ports: +8080:8080 -3000:8080"}, plans[0].changes)
+	assert.Contains(t, plans[0].steps(), "Apply config change: image: node:16-alpine → node:18-alpine")
+}
+
+// ============================================================================
+// Health Wait Tests
+// ============================================================================
+
+const restartHealthTestConfig = `version: "1.0"
+project: shop
+services:
+  db:
+    image: postgres:15
+    wait_for_native_health: true
+    health:
+      interval: 10ms
+`
+
+// restartedContainerID is the ID the fake daemon gives the first container restart creates
+var restartedContainerID = fmt.Sprintf("%064d", 1)
+
+// nativeHealthInspect serves container inspects reporting each status in turn (the last one repeats)
+func nativeHealthInspect(statuses ...string) (http.HandlerFunc, *atomic.Int32) {
+	var calls atomic.Int32
+	return func(w http.ResponseWriter, _ *http.Request) {
+		i := min(int(calls.Add(1))-1, len(statuses)-1)
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"Id":    restartedContainerID,
+			"State": map[string]any{"Status": "running", "Running": true, "Health": map[string]any{"Status": statuses[i]}},
+		})
+	}, &calls
+}
+
+func TestRunRestart_WaitsUntilHealthy(t *testing.T) {
+	writeTestConfig(t, restartHealthTestConfig)
+	fake, _ := dockertest.NewServer(t)
+	fake.AddContainer("aaaaaaaaaaaa", "shop", "db", "Up 5 minutes")
+	fake.AddNetwork("ork-shop-network", "net-1")
+	handler, inspects := nativeHealthInspect("starting", "starting", "healthy")
+	fake.Handle(http.MethodGet, "/containers/"+restartedContainerID+"/json", handler)
+
+	var err error
+	out := captureStdout(t, func() {
+		err = runRestart([]string{"db"}, false, false, false, true, nil, nil)
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, int32(3), inspects.Load(), "restart should poll until the new container is healthy")
+	assert.Contains(t, out, "db is healthy")
+}
+
+func TestRunRestart_FailsWhenNeverHealthy(t *testing.T) {
+	writeTestConfig(t, restartHealthTestConfig+"      start_period: 50ms\n")
+	fake, _ := dockertest.NewServer(t)
+	fake.AddNetwork("ork-shop-network", "net-1")
+	handler, _ := nativeHealthInspect("unhealthy")
+	fake.Handle(http.MethodGet, "/containers/"+restartedContainerID+"/json", handler)
+
+	var err error
+	captureStdout(t, func() {
+		err = runRestart([]string{"db"}, false, false, false, true, nil, nil)
+	})
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "did not become healthy")
+}
+
+func TestRunRestart_NoWaitSkipsHealthCheck(t *testing.T) {
+	writeTestConfig(t, restartHealthTestConfig)
+	fake, _ := dockertest.NewServer(t)
+	fake.AddContainer("aaaaaaaaaaaa", "shop", "db", "Up 5 minutes")
+	fake.AddNetwork("ork-shop-network", "net-1")
+	handler, inspects := nativeHealthInspect("starting")
+	fake.Handle(http.MethodGet, "/containers/"+restartedContainerID+"/json", handler)
+
+	var err error
+	out := captureStdout(t, func() {
+		err = runRestart([]string{"db"}, false, false, false, false, nil, nil)
+	})
+	require.NoError(t, err)
+
+	assert.True(t, fake.HasRequest("POST /containers/"+restartedContainerID+"/start"))
+	assert.Zero(t, inspects.Load(), "--no-wait must not poll the new container's health")
+	assert.NotContains(t, out, "healthy")
+}
+
+// ============================================================================
+// Env Override Tests
+// ============================================================================
+
+func TestParseEnvOverrides(t *testing.T) {
+	overrides, err := parseEnvOverrides([]string{"DEBUG=true", "LEVEL=trace", "URL=postgres://db?sslmode=disable", "EMPTY=", "LEVEL=debug"})
+	require.NoError(t, err)
+
+	assert.Equal(t, map[string]string{
+		"DEBUG": "true",
+		"LEVEL": "debug", // Later flags win
+		"URL":   "postgres://db?sslmode=disable",
+		"EMPTY": "",
+	}, overrides)
+}
+
+func TestParseEnvOverrides_None(t *testing.T) {
+	overrides, err := parseEnvOverrides(nil)
+
+	require.NoError(t, err)
+	assert.Nil(t, overrides)
+}
+
+func TestParseEnvOverrides_Invalid(t *testing.T) {
+	for _, flag := range []string{"DEBUG", "=true", " =x"} {
+		t.Run(flag, func(t *testing.T) {
+			_, err := parseEnvOverrides([]string{flag})
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestApplyEnvOverrides_WinOverConfigEnv(t *testing.T) {
+	dir := t.TempDir()
+	t.Chdir(dir)
+	require.NoError(t, os.WriteFile(filepath.Join(dir, ".env"), []byte("LEVEL=info\nREGION=eu\n"), 0o644))
+
+	configEnv := map[string]string{"LEVEL": "warn", "PORT": "8080"}
+	cfg := &config.Config{Services: map[string]config.Service{
+		"api": {Image: "node:18", Env: configEnv},
+		"web": {Image: "nginx", Env: map[string]string{"LEVEL": "warn"}},
+	}}
+
+	applyEnvOverrides(cfg, []string{"api"}, map[string]string{"LEVEL": "trace", "DEBUG": "true"})
+
+	merged, err := config.LoadAllEnvForService("", "api", cfg.Services["api"].Env)
+	require.NoError(t, err)
+	assert.Equal(t, "trace", merged["LEVEL"], "override should beat both .env and ork.yml")
+	assert.Equal(t, "true", merged["DEBUG"])
+	assert.Equal(t, "8080", merged["PORT"])
+	assert.Equal(t, "eu", merged["REGION"])
+
+	// Other services and the original map are untouched
+	assert.Equal(t, "warn", cfg.Services["web"].Env["LEVEL"])
+	assert.Equal(t, "warn", configEnv["LEVEL"])
+}
+
+// ============================================================================
+// Volume Tests
+// ============================================================================
+
+const restartVolumesTestConfig = `version: "1.0"
+project: shop
+services:
+  db:
+    image: postgres:15
+    volumes:
+      - pgdata:/var/lib/postgresql/data
+      - ./init:/docker-entrypoint-initdb.d:ro
+      - ./seeds:/seeds
+`
+
+func TestRunRestart_ReattachesNamedVolumes(t *testing.T) {
+	writeTestConfig(t, restartVolumesTestConfig)
+	require.NoError(t, os.Mkdir("init", 0o755))
+	require.NoError(t, os.Mkdir("seeds", 0o755))
+	fake, client := dockertest.NewServer(t)
+	fake.AddContainer("dddddddddddd", "shop", "db", "Up 5 minutes")
+	fake.AddNetwork("ork-shop-network", "net-1")
+
+	var err error
+	captureStdout(t, func() {
+		err = runRestart([]string{"db"}, false, false, false, true, nil, nil)
+	})
+	require.NoError(t, err)
+
+	containers, err := client.ListByService(context.Background(), "shop", "db")
+	require.NoError(t, err)
+	require.Len(t, containers, 1)
+	require.NotEqual(t, "dddddddddddd", containers[0].ID, "the container should be recreated")
+
+	binds := fake.Binds(containers[0].ID)
+	assert.Contains(t, binds, "pgdata:/var/lib/postgresql/data", "the same named volume is mounted again")
+	assert.Len(t, binds, 3)
+	assert.False(t, fake.HasRequest("DELETE /volumes"), "restart must never remove volumes")
+}
+
+func TestRunRestart_WarnsAboutMissingBindSource(t *testing.T) {
+	writeTestConfig(t, restartVolumesTestConfig)
+	require.NoError(t, os.Mkdir("init", 0o755))
+	fake, _ := dockertest.NewServer(t)
+	fake.AddContainer("dddddddddddd", "shop", "db", "Up 5 minutes")
+	fake.AddNetwork("ork-shop-network", "net-1")
+
+	var err error
+	out := captureStdout(t, func() {
+		err = runRestart([]string{"db"}, false, false, false, true, nil, nil)
+	})
+	require.NoError(t, err)
+
+	assert.Contains(t, out, "seeds, which no longer exists")
+	assert.NotContains(t, out, "init, which no longer exists")
+	assert.NotContains(t, out, "pgdata, which", "named volumes are never reported")
+}
+
+func TestMissingBindSources(t *testing.T) {
+	dir := t.TempDir()
+	present := filepath.Join(dir, "present")
+	require.NoError(t, os.Mkdir(present, 0o755))
+	gone := filepath.Join(dir, "gone")
+
+	missing := missingBindSources(config.Service{Volumes: []string{
+		"pgdata:/data",
+		present + ":/present",
+		gone + ":/gone:ro",
+	}})
+
+	assert.Equal(t, []string{gone}, missing)
+}
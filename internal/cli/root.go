@@ -1,9 +1,14 @@
 package cli
 
 import (
+	"errors"
 	"fmt"
 	"os"
+	"strings"
 
+	"github.com/ork-cli/ork/internal/docker"
+	"github.com/ork-cli/ork/internal/ui"
+	"github.com/ork-cli/ork/pkg/utils"
 	"github.com/spf13/cobra"
 )
 
@@ -43,15 +48,112 @@ Ork is a modern microservices orchestration tool that makes Docker Compose not s
 
 Run services from anywhere, intelligently manage dependencies, and enjoy beautiful CLI output.`,
 	Version: version,
+	PersistentPreRun: func(cmd *cobra.Command, args []string) {
+		// Keep stdout clean for machine-readable output
+		if outputJSON {
+			ui.SetMessageOutput(os.Stderr)
+		}
+
+		// Docker clients pick up offline mode from the environment
+		if noPull {
+			_ = os.Setenv(docker.OfflineEnvVar, "1")
+		}
+	},
+}
+
+// Set by global flags
+var (
+	outputJSON bool // --json: machine-readable output (registered by ps, scan, stats, and top)
+	noPull     bool // --no-pull: never pull images from a registry
+	verbose    bool // --verbose: show the operation path and underlying errors when a command fails
+)
+
+// commandErr is the error a command already displayed through its error handler (e.g., handleUpError)
+// Those commands return normally, so Execute exits with the code for this error instead
+var commandErr error
+
+func init() {
+	rootCmd.PersistentFlags().BoolVar(&noPull, "no-pull", false, "Offline mode: never pull images, fail if one is missing (or set ORK_OFFLINE=1)")
+	// No -v shorthand: it is already --version here and --volumes on 'ork down'
+	rootCmd.PersistentFlags().BoolVar(&verbose, "verbose", false, "Show the failed operation and the underlying error chain")
+
+	// Config loading flags, honored by every command that reads ork.yml
+	rootCmd.PersistentFlags().StringVar(&configFile, "file", "", "Path to the config file (default: the nearest ork.yml or .ork.yml in this directory or a parent)")
+	rootCmd.PersistentFlags().StringVar(&projectOverride, "project", "", "Override the project name from the config file")
+	rootCmd.PersistentFlags().BoolVar(&strictConfig, "strict", false, "Reject unknown fields in the config file")
+}
+
+// addJSONFlag registers --json on a command that can write machine-readable output
+func addJSONFlag(cmd *cobra.Command) {
+	cmd.Flags().BoolVar(&outputJSON, "json", false, "Output machine-readable JSON")
 }
 
 // Execute runs the root command
+// A failed command exits with the code for its error's kind (see utils.ExitCode),
+// so scripts can tell, e.g., a config error (2) apart from a Docker error (3)
 func Execute() {
-	if err := rootCmd.Execute(); err != nil {
-		_, err := fmt.Fprintln(os.Stderr, err)
-		if err != nil {
+	err := rootCmd.Execute()
+	if err != nil {
+		if _, printErr := fmt.Fprintln(os.Stderr, err); printErr != nil {
 			return
 		}
-		os.Exit(1)
+	} else {
+		err = commandErr // Already displayed by the command's error handler
+	}
+
+	if err != nil {
+		os.Exit(finishWithError(err))
+	}
+}
+
+// finishWithError shows the error chain of a failed command's error (with --verbose), once its
+// message has been displayed, and returns the code to exit with
+func finishWithError(err error) int {
+	// A command run with 'ork exec' that failed exits with its own code
+	var exitErr *docker.ExecExitError
+	if errors.As(err, &exitErr) {
+		return exitErr.Code
+	}
+
+	if verbose {
+		printErrorChain(err)
+	}
+	return utils.ExitCode(err)
+}
+
+// printErrorChain shows the operation path and every error wrapped under err (with --verbose)
+// The top-level error is skipped, since it has already been displayed
+func printErrorChain(err error) {
+	var ops, causes []string
+	for current := err; current != nil; current = errors.Unwrap(current) {
+		orkErr, isOrkErr := current.(*utils.OrkError)
+		if isOrkErr && orkErr.Op != "" {
+			ops = append(ops, orkErr.Op)
+		}
+		if current == err {
+			continue
+		}
+
+		switch {
+		case !isOrkErr:
+			causes = append(causes, current.Error())
+		case orkErr.Message != "":
+			causes = append(causes, orkErr.Message)
+		}
+	}
+
+	if len(ops) == 0 && len(causes) == 0 {
+		return
+	}
+
+	ui.EmptyLine()
+	if len(ops) > 0 {
+		ui.Info(fmt.Sprintf("Operation: %s", strings.Join(ops, " → ")))
+	}
+	if len(causes) > 0 {
+		ui.Info("Caused by:")
+		for _, cause := range causes {
+			ui.List(cause)
+		}
 	}
 }
@@ -0,0 +1,124 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/ork-cli/ork/pkg/utils"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+)
+
+// ============================================================================
+// Verbose Error Tests
+// ============================================================================
+
+// testServiceError is a restart failure wrapping a Docker failure wrapping a daemon error
+func testServiceError() error {
+	daemonErr := errors.New("Error response from daemon: driver failed programming external connectivity")
+	dockerErr := utils.DockerError("docker.run", "Failed to start container", "", fmt.Errorf("failed to start container: %w", daemonErr))
+	return utils.ServiceError("restart.start", "Failed to start service api", "Check logs with 'ork logs api' for details", dockerErr)
+}
+
+func TestHandleError_NormalOutputHidesUnderlyingError(t *testing.T) {
+	out := captureStdout(t, func() {
+		handleRestartError(testServiceError())
+	})
+
+	assert.Contains(t, out, "Failed to start service api")
+	assert.Contains(t, out, "Check logs with 'ork logs api' for details")
+	assert.NotContains(t, out, "driver failed programming external connectivity")
+	assert.NotContains(t, out, "restart.start")
+}
+
+func TestHandleError_VerboseShowsErrorChain(t *testing.T) {
+	verbose = true
+	t.Cleanup(func() {
+		verbose = false
+		commandErr = nil
+	})
+
+	out := captureStdout(t, func() {
+		handleRestartError(testServiceError())
+		finishWithError(commandErr)
+	})
+
+	assert.Contains(t, out, "Failed to start service api")
+	assert.Contains(t, out, "Operation: restart.start → docker.run")
+	assert.Contains(t, out, "Failed to start container")
+	assert.Contains(t, out, "failed to start container: Error response from daemon")
+	assert.Contains(t, out, "Error response from daemon: driver failed programming external connectivity")
+}
+
+func TestHandleError_VerboseWithPlainError(t *testing.T) {
+	verbose = true
+	t.Cleanup(func() {
+		verbose = false
+		commandErr = nil
+	})
+
+	out := captureStdout(t, func() {
+		handleUpError(fmt.Errorf("failed to load config: %w", errors.New("permission denied")))
+		finishWithError(commandErr)
+	})
+
+	assert.Contains(t, out, "Error: failed to load config: permission denied")
+	assert.NotContains(t, out, "Operation:")
+	assert.Contains(t, out, "Caused by:")
+}
+
+func TestHandleError_VerboseChainPrintedOnce(t *testing.T) {
+	verbose = true
+	t.Cleanup(func() {
+		verbose = false
+		commandErr = nil
+	})
+
+	// Handlers only display the error; the chain is left to the shared exit path
+	out := captureStdout(t, func() {
+		handleLogsError(testServiceError())
+	})
+	assert.NotContains(t, out, "Caused by:")
+
+	var code int
+	out = captureStdout(t, func() {
+		code = finishWithError(commandErr)
+	})
+	assert.Equal(t, 1, strings.Count(out, "Caused by:"))
+	assert.Equal(t, utils.ExitCode(testServiceError()), code)
+}
+
+// ============================================================================
+// Exit Code Tests
+// ============================================================================
+
+func TestHandleError_RecordsErrorForExitCode(t *testing.T) {
+	t.Cleanup(func() { commandErr = nil })
+
+	captureStdout(t, func() {
+		handleUpError(utils.ConfigError("config.load", "Invalid ork.yml", "", nil))
+	})
+	assert.Equal(t, 2, utils.ExitCode(commandErr), "a config error exits with 2")
+
+	captureStdout(t, func() {
+		handleDownError(utils.DockerError("down.docker", "Failed to connect to Docker", "", nil))
+	})
+	assert.Equal(t, 3, utils.ExitCode(commandErr), "a Docker error exits with 3")
+}
+
+// ============================================================================
+// Flag Registration Tests
+// ============================================================================
+
+func TestJSONFlag_OnlyOnSupportingCommands(t *testing.T) {
+	assert.Nil(t, rootCmd.PersistentFlags().Lookup("json"), "--json must not be inherited by every command")
+
+	for _, cmd := range []*cobra.Command{psCmd, scanCmd, statsCmd, topCmd} {
+		assert.NotNil(t, cmd.Flags().Lookup("json"), cmd.Name())
+	}
+	for _, cmd := range []*cobra.Command{upCmd, downCmd, logsCmd} {
+		assert.Nil(t, cmd.Flags().Lookup("json"), cmd.Name())
+	}
+}
@@ -4,14 +4,17 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"runtime"
 	"sort"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/charmbracelet/lipgloss"
 	"github.com/ork-cli/ork/internal/config"
 	"github.com/ork-cli/ork/internal/git"
 	"github.com/ork-cli/ork/internal/ui"
+	"github.com/ork-cli/ork/pkg/utils"
 	"github.com/spf13/cobra"
 )
 
@@ -28,7 +31,25 @@ Workspace directories can be configured in ~/.ork/config.yml:
     - ~/projects
     - ~/workspace
 
-If no configuration exists, ork will scan default directories: ~/code, ~/projects, ~/workspace`,
+If no configuration exists, ork will scan default directories: ~/code, ~/projects, ~/workspace
+
+Directories can be skipped with glob patterns, matched against a directory's
+name or its path relative to the workspace. Patterns from the config file and
+--exclude flags are combined:
+
+  exclude:
+    - archive
+    - legacy-*
+
+Use --dirty-only to list only repositories with uncommitted changes or commits
+not yet pushed to origin - a quick "what haven't I committed" view. It works
+with --detailed and --json.
+
+Use --group-by workspace to list repositories under the workspace they were
+found in, instead of one flat list sorted by name.
+
+Use --json to output the discovered repositories as a JSON array. Each entry
+includes the workspace it was found in.`,
 	RunE: runScan,
 }
 
@@ -40,6 +61,10 @@ const (
 	workspaceConfigMsg  = "Make sure you have repositories in your workspace directories:"
 	scanDepth           = 3
 
+	// --group-by values
+	scanGroupByNone      = ""
+	scanGroupByWorkspace = "workspace"
+
 	// Column width limits
 	maxNameWidth   = 25
 	maxPathWidth   = 40
@@ -67,15 +92,23 @@ type detailedStyles struct {
 	commit    lipgloss.Style
 	clean     lipgloss.Style
 	dirty     lipgloss.Style
+	sync      lipgloss.Style
 }
 
 var (
-	scanDetailed bool
+	scanDetailed  bool
+	scanDirtyOnly bool
+	scanExclude   []string
+	scanGroupBy   string
 )
 
 func init() {
 	rootCmd.AddCommand(scanCmd)
-	scanCmd.Flags().BoolVarP(&scanDetailed, "detailed", "d", false, "Show detailed git state (branch, commit, changes)")
+	scanCmd.Flags().BoolVarP(&scanDetailed, "detailed", "d", false, "Show detailed git state (branch, commit, changes, commits behind origin)")
+	scanCmd.Flags().BoolVar(&scanDirtyOnly, "dirty-only", false, "List only repositories with uncommitted changes or unpushed commits")
+	scanCmd.Flags().StringArrayVar(&scanExclude, "exclude", nil, "Skip directories matching a glob pattern (repeatable, merged with config)")
+	scanCmd.Flags().StringVar(&scanGroupBy, "group-by", scanGroupByNone, "Group repositories in the listing (workspace)")
+	addJSONFlag(scanCmd)
 }
 
 // ============================================================================
@@ -83,14 +116,24 @@ func init() {
 // ============================================================================
 
 func runScan(_ *cobra.Command, _ []string) error {
+	if err := validateScanGroupBy(scanGroupBy); err != nil {
+		return err
+	}
+
 	// Load global config
 	globalConfig, err := config.LoadGlobal()
 	if err != nil {
 		return fmt.Errorf("failed to load global config: %w", err)
 	}
 
+	// Combine exclude patterns from the config file and --exclude flags
+	exclude := mergeExcludePatterns(globalConfig.ExcludePatterns, scanExclude)
+
 	// Filter and validate workspaces
 	existingWorkspaces := filterExistingWorkspaces(globalConfig.Workspaces)
+	if outputJSON {
+		return runScanJSON(globalConfig.Workspaces, existingWorkspaces, exclude)
+	}
 	if len(existingWorkspaces) == 0 {
 		return handleNoWorkspaces(globalConfig.Workspaces)
 	}
@@ -99,7 +142,7 @@ func runScan(_ *cobra.Command, _ []string) error {
 	displayScanningMessage(existingWorkspaces)
 
 	// Perform discovery
-	repos, elapsed, err := performDiscovery(globalConfig.Workspaces)
+	repos, elapsed, err := performDiscovery(globalConfig.Workspaces, exclude)
 	if err != nil {
 		return err
 	}
@@ -110,6 +153,31 @@ func runScan(_ *cobra.Command, _ []string) error {
 	return nil
 }
 
+// runScanJSON discovers repositories and writes them to stdout as a JSON array
+// Status messages go through ui (stderr in --json mode) so stdout stays parseable
+func runScanJSON(workspaces, existingWorkspaces, exclude []string) error {
+	repos := []git.Repository{}
+
+	if len(existingWorkspaces) == 0 {
+		ui.Warning("No workspace directories found")
+	} else {
+		found, elapsed, err := performDiscovery(workspaces, exclude)
+		if err != nil {
+			return err
+		}
+		ui.Success(fmt.Sprintf("Found %d repositories in %v", len(found), elapsed.Round(time.Millisecond)))
+		if scanDirtyOnly {
+			found, _ = filterDirtyRepos(found)
+		}
+		if found != nil {
+			repos = found
+		}
+	}
+
+	sortRepositories(repos)
+	return ui.WriteJSON(os.Stdout, repos)
+}
+
 // ============================================================================
 // Workspace Management
 // ============================================================================
@@ -163,9 +231,12 @@ func displayScanningMessage(workspaces []string) {
 // Repository Discovery
 // ============================================================================
 
-func performDiscovery(workspaces []string) ([]git.Repository, time.Duration, error) {
+func performDiscovery(workspaces, exclude []string) ([]git.Repository, time.Duration, error) {
 	start := time.Now()
-	repos, err := git.DiscoverRepositories(workspaces, scanDepth)
+	repos, err := git.DiscoverRepositoriesWithOptions(workspaces, git.DiscoverOptions{
+		MaxDepth: scanDepth,
+		Exclude:  exclude,
+	})
 	if err != nil {
 		return nil, 0, fmt.Errorf("failed to discover repositories: %w", err)
 	}
@@ -173,17 +244,62 @@ func performDiscovery(workspaces []string) ([]git.Repository, time.Duration, err
 	return repos, elapsed, nil
 }
 
+// mergeExcludePatterns combines config and flag patterns, dropping duplicates and keeping order
+func mergeExcludePatterns(configPatterns, flagPatterns []string) []string {
+	seen := make(map[string]bool)
+	var merged []string
+	for _, pattern := range append(append([]string(nil), configPatterns...), flagPatterns...) {
+		if pattern == "" || seen[pattern] {
+			continue
+		}
+		seen[pattern] = true
+		merged = append(merged, pattern)
+	}
+	return merged
+}
+
 func displayResults(repos []git.Repository, elapsed time.Duration, workspaces []string) {
 	ui.Success(fmt.Sprintf("Found %d repositories in %v", len(repos), elapsed.Round(time.Millisecond)))
 	fmt.Println()
-	printRepositories(repos, workspaces)
+
+	if !scanDirtyOnly || len(repos) == 0 {
+		printRepositories(repos, workspaces, nil)
+		return
+	}
+
+	dirty, states := filterDirtyRepos(repos)
+	if len(dirty) == 0 {
+		ui.Success("Every repository is committed and pushed")
+		return
+	}
+	ui.Info(fmt.Sprintf("%d with uncommitted changes or unpushed commits", len(dirty)))
+	fmt.Println()
+	printRepositories(dirty, workspaces, states)
+}
+
+// filterDirtyRepos keeps the repositories with uncommitted changes or commits ahead of origin,
+// along with the git state read for them (reused by the detailed view)
+// Repositories whose state can't be read are kept, so the problem isn't hidden
+func filterDirtyRepos(repos []git.Repository) ([]git.Repository, map[string]repoStateResult) {
+	states := fetchRepoStates(repos, runtime.NumCPU())
+
+	var dirty []git.Repository
+	for _, repo := range repos {
+		result := states[repo.Path]
+		if result.err != nil || result.state == nil || result.state.HasUncommitted || result.ahead > 0 {
+			dirty = append(dirty, repo)
+		}
+	}
+	return dirty, states
 }
 
 // ============================================================================
 // Output Formatting - Basic View
 // ============================================================================
 
-func printRepositories(repos []git.Repository, workspaces []string) {
+// printRepositories prints the repository listing; states holds git state already read
+// for the detailed view (nil to read it as needed)
+func printRepositories(repos []git.Repository, workspaces []string, states map[string]repoStateResult) {
 	if len(repos) == 0 {
 		ui.Warning(noReposMessage)
 		fmt.Println()
@@ -192,17 +308,29 @@ func printRepositories(repos []git.Repository, workspaces []string) {
 		return
 	}
 
+	if scanGroupBy == scanGroupByWorkspace {
+		printGroupedRepositories(repos, states)
+		return
+	}
+
 	// Sort repositories by name
-	sort.Slice(repos, func(i, j int) bool {
-		return repos[i].Name < repos[j].Name
-	})
+	sortRepositories(repos)
+	printRepositoryList(repos, states)
+}
 
+// printRepositoryList prints repositories in the basic or detailed view, in the given order
+func printRepositoryList(repos []git.Repository, states map[string]repoStateResult) {
 	// Use the detailed view if a flag is set
 	if scanDetailed {
-		printDetailedRepositories(repos)
+		printDetailedRepositories(repos, states)
 		return
 	}
 
+	printRepositoryTable(repos)
+}
+
+// printRepositoryTable prints the basic NAME / PATH / GIT URL table
+func printRepositoryTable(repos []git.Repository) {
 	// Create header style
 	headerStyle := lipgloss.NewStyle().
 		Bold(true).
@@ -267,10 +395,76 @@ func printRepositories(repos []git.Repository, workspaces []string) {
 	}
 }
 
+// ============================================================================
+// Output Formatting - Grouped View
+// ============================================================================
+
+// repoGroup is the repositories discovered in one workspace
+type repoGroup struct {
+	workspace string
+	repos     []git.Repository
+}
+
+// validateScanGroupBy checks that the --group-by value is one we know how to render
+func validateScanGroupBy(groupBy string) error {
+	switch groupBy {
+	case scanGroupByNone, scanGroupByWorkspace:
+		return nil
+	}
+	return utils.ConfigError(
+		"flags.group-by",
+		fmt.Sprintf("Unknown grouping '%s'", groupBy),
+		"Use: workspace",
+		nil,
+	)
+}
+
+// groupReposByWorkspace splits repositories by workspace, sorting each group by name
+// Groups keep the order workspaces were scanned in (the configured order)
+func groupReposByWorkspace(repos []git.Repository) []repoGroup {
+	var groups []repoGroup
+	index := make(map[string]int)
+
+	for _, repo := range repos {
+		i, ok := index[repo.Workspace]
+		if !ok {
+			i = len(groups)
+			index[repo.Workspace] = i
+			groups = append(groups, repoGroup{workspace: repo.Workspace})
+		}
+		groups[i].repos = append(groups[i].repos, repo)
+	}
+
+	for _, group := range groups {
+		sortRepositories(group.repos)
+	}
+	return groups
+}
+
+// printGroupedRepositories prints one listing per workspace under a workspace header
+func printGroupedRepositories(repos []git.Repository, states map[string]repoStateResult) {
+	headerStyle := lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("12"))
+
+	for i, group := range groupReposByWorkspace(repos) {
+		if i > 0 {
+			fmt.Println()
+		}
+		fmt.Printf("%s %s\n", headerStyle.Render(group.workspace), ui.Dim(fmt.Sprintf("(%d)", len(group.repos))))
+		printRepositoryList(group.repos, states)
+	}
+}
+
 // ============================================================================
 // Utility Functions
 // ============================================================================
 
+// sortRepositories sorts repositories by name
+func sortRepositories(repos []git.Repository) {
+	sort.Slice(repos, func(i, j int) bool {
+		return repos[i].Name < repos[j].Name
+	})
+}
+
 func truncate(s string, maxLen int) string {
 	if len(s) <= maxLen {
 		return s
@@ -314,12 +508,57 @@ func minInt(a, b int) int {
 // Output Formatting - Detailed View
 // ============================================================================
 
+// repoStateResult is the git state of one repository, or the error reading it
+type repoStateResult struct {
+	state  *git.RepoState
+	ahead  int // Local commits not yet pushed to the remote tracking branch (0 if unknown)
+	behind int // Commits on the remote tracking branch not yet pulled (0 if unknown)
+	err    error
+}
+
 // printDetailedRepositories displays repositories with git state information
-func printDetailedRepositories(repos []git.Repository) {
+// Unless already given, git state is fetched for all repositories up front, in parallel, then rendered in order
+func printDetailedRepositories(repos []git.Repository, states map[string]repoStateResult) {
+	if states == nil {
+		states = fetchRepoStates(repos, runtime.NumCPU())
+	}
 	styles := createDetailedStyles()
-	widths := calculateDetailedColumnWidths(repos)
+	widths := calculateDetailedColumnWidths(repos, states)
 	printDetailedHeader(styles, widths)
-	printDetailedRows(repos, styles, widths)
+	printDetailedRows(repos, states, styles, widths)
+}
+
+// fetchRepoStates reads the git state of every repository using a bounded pool of workers
+// Results are keyed by repository path
+func fetchRepoStates(repos []git.Repository, workers int) map[string]repoStateResult {
+	workers = maxInt(1, minInt(workers, len(repos)))
+
+	paths := make(chan string)
+	states := make(map[string]repoStateResult, len(repos))
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for path := range paths {
+				state, err := git.GetRepoState(path)
+				ahead, behind, _ := git.AheadBehind(path) // No commits or no remote just means nothing to push or pull
+				mu.Lock()
+				states[path] = repoStateResult{state: state, ahead: ahead, behind: behind, err: err}
+				mu.Unlock()
+			}
+		}()
+	}
+
+	for _, repo := range repos {
+		paths <- repo.Path
+	}
+	close(paths)
+	wg.Wait()
+
+	return states
 }
 
 // createDetailedStyles creates all lipgloss styles for the detailed view
@@ -333,11 +572,12 @@ func createDetailedStyles() detailedStyles {
 		commit:    lipgloss.NewStyle().Foreground(lipgloss.Color("11")),
 		clean:     lipgloss.NewStyle().Foreground(lipgloss.Color("10")),
 		dirty:     lipgloss.NewStyle().Foreground(lipgloss.Color("9")),
+		sync:      lipgloss.NewStyle().Foreground(lipgloss.Color("11")),
 	}
 }
 
 // calculateDetailedColumnWidths calculates optimal column widths based on content
-func calculateDetailedColumnWidths(repos []git.Repository) detailedColumnWidths {
+func calculateDetailedColumnWidths(repos []git.Repository, states map[string]repoStateResult) detailedColumnWidths {
 	widths := detailedColumnWidths{
 		name:   len("NAME"),
 		path:   len("PATH"),
@@ -351,9 +591,9 @@ func calculateDetailedColumnWidths(repos []git.Repository) detailedColumnWidths
 		widths.name = maxInt(widths.name, len(repo.Name))
 		widths.path = maxInt(widths.path, len(repo.Path))
 
-		if state, err := git.GetRepoState(repo.Path); err == nil {
-			widths.branch = maxInt(widths.branch, len(state.Branch))
-			widths.status = maxInt(widths.status, len(state.UncommittedSummary))
+		if result := states[repo.Path]; result.err == nil && result.state != nil {
+			widths.branch = maxInt(widths.branch, len(result.state.Branch))
+			widths.status = maxInt(widths.status, len(repoStatusText(result)))
 		}
 	}
 
@@ -386,19 +626,19 @@ func printDetailedHeader(styles detailedStyles, widths detailedColumnWidths) {
 }
 
 // printDetailedRows prints all repository rows with git state
-func printDetailedRows(repos []git.Repository, styles detailedStyles, widths detailedColumnWidths) {
+func printDetailedRows(repos []git.Repository, states map[string]repoStateResult, styles detailedStyles, widths detailedColumnWidths) {
 	for _, repo := range repos {
-		printDetailedRow(repo, styles, widths)
+		printDetailedRow(repo, states[repo.Path], styles, widths)
 	}
 }
 
-// printDetailedRow prints a single repository row with git state
-func printDetailedRow(repo git.Repository, styles detailedStyles, widths detailedColumnWidths) {
-	state, err := git.GetRepoState(repo.Path)
-	if err != nil {
-		printDetailedErrorRow(repo, err.Error(), styles, widths)
+// printDetailedRow prints a single repository row with its pre-fetched git state
+func printDetailedRow(repo git.Repository, result repoStateResult, styles detailedStyles, widths detailedColumnWidths) {
+	if result.err != nil {
+		printDetailedErrorRow(repo, result.err.Error(), styles, widths)
 		return
 	}
+	state := result.state
 
 	statusStyle := styles.clean
 	if state.HasUncommitted {
@@ -410,7 +650,31 @@ func printDetailedRow(repo git.Repository, styles detailedStyles, widths detaile
 		styles.path.Render(padRight(truncate(repo.Path, widths.path), widths.path)),
 		styles.branch.Render(padRight(truncate(state.Branch, widths.branch), widths.branch)),
 		styles.commit.Render(padRight(state.CommitHash, widths.commit)),
-		statusStyle.Render(state.UncommittedSummary))
+		statusStyle.Render(state.UncommittedSummary)+syncAnnotation(result.ahead, result.behind, styles))
+}
+
+// repoStatusText returns the unstyled STATUS cell: the change summary plus any ahead and behind counts
+func repoStatusText(result repoStateResult) string {
+	text := result.state.UncommittedSummary
+	if result.ahead > 0 {
+		text += fmt.Sprintf(" ↑%d", result.ahead)
+	}
+	if result.behind > 0 {
+		text += fmt.Sprintf(" ↓%d", result.behind)
+	}
+	return text
+}
+
+// syncAnnotation returns " ↑N" when the repository needs a git push and " ↓N" when it needs a git pull
+func syncAnnotation(ahead, behind int, styles detailedStyles) string {
+	var annotation string
+	if ahead > 0 {
+		annotation += " " + styles.sync.Render(fmt.Sprintf("↑%d", ahead))
+	}
+	if behind > 0 {
+		annotation += " " + styles.sync.Render(fmt.Sprintf("↓%d", behind))
+	}
+	return annotation
 }
 
 // printDetailedErrorRow prints an error row for a repository that failed to load
@@ -0,0 +1,415 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+
+	gogit "github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/ork-cli/ork/internal/git"
+	"github.com/ork-cli/ork/internal/ui"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// ============================================================================
+// Test Helpers
+// ============================================================================
+
+// setupScanWorkspace points HOME at a temp dir with one workspace holding the given repos
+func setupScanWorkspace(t *testing.T, repoNames ...string) string {
+	t.Helper()
+
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+
+	workspace := filepath.Join(home, "code")
+	for _, name := range repoNames {
+		_, err := gogit.PlainInit(filepath.Join(workspace, name), false)
+		require.NoError(t, err)
+	}
+
+	require.NoError(t, os.MkdirAll(filepath.Join(home, ".ork"), 0o755))
+	globalConfig := "workspaces:\n  - " + workspace + "\n"
+	require.NoError(t, os.WriteFile(filepath.Join(home, ".ork", "config.yml"), []byte(globalConfig), 0o644))
+
+	return workspace
+}
+
+// withJSONOutput enables --json for the duration of a test
+func withJSONOutput(t *testing.T) {
+	t.Helper()
+
+	outputJSON = true
+	ui.SetMessageOutput(os.Stderr)
+	t.Cleanup(func() {
+		outputJSON = false
+		ui.SetMessageOutput(nil)
+	})
+}
+
+// ============================================================================
+// JSON Output Tests
+// ============================================================================
+
+func TestRunScan_JSONSchema(t *testing.T) {
+	workspace := setupScanWorkspace(t, "web", "api")
+	withJSONOutput(t)
+
+	var runErr error
+	out := captureStdout(t, func() {
+		runErr = runScan(scanCmd, nil)
+	})
+	require.NoError(t, runErr)
+
+	var repos []map[string]any
+	require.NoError(t, json.Unmarshal([]byte(out), &repos), "stdout should be pure JSON: %q", out)
+	require.Len(t, repos, 2)
+
+	for _, repo := range repos {
+		keys := make([]string, 0, len(repo))
+		for key := range repo {
+			keys = append(keys, key)
+		}
+		assert.ElementsMatch(t, []string{"name", "path", "url", "workspace"}, keys)
+	}
+
+	// Sorted by name
+	assert.Equal(t, "api", repos[0]["name"])
+	assert.Equal(t, filepath.Join(workspace, "api"), repos[0]["path"])
+	assert.Equal(t, workspace, repos[0]["workspace"])
+	assert.Equal(t, "web", repos[1]["name"])
+}
+
+func TestRunScan_JSONSuppressesHeader(t *testing.T) {
+	setupScanWorkspace(t, "api")
+	withJSONOutput(t)
+
+	out := captureStdout(t, func() {
+		require.NoError(t, runScan(scanCmd, nil))
+	})
+
+	assert.NotContains(t, out, "Scanning")
+	assert.NotContains(t, out, "Found")
+	assert.NotContains(t, out, "NAME")
+}
+
+func TestRunScan_JSONNoWorkspaces(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	require.NoError(t, os.MkdirAll(filepath.Join(home, ".ork"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(home, ".ork", "config.yml"), []byte("workspaces:\n  - "+filepath.Join(home, "missing")+"\n"), 0o644))
+	withJSONOutput(t)
+
+	out := captureStdout(t, func() {
+		require.NoError(t, runScan(scanCmd, nil))
+	})
+
+	assert.JSONEq(t, "[]", out)
+}
+
+// ============================================================================
+// Exclude Pattern Tests
+// ============================================================================
+
+func TestMergeExcludePatterns(t *testing.T) {
+	merged := mergeExcludePatterns([]string{"archive", "legacy-*"}, []string{"tmp", "archive", ""})
+
+	assert.Equal(t, []string{"archive", "legacy-*", "tmp"}, merged)
+	assert.Nil(t, mergeExcludePatterns(nil, nil))
+}
+
+func TestRunScan_ExcludeMergesConfigAndFlag(t *testing.T) {
+	workspace := setupScanWorkspace(t, "api", "web", "legacy-billing")
+	withJSONOutput(t)
+
+	// The config excludes legacy-*, the flag excludes web
+	home := filepath.Dir(workspace)
+	globalConfig := "workspaces:\n  - " + workspace + "\nexclude:\n  - legacy-*\n"
+	require.NoError(t, os.WriteFile(filepath.Join(home, ".ork", "config.yml"), []byte(globalConfig), 0o644))
+
+	scanExclude = []string{"web"}
+	t.Cleanup(func() { scanExclude = nil })
+
+	out := captureStdout(t, func() {
+		require.NoError(t, runScan(scanCmd, nil))
+	})
+
+	var repos []map[string]any
+	require.NoError(t, json.Unmarshal([]byte(out), &repos))
+	require.Len(t, repos, 1)
+	assert.Equal(t, "api", repos[0]["name"])
+}
+
+// ============================================================================
+// Repository State Pre-fetch Tests
+// ============================================================================
+
+// createStateRepos creates repos under dir, making every other one dirty so states differ
+func createStateRepos(tb testing.TB, dir string, count int) []git.Repository {
+	tb.Helper()
+
+	repos := make([]git.Repository, 0, count)
+	for i := 0; i < count; i++ {
+		name := fmt.Sprintf("repo-%03d", i)
+		path := filepath.Join(dir, name)
+		_, err := gogit.PlainInit(path, false)
+		require.NoError(tb, err)
+		if i%2 == 0 {
+			require.NoError(tb, os.WriteFile(filepath.Join(path, "README.md"), []byte(name), 0o644))
+		}
+		repos = append(repos, git.Repository{Name: name, Path: path})
+	}
+	return repos
+}
+
+func TestFetchRepoStates_MatchesSerial(t *testing.T) {
+	repos := createStateRepos(t, t.TempDir(), 12)
+	repos = append(repos, git.Repository{Name: "missing", Path: filepath.Join(t.TempDir(), "missing")})
+
+	states := fetchRepoStates(repos, 4)
+
+	require.Len(t, states, len(repos))
+	for _, repo := range repos {
+		want, wantErr := git.GetRepoState(repo.Path)
+		got := states[repo.Path]
+		assert.Equal(t, wantErr, got.err, repo.Name)
+		assert.Equal(t, want, got.state, repo.Name)
+	}
+}
+
+func TestFetchRepoStates_Empty(t *testing.T) {
+	assert.Empty(t, fetchRepoStates(nil, 4))
+}
+
+func BenchmarkFetchRepoStates(b *testing.B) {
+	repos := createStateRepos(b, b.TempDir(), 50)
+
+	b.Run("serial", func(b *testing.B) {
+		for b.Loop() {
+			fetchRepoStates(repos, 1)
+		}
+	})
+	b.Run("parallel", func(b *testing.B) {
+		for b.Loop() {
+			fetchRepoStates(repos, runtime.NumCPU())
+		}
+	})
+}
+
+func TestRunScan_DetailedShowsBehindRemote(t *testing.T) {
+	workspace := setupScanWorkspace(t)
+
+	// A repo whose origin tracking branch has two commits the local branch lacks
+	path := filepath.Join(workspace, "api")
+	repo, err := gogit.PlainInit(path, false)
+	require.NoError(t, err)
+	worktree, err := repo.Worktree()
+	require.NoError(t, err)
+
+	commit := func(name string) plumbing.Hash {
+		require.NoError(t, os.WriteFile(filepath.Join(path, name), []byte(name), 0o644))
+		_, err := worktree.Add(name)
+		require.NoError(t, err)
+		hash, err := worktree.Commit(name, &gogit.CommitOptions{Author: &object.Signature{Name: "Test", Email: "test@example.com"}})
+		require.NoError(t, err)
+		return hash
+	}
+	base := commit("a.txt")
+	commit("b.txt")
+	remoteHead := commit("c.txt")
+
+	head, err := repo.Head()
+	require.NoError(t, err)
+	remoteRef := plumbing.NewHashReference(plumbing.NewRemoteReferenceName("origin", head.Name().Short()), remoteHead)
+	require.NoError(t, repo.Storer.SetReference(remoteRef))
+	require.NoError(t, worktree.Reset(&gogit.ResetOptions{Commit: base, Mode: gogit.HardReset}))
+
+	scanDetailed = true
+	t.Cleanup(func() { scanDetailed = false })
+
+	out := captureStdout(t, func() {
+		require.NoError(t, runScan(scanCmd, nil))
+	})
+
+	assert.Contains(t, out, "clean ↓2")
+}
+
+func TestRunScan_DetailedOutputIsSorted(t *testing.T) {
+	setupScanWorkspace(t, "web", "api", "worker")
+	scanDetailed = true
+	t.Cleanup(func() { scanDetailed = false })
+
+	out := captureStdout(t, func() {
+		require.NoError(t, runScan(scanCmd, nil))
+	})
+
+	api, web, worker := strings.Index(out, "api"), strings.Index(out, "web"), strings.Index(out, "worker")
+	require.True(t, api >= 0 && web >= 0 && worker >= 0, "every repo should be listed: %q", out)
+	assert.Less(t, api, web)
+	assert.Less(t, web, worker)
+}
+
+// ============================================================================
+// Group By Workspace Tests
+// ============================================================================
+
+func TestValidateScanGroupBy(t *testing.T) {
+	assert.NoError(t, validateScanGroupBy(""))
+	assert.NoError(t, validateScanGroupBy("workspace"))
+
+	err := validateScanGroupBy("owner")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "owner")
+}
+
+func TestGroupReposByWorkspace(t *testing.T) {
+	repos := []git.Repository{
+		{Name: "web", Workspace: "/code"},
+		{Name: "blog", Workspace: "/projects"},
+		{Name: "api", Workspace: "/code"},
+	}
+
+	groups := groupReposByWorkspace(repos)
+
+	require.Len(t, groups, 2)
+	assert.Equal(t, "/code", groups[0].workspace, "groups keep the scan order")
+	assert.Equal(t, []git.Repository{{Name: "api", Workspace: "/code"}, {Name: "web", Workspace: "/code"}}, groups[0].repos)
+	assert.Equal(t, "/projects", groups[1].workspace)
+	assert.Equal(t, []git.Repository{{Name: "blog", Workspace: "/projects"}}, groups[1].repos)
+}
+
+func TestRunScan_GroupByWorkspace(t *testing.T) {
+	code := setupScanWorkspace(t, "web", "api")
+	home := filepath.Dir(code)
+
+	// A second workspace, listed first in the config
+	projects := filepath.Join(home, "projects")
+	_, err := gogit.PlainInit(filepath.Join(projects, "blog"), false)
+	require.NoError(t, err)
+	globalConfig := "workspaces:\n  - " + projects + "\n  - " + code + "\n"
+	require.NoError(t, os.WriteFile(filepath.Join(home, ".ork", "config.yml"), []byte(globalConfig), 0o644))
+
+	scanGroupBy = scanGroupByWorkspace
+	t.Cleanup(func() { scanGroupBy = scanGroupByNone })
+
+	out := captureStdout(t, func() {
+		require.NoError(t, runScan(scanCmd, nil))
+	})
+
+	// Each repo is listed after its own workspace header, in config order
+	projectsHeader := strings.Index(out, projects+" (1)")
+	codeHeader := strings.Index(out, code+" (2)")
+	require.True(t, projectsHeader >= 0 && codeHeader >= 0, "both workspace headers should be printed: %q", out)
+	assert.Less(t, projectsHeader, codeHeader)
+
+	blog, api, web := strings.Index(out, "blog"), strings.Index(out, "api  "), strings.Index(out, "web  ")
+	assert.Greater(t, blog, projectsHeader)
+	assert.Less(t, blog, codeHeader)
+	assert.Greater(t, api, codeHeader)
+	assert.Less(t, api, web)
+}
+
+// ============================================================================
+// Dirty Only Tests
+// ============================================================================
+
+// withDirtyOnly enables --dirty-only for the duration of a test
+func withDirtyOnly(t *testing.T) {
+	t.Helper()
+
+	scanDirtyOnly = true
+	t.Cleanup(func() { scanDirtyOnly = false })
+}
+
+// commitAheadOfOrigin commits a file in the repo at path and points origin/<branch> at the previous commit
+func commitAheadOfOrigin(t *testing.T, path string) {
+	t.Helper()
+
+	repo, err := gogit.PlainOpen(path)
+	require.NoError(t, err)
+	worktree, err := repo.Worktree()
+	require.NoError(t, err)
+
+	var hashes []plumbing.Hash
+	for _, name := range []string{"a.txt", "b.txt"} {
+		require.NoError(t, os.WriteFile(filepath.Join(path, name), []byte(name), 0o644))
+		_, err := worktree.Add(name)
+		require.NoError(t, err)
+		hash, err := worktree.Commit(name, &gogit.CommitOptions{Author: &object.Signature{Name: "Test", Email: "test@example.com"}})
+		require.NoError(t, err)
+		hashes = append(hashes, hash)
+	}
+
+	head, err := repo.Head()
+	require.NoError(t, err)
+	remoteRef := plumbing.NewHashReference(plumbing.NewRemoteReferenceName("origin", head.Name().Short()), hashes[0])
+	require.NoError(t, repo.Storer.SetReference(remoteRef))
+}
+
+func TestRunScan_DirtyOnlyExcludesCleanRepos(t *testing.T) {
+	workspace := setupScanWorkspace(t, "tidy", "messy")
+	require.NoError(t, os.WriteFile(filepath.Join(workspace, "messy", "notes.txt"), []byte("wip"), 0o644))
+	withDirtyOnly(t)
+
+	out := captureStdout(t, func() {
+		require.NoError(t, runScan(scanCmd, nil))
+	})
+
+	assert.Contains(t, out, "messy")
+	assert.NotContains(t, out, "tidy")
+	assert.Contains(t, out, "1 with uncommitted changes or unpushed commits")
+}
+
+func TestRunScan_DirtyOnlyWithDetailed(t *testing.T) {
+	workspace := setupScanWorkspace(t, "tidy", "messy", "pushme")
+	require.NoError(t, os.WriteFile(filepath.Join(workspace, "messy", "notes.txt"), []byte("wip"), 0o644))
+	commitAheadOfOrigin(t, filepath.Join(workspace, "pushme"))
+	withDirtyOnly(t)
+	scanDetailed = true
+	t.Cleanup(func() { scanDetailed = false })
+
+	out := captureStdout(t, func() {
+		require.NoError(t, runScan(scanCmd, nil))
+	})
+
+	assert.Contains(t, out, "messy")
+	assert.Contains(t, out, "untracked")
+	assert.Contains(t, out, "pushme")
+	assert.Contains(t, out, "clean ↑1", "a repo with unpushed commits is kept and shows how far ahead it is")
+	assert.NotContains(t, out, "tidy")
+}
+
+func TestRunScan_DirtyOnlyAllClean(t *testing.T) {
+	setupScanWorkspace(t, "tidy")
+	withDirtyOnly(t)
+
+	out := captureStdout(t, func() {
+		require.NoError(t, runScan(scanCmd, nil))
+	})
+
+	assert.Contains(t, out, "Every repository is committed and pushed")
+	assert.NotContains(t, out, "NAME")
+}
+
+func TestRunScan_DirtyOnlyJSON(t *testing.T) {
+	workspace := setupScanWorkspace(t, "tidy", "messy")
+	require.NoError(t, os.WriteFile(filepath.Join(workspace, "messy", "notes.txt"), []byte("wip"), 0o644))
+	withDirtyOnly(t)
+	withJSONOutput(t)
+
+	out := captureStdout(t, func() {
+		require.NoError(t, runScan(scanCmd, nil))
+	})
+
+	var repos []git.Repository
+	require.NoError(t, json.Unmarshal([]byte(out), &repos), "stdout should be a JSON array: %q", out)
+	require.Len(t, repos, 1)
+	assert.Equal(t, "messy", repos[0].Name)
+}
@@ -0,0 +1,113 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/moby/term"
+	"github.com/ork-cli/ork/internal/config"
+	"github.com/ork-cli/ork/internal/service"
+	"github.com/ork-cli/ork/internal/ui"
+	"github.com/ork-cli/ork/pkg/utils"
+	"github.com/spf13/cobra"
+)
+
+// ============================================================================
+// Cobra Command Definition
+// ============================================================================
+
+var servicesCmd = &cobra.Command{
+	Use:   "services",
+	Short: "List the services defined in ork.yml",
+	Long: `
+List the service names defined in ork.yml, sorted alphabetically.
+
+When stdout is not a terminal, names are printed one per line with no
+decoration, so the output can feed shell completion and scripts.
+
+Use --with-deps to instead print a service and everything it depends on,
+in the order 'ork up' would start them.`,
+	Example: `
+ork services                 List all services
+ork services --with-deps api List api and its dependencies in start order
+ork services | xargs -n1 ork logs --tail 5`,
+
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		withDeps, _ := cmd.Flags().GetString("with-deps")
+
+		if err := runServices(withDeps); err != nil {
+			handleUpError(err)
+			return
+		}
+	},
+}
+
+func init() {
+	// Register the 'services' command with the root command
+	rootCmd.AddCommand(servicesCmd)
+
+	// Add flags
+	servicesCmd.Flags().String("with-deps", "", "Print this service and its dependencies in start order")
+}
+
+// ============================================================================
+// Main Orchestrator
+// ============================================================================
+
+// runServices prints the project's service names, or a service's start order with withDeps
+func runServices(withDeps string) error {
+	cfg, err := loadAndValidateConfig()
+	if err != nil {
+		return err
+	}
+
+	if withDeps == "" {
+		printServiceNames(fmt.Sprintf("Services in %s:", ui.Bold(cfg.Project)), sortedServiceNames(cfg))
+		return nil
+	}
+
+	ordered, err := startOrder(cfg, withDeps)
+	if err != nil {
+		return err
+	}
+	printServiceNames(fmt.Sprintf("Start order for %s:", ui.Bold(withDeps)), ordered)
+	return nil
+}
+
+// ============================================================================
+// Private Helpers
+// ============================================================================
+
+// startOrder returns a service and its dependencies, dependencies first
+func startOrder(cfg *config.Config, serviceName string) ([]string, error) {
+	if err := validateServiceNames([]string{serviceName}, cfg); err != nil {
+		return nil, err
+	}
+
+	ordered, err := service.ResolveDependencies(cfg.Services, []string{serviceName})
+	if err != nil {
+		return nil, utils.ServiceError(
+			"services.dependencies",
+			"Failed to resolve service dependencies",
+			"Check your service dependencies in ork.yml",
+			err,
+		)
+	}
+	return ordered, nil
+}
+
+// printServiceNames prints names one per line, under a header when stdout is a terminal
+func printServiceNames(header string, names []string) {
+	if _, isTerminal := term.GetFdInfo(os.Stdout); !isTerminal {
+		for _, name := range names {
+			fmt.Println(name)
+		}
+		return
+	}
+
+	ui.Info(header)
+	for _, name := range names {
+		ui.List(name)
+	}
+}
@@ -0,0 +1,69 @@
+package cli
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const servicesTestConfig = `version: "1.0"
+project: shop
+services:
+  web:
+    image: nginx:alpine
+    depends_on: [api]
+  api:
+    image: node:18-alpine
+    depends_on: [db, cache]
+  db:
+    image: postgres:15
+  cache:
+    image: redis:7
+  worker:
+    image: node:18-alpine
+    depends_on: [db]
+`
+
+// ============================================================================
+// Services Listing Tests
+// ============================================================================
+
+func TestRunServices_PlainSortedNames(t *testing.T) {
+	writeTestConfig(t, servicesTestConfig)
+
+	var err error
+	out := captureStdout(t, func() {
+		err = runServices("")
+	})
+	require.NoError(t, err)
+
+	// Stdout is a pipe here, so the output is undecorated
+	assert.Equal(t, "api\ncache\ndb\nweb\nworker\n", out)
+}
+
+func TestRunServices_WithDepsStartOrder(t *testing.T) {
+	writeTestConfig(t, servicesTestConfig)
+
+	var err error
+	out := captureStdout(t, func() {
+		err = runServices("web")
+	})
+	require.NoError(t, err)
+
+	// Only web and its dependencies, dependencies first; worker is unrelated
+	assert.Equal(t, "db\ncache\napi\nweb\n", out)
+}
+
+func TestRunServices_WithDepsUnknownService(t *testing.T) {
+	writeTestConfig(t, servicesTestConfig)
+
+	var err error
+	out := captureStdout(t, func() {
+		err = runServices("wbe")
+	})
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "wbe")
+	assert.Empty(t, out)
+}
@@ -0,0 +1,163 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/ork-cli/ork/internal/docker"
+	"github.com/ork-cli/ork/internal/ui"
+	"github.com/ork-cli/ork/pkg/utils"
+	"github.com/spf13/cobra"
+)
+
+// ============================================================================
+// Cobra Command Definition
+// ============================================================================
+
+var statsCmd = &cobra.Command{
+	Use:   "stats [service...]",
+	Short: "Show live CPU and memory usage of services",
+	Long: `
+Show CPU and memory usage for the running services of the current project,
+like 'docker stats' scoped to Ork.
+
+The table refreshes continuously until you press Ctrl+C. Use --no-stream to
+print a single sample and exit (--json implies --no-stream).`,
+	Example: `
+ork stats                    Live usage for every running service
+ork stats api worker         Live usage for specific services
+ork stats --no-stream        Print one sample and exit
+ork stats --json             One sample as JSON (for scripting)`,
+
+	Run: func(cmd *cobra.Command, args []string) {
+		// Get flags
+		noStream, _ := cmd.Flags().GetBool("no-stream")
+
+		if err := runStats(args, noStream || outputJSON, outputJSON); err != nil {
+			handlePSError(err)
+			return
+		}
+	},
+}
+
+func init() {
+	// Register the 'stats' command with the root command
+	rootCmd.AddCommand(statsCmd)
+
+	// Add flags
+	statsCmd.Flags().Bool("no-stream", false, "Print a single sample instead of refreshing")
+	addJSONFlag(statsCmd)
+}
+
+// statsRefreshInterval is the pause between refreshes of the live stats table
+// Each sample already takes the daemon about a second to measure
+const statsRefreshInterval = time.Second
+
+// ============================================================================
+// Main Orchestrator
+// ============================================================================
+
+// runStats shows resource usage for the project's running services (or just serviceNames)
+// With noStream, a single sample is printed; otherwise the table refreshes until interrupted
+func runStats(serviceNames []string, noStream, jsonOutput bool) error {
+	// Load configuration to get the project name
+	cfg, err := loadConfigUnvalidated()
+	if err != nil {
+		return err
+	}
+	if err := validateServiceNames(serviceNames, cfg); err != nil {
+		return err
+	}
+
+	dockerClient, err := docker.NewClient()
+	if err != nil {
+		return utils.DockerError(
+			"stats.docker",
+			"Failed to connect to Docker",
+			"Make sure Docker is running with 'docker ps' or run 'ork doctor'",
+			err,
+		)
+	}
+	defer func() {
+		if closeErr := dockerClient.Close(); closeErr != nil {
+			ui.Warning(fmt.Sprintf("Failed to close Docker client: %v", closeErr))
+		}
+	}()
+
+	ctx := context.Background()
+	for {
+		rows, err := collectStats(ctx, dockerClient, cfg.Project, serviceNames)
+		if err != nil {
+			return err
+		}
+
+		if noStream {
+			if jsonOutput {
+				return ui.WriteJSON(os.Stdout, rows)
+			}
+			fmt.Print(ui.StatsTable(cfg.Project, rows))
+			return nil
+		}
+
+		clearScreen()
+		fmt.Print(ui.StatsTable(cfg.Project, rows))
+		time.Sleep(statsRefreshInterval)
+	}
+}
+
+// ============================================================================
+// Private Helpers - Sampling
+// ============================================================================
+
+// collectStats samples every running container of the project (optionally only serviceNames) in parallel
+// Containers that stop while being sampled are left out; rows are sorted by service name
+func collectStats(ctx context.Context, client *docker.Client, projectName string, serviceNames []string) ([]ui.StatsRow, error) {
+	containers, err := client.List(ctx, projectName)
+	if err != nil {
+		return nil, utils.DockerError(
+			"stats.list",
+			"Failed to list containers",
+			"Try running 'ork doctor' to diagnose issues",
+			err,
+		)
+	}
+	containers = filterContainersByService(filterRunningContainers(containers), serviceNames)
+
+	var (
+		mu   sync.Mutex
+		wg   sync.WaitGroup
+		rows = make([]ui.StatsRow, 0, len(containers))
+	)
+	for _, c := range containers {
+		wg.Add(1)
+		go func(c docker.ContainerInfo) {
+			defer wg.Done()
+
+			stats, err := client.Stats(ctx, c.ID)
+			if err != nil {
+				return
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+			rows = append(rows, ui.StatsRow{
+				Service:       resolveContainerService(c, projectName),
+				ContainerID:   c.ID,
+				CPUPercent:    stats.CPUPercent,
+				MemoryUsage:   stats.MemoryUsage,
+				MemoryLimit:   stats.MemoryLimit,
+				MemoryPercent: stats.MemoryPercent,
+			})
+		}(c)
+	}
+	wg.Wait()
+
+	sort.Slice(rows, func(i, j int) bool {
+		return rows[i].Service < rows[j].Service
+	})
+	return rows, nil
+}
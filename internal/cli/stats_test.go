@@ -0,0 +1,102 @@
+package cli
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/docker/docker/api/types/container"
+	"github.com/ork-cli/ork/internal/docker/dockertest"
+	"github.com/ork-cli/ork/internal/ui"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const statsTestConfig = `version: "1.0"
+project: shop
+services:
+  api:
+    image: node:18
+  db:
+    image: postgres:15
+  worker:
+    image: node:18
+`
+
+// statsSample builds a stats response with the given CPU readings and memory usage
+func statsSample(prevTotal, total uint64, memory uint64) container.StatsResponse {
+	return container.StatsResponse{
+		PreCPUStats: container.CPUStats{CPUUsage: container.CPUUsage{TotalUsage: prevTotal}, SystemUsage: 10_000, OnlineCPUs: 2},
+		CPUStats:    container.CPUStats{CPUUsage: container.CPUUsage{TotalUsage: total}, SystemUsage: 20_000, OnlineCPUs: 2},
+		MemoryStats: container.MemoryStats{Usage: memory, Limit: 1 << 30},
+	}
+}
+
+// ============================================================================
+// Command Tests
+// ============================================================================
+
+func TestRunStats_NoStreamJSON(t *testing.T) {
+	writeTestConfig(t, statsTestConfig)
+	fake, _ := dockertest.NewServer(t)
+	fake.AddContainer("bbbbbbbbbbbb", "shop", "db", "Up 2 hours")
+	fake.AddContainer("aaaaaaaaaaaa", "shop", "api", "Up 5 minutes")
+	fake.AddContainer("cccccccccccc", "shop", "worker", "Exited (0) 1 minute ago")
+	fake.SetStats("aaaaaaaaaaaa", statsSample(1_000, 2_000, 256<<20))
+	fake.SetStats("bbbbbbbbbbbb", statsSample(1_000, 6_000, 512<<20))
+
+	out := captureStdout(t, func() {
+		require.NoError(t, runStats(nil, true, true))
+	})
+
+	var rows []ui.StatsRow
+	require.NoError(t, json.Unmarshal([]byte(out), &rows), "stdout should be pure JSON: %q", out)
+	require.Len(t, rows, 2, "stopped containers are not sampled")
+
+	assert.Equal(t, "api", rows[0].Service)
+	assert.InDelta(t, 20, rows[0].CPUPercent, 0.0001)
+	assert.Equal(t, uint64(256<<20), rows[0].MemoryUsage)
+	assert.InDelta(t, 25, rows[0].MemoryPercent, 0.0001)
+
+	assert.Equal(t, "db", rows[1].Service)
+	assert.InDelta(t, 100, rows[1].CPUPercent, 0.0001)
+}
+
+func TestRunStats_FiltersServices(t *testing.T) {
+	writeTestConfig(t, statsTestConfig)
+	fake, _ := dockertest.NewServer(t)
+	fake.AddContainer("aaaaaaaaaaaa", "shop", "api", "Up 5 minutes")
+	fake.AddContainer("bbbbbbbbbbbb", "shop", "db", "Up 2 hours")
+
+	out := captureStdout(t, func() {
+		require.NoError(t, runStats([]string{"db"}, true, true))
+	})
+
+	var rows []ui.StatsRow
+	require.NoError(t, json.Unmarshal([]byte(out), &rows))
+	require.Len(t, rows, 1)
+	assert.Equal(t, "db", rows[0].Service)
+	assert.False(t, fake.HasRequest("GET /containers/aaaaaaaaaaaa/stats"))
+}
+
+func TestRunStats_NoStreamTable(t *testing.T) {
+	writeTestConfig(t, statsTestConfig)
+	fake, _ := dockertest.NewServer(t)
+	fake.AddContainer("aaaaaaaaaaaa", "shop", "api", "Up 5 minutes")
+	fake.SetStats("aaaaaaaaaaaa", statsSample(1_000, 2_000, 256<<20))
+
+	out := captureStdout(t, func() {
+		require.NoError(t, runStats(nil, true, false))
+	})
+
+	assert.Contains(t, out, "CPU %")
+	assert.Contains(t, out, "20.00%")
+	assert.Contains(t, out, "256MiB / 1GiB")
+}
+
+func TestRunStats_UnknownService(t *testing.T) {
+	writeTestConfig(t, statsTestConfig)
+
+	err := runStats([]string{"nope"}, true, true)
+
+	require.Error(t, err)
+}
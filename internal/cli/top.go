@@ -0,0 +1,107 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/ork-cli/ork/internal/docker"
+	"github.com/ork-cli/ork/internal/ui"
+	"github.com/ork-cli/ork/pkg/utils"
+	"github.com/spf13/cobra"
+)
+
+// ============================================================================
+// Cobra Command Definition
+// ============================================================================
+
+var topCmd = &cobra.Command{
+	Use:   "top <service>",
+	Short: "Show the processes running in a service's container",
+	Long: `
+Show the processes running inside a service's container, like 'docker top'
+scoped to Ork. The service must be running.`,
+	Example: `
+ork top api                  List the processes in the api container
+ork top api --json           The process list as JSON (for scripting)`,
+
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		if err := runTop(args[0], outputJSON); err != nil {
+			handlePSError(err)
+			return
+		}
+	},
+}
+
+func init() {
+	// Register the 'top' command with the root command
+	rootCmd.AddCommand(topCmd)
+
+	// Add flags
+	addJSONFlag(topCmd)
+}
+
+// ============================================================================
+// Main Orchestrator
+// ============================================================================
+
+// runTop prints the processes running in a service's container
+func runTop(serviceName string, jsonOutput bool) error {
+	// Load configuration to get the project name
+	cfg, err := loadConfigUnvalidated()
+	if err != nil {
+		return err
+	}
+	if err := validateServiceNames([]string{serviceName}, cfg); err != nil {
+		return err
+	}
+
+	dockerClient, err := docker.NewClient()
+	if err != nil {
+		return utils.DockerError(
+			"top.docker",
+			"Failed to connect to Docker",
+			"Make sure Docker is running with 'docker ps' or run 'ork doctor'",
+			err,
+		)
+	}
+	defer func() {
+		if closeErr := dockerClient.Close(); closeErr != nil {
+			ui.Warning(fmt.Sprintf("Failed to close Docker client: %v", closeErr))
+		}
+	}()
+
+	// Resolve the service to its running container
+	ctx := context.Background()
+	containers, err := dockerClient.ListByService(ctx, cfg.Project, serviceName)
+	if err != nil {
+		return utils.DockerError(
+			"top.list",
+			"Failed to list containers",
+			"Try running 'ork doctor' to diagnose issues",
+			err,
+		)
+	}
+
+	containerID, err := resolveRunningContainer(containers, serviceName, "top.resolve")
+	if err != nil {
+		return err
+	}
+
+	result, err := dockerClient.Top(ctx, containerID)
+	if err != nil {
+		return utils.DockerError(
+			"top.processes",
+			fmt.Sprintf("Failed to list processes in service '%s'", serviceName),
+			fmt.Sprintf("Check that it is still running with 'ork ps', or see 'ork logs %s'", serviceName),
+			err,
+		)
+	}
+
+	if jsonOutput {
+		return ui.WriteJSON(os.Stdout, result)
+	}
+	fmt.Print(ui.ProcessTable(serviceName, containerID, result.Titles, result.Processes))
+	return nil
+}
@@ -0,0 +1,82 @@
+package cli
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/ork-cli/ork/internal/docker"
+	"github.com/ork-cli/ork/internal/docker/dockertest"
+	"github.com/ork-cli/ork/pkg/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const topTestConfig = `version: "1.0"
+project: shop
+services:
+  api:
+    image: node:18
+  db:
+    image: postgres:15
+`
+
+// serveTop registers a fake top endpoint for a container
+func serveTop(fake *dockertest.Server, containerID string) {
+	fake.Handle(http.MethodGet, "/containers/"+containerID+"/top", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"Titles":    []string{"PID", "USER", "CMD"},
+			"Processes": [][]string{{"1", "node", "node server.js"}},
+		})
+	})
+}
+
+// ============================================================================
+// Command Tests
+// ============================================================================
+
+func TestRunTop_RendersProcessTable(t *testing.T) {
+	writeTestConfig(t, topTestConfig)
+	fake, _ := dockertest.NewServer(t)
+	fake.AddContainer("aaaaaaaaaaaa", "shop", "api", "Up 5 minutes")
+	serveTop(fake, "aaaaaaaaaaaa")
+
+	out := captureStdout(t, func() {
+		require.NoError(t, runTop("api", false))
+	})
+
+	assert.Contains(t, out, "Processes in")
+	assert.Contains(t, out, "CMD")
+	assert.Contains(t, out, "node server.js")
+}
+
+func TestRunTop_JSON(t *testing.T) {
+	writeTestConfig(t, topTestConfig)
+	fake, _ := dockertest.NewServer(t)
+	fake.AddContainer("aaaaaaaaaaaa", "shop", "api", "Up 5 minutes")
+	serveTop(fake, "aaaaaaaaaaaa")
+
+	out := captureStdout(t, func() {
+		require.NoError(t, runTop("api", true))
+	})
+
+	var result docker.TopResult
+	require.NoError(t, json.Unmarshal([]byte(out), &result), "stdout should be pure JSON: %q", out)
+	assert.Equal(t, []string{"PID", "USER", "CMD"}, result.Titles)
+	assert.Equal(t, [][]string{{"1", "node", "node server.js"}}, result.Processes)
+}
+
+func TestRunTop_StoppedService(t *testing.T) {
+	writeTestConfig(t, topTestConfig)
+	fake, _ := dockertest.NewServer(t)
+	fake.AddContainer("bbbbbbbbbbbb", "shop", "db", "Exited (0) 1 minute ago")
+
+	err := runTop("db", false)
+
+	var orkErr *utils.OrkError
+	require.ErrorAs(t, err, &orkErr)
+	assert.Equal(t, "top.resolve", orkErr.Op)
+	assert.Contains(t, orkErr.Message, "not running")
+	assert.Contains(t, orkErr.Hint, "ork up db")
+	assert.False(t, fake.HasRequest("GET /containers/bbbbbbbbbbbb/top"))
+}
@@ -3,6 +3,12 @@ package cli
 import (
 	"context"
 	"fmt"
+	"os"
+	"os/signal"
+	"sort"
+	"strconv"
+	"strings"
+	"syscall"
 
 	"github.com/ork-cli/ork/internal/config"
 	"github.com/ork-cli/ork/internal/docker"
@@ -17,22 +23,56 @@ import (
 // ============================================================================
 
 var upCmd = &cobra.Command{
-	Use:   "up <service> [service...]",
+	Use:   "up [service...]",
 	Short: "Start services and their dependencies",
 	Long: `
 Start one or more services along with their dependencies.
 
 Ork automatically resolves and starts all required dependencies in the correct order.
 For example, if 'frontend' depends on 'api', and 'api' depends on 'postgres',
-running 'ork up frontend' will start all three services.`,
+running 'ork up frontend' will start all three services.
+
+Use --dry-run to print the dependency levels and the exact container options
+(image, ports, env, labels) for each service without contacting Docker.
+
+Use --pull to choose when images are pulled: 'missing' (the default) only
+pulls images that aren't present locally, 'always' pulls on every run so
+moving tags like :latest stay current, and 'never' fails on a missing image.
+Digest-pinned images (nginx@sha256:...) are never re-pulled once present.
+
+Use --scale service=N to override how many containers a service runs.
+Each service runs a single container, so N is 0 (don't start the service,
+e.g. when it already runs outside Ork) or 1. Services that depend on a
+service scaled to 0 still start, without waiting on it.
+
+Use --profile NAME to also start every service that declares that profile
+(see 'ork config --profiles'). With a profile, service names are optional.`,
 	Example: `
 ork up frontend              Start frontend (and its dependencies)
 ork up frontend api          Start multiple services
-ork up --local frontend      Build and run from local source`,
+ork up --local frontend      Build and run from local source
+ork up --timing api          Show a per-service startup timing breakdown
+ork up --dry-run frontend    Show the start plan without starting anything
+ork up --pull always api     Pull the latest images before starting
+ork up --scale db=0 api      Start api without its db dependency
+ork up --profile debug       Start every service in the debug profile
+ork up api --profile debug   Start api plus the debug profile's services`,
 
-	Args: cobra.MinimumNArgs(1), // Require at least one service name
+	Args: func(cmd *cobra.Command, args []string) error {
+		// Require at least one service name, unless a profile selects the services
+		if profiles, _ := cmd.Flags().GetStringArray("profile"); len(profiles) > 0 {
+			return nil
+		}
+		return cobra.MinimumNArgs(1)(cmd, args)
+	},
 	Run: func(cmd *cobra.Command, args []string) {
-		if err := runUp(args); err != nil {
+		showTiming, _ := cmd.Flags().GetBool("timing")
+		dryRun, _ := cmd.Flags().GetBool("dry-run")
+		pull, _ := cmd.Flags().GetString("pull")
+		scale, _ := cmd.Flags().GetStringArray("scale")
+		profiles, _ := cmd.Flags().GetStringArray("profile")
+
+		if err := runUp(args, profiles, showTiming, dryRun, pull, scale); err != nil {
 			handleUpError(err)
 			return
 		}
@@ -46,6 +86,11 @@ func init() {
 	// Add flags (options) to the command
 	upCmd.Flags().Bool("local", false, "Build and run from local source")
 	upCmd.Flags().Bool("dev", false, "Use development registry images")
+	upCmd.Flags().Bool("timing", false, "Print build, pull, create, start, and health-wait times per service")
+	upCmd.Flags().Bool("dry-run", false, "Print the start plan without touching Docker")
+	upCmd.Flags().String("pull", string(docker.PullMissing), "When to pull images: always, missing, or never")
+	upCmd.Flags().StringArray("scale", nil, "Override a service's container count (SERVICE=N, where N is 0 or 1, repeatable)")
+	upCmd.Flags().StringArray("profile", nil, "Also start the services in this profile (repeatable)")
 }
 
 // ============================================================================
@@ -53,7 +98,32 @@ func init() {
 // ============================================================================
 
 // runUp orchestrates the service startup process
-func runUp(serviceNames []string) error {
+// profiles are the --profile values whose services are started along with serviceNames
+// With showTiming, a per-service phase breakdown is printed after a successful start
+// With dryRun, the start plan is printed and Docker is never contacted
+// pull is the --pull policy deciding when images are pulled (empty means missing)
+// scale holds the --scale SERVICE=N overrides; services scaled to 0 are left out of the start
+func runUp(serviceNames, profiles []string, showTiming, dryRun bool, pull string, scale []string) error {
+	pullPolicy, err := docker.ParsePullPolicy(pull)
+	if err != nil {
+		return utils.ConfigError("up.pull", "Invalid --pull value", "Use --pull always, missing, or never", err)
+	}
+
+	scaleOverrides, err := parseScaleFlags(scale)
+	if err != nil {
+		return err
+	}
+
+	// Pulling every time reaches out to the registry, which offline mode forbids
+	if pullPolicy == docker.PullAlways && noPull {
+		return utils.ConfigError(
+			"up.pull",
+			"Cannot use --pull always together with --no-pull",
+			"Drop one of the flags (or unset ORK_OFFLINE)",
+			nil,
+		)
+	}
+
 	// Load and validate configuration
 	cfg, err := loadAndValidateConfig()
 	if err != nil {
@@ -65,16 +135,10 @@ func runUp(serviceNames []string) error {
 		return err
 	}
 
-	// Create a Docker client
-	dockerClient, err := createDockerClient()
-	if err != nil {
+	// Add the services of each --profile
+	if serviceNames, err = addProfileServices(serviceNames, profiles, cfg); err != nil {
 		return err
 	}
-	defer func() {
-		if closeErr := dockerClient.Close(); closeErr != nil {
-			ui.Warning(fmt.Sprintf("Failed to close Docker client: %v", closeErr))
-		}
-	}()
 
 	// Resolve dependencies and get services in the correct start order
 	orderedServices, err := service.ResolveDependencies(cfg.Services, serviceNames)
@@ -87,8 +151,36 @@ func runUp(serviceNames []string) error {
 		)
 	}
 
+	// Apply --scale overrides, dropping the services scaled to 0
+	if len(scaleOverrides) > 0 {
+		if serviceNames, orderedServices, err = applyScale(cfg, serviceNames, orderedServices, scaleOverrides); err != nil {
+			return err
+		}
+	}
+
+	// In dry-run mode, print the plan before any Docker client exists
+	if dryRun {
+		return printUpPlan(cfg, serviceNames, orderedServices)
+	}
+
+	// Create a Docker client
+	dockerClient, err := createDockerClient()
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if closeErr := dockerClient.Close(); closeErr != nil {
+			ui.Warning(fmt.Sprintf("Failed to close Docker client: %v", closeErr))
+		}
+	}()
+
+	// Cancel the start on Ctrl+C so services that already started get rolled back
+	// After the first signal the default handling returns, so a second Ctrl+C exits immediately
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	context.AfterFunc(ctx, stop)
+
 	// Create a project network for service communication
-	ctx := context.Background()
 	spinner := ui.ShowSpinner("Creating project network...")
 	networkID, err := dockerClient.CreateNetwork(ctx, cfg.Project)
 	if err != nil {
@@ -118,73 +210,153 @@ func runUp(serviceNames []string) error {
 	for _, serviceName := range orderedServices {
 		orchestrator.AddService(serviceName, cfg.Services[serviceName])
 	}
+	orchestrator.SetPullPolicy(pullPolicy)
 
 	// Start services with parallel execution, health checks, and rollback
 	if err := orchestrator.StartServicesInOrder(ctx, orderedServices, cfg); err != nil {
+		if ctx.Err() != nil {
+			return utils.ServiceError(
+				"up.interrupted",
+				"Interrupted - services started so far were rolled back",
+				"Run 'ork up' again to start them",
+				err,
+			)
+		}
 		return err
 	}
 
 	ui.EmptyLine()
 	ui.SuccessBox(fmt.Sprintf("All services started successfully! %s", ui.SymbolRocket))
+
+	if showTiming {
+		ui.EmptyLine()
+		fmt.Print(ui.TimingTable(buildTimingRows(orchestrator, orderedServices)))
+	}
 	return nil
 }
 
-// ============================================================================
-// Private Helpers - Configuration
-// ============================================================================
+// printUpPlan shows what 'ork up' would start, level by level, without touching Docker
+func printUpPlan(cfg *config.Config, serviceNames, orderedServices []string) error {
+	ui.EmptyLine()
+	ui.Info(fmt.Sprintf("Project: %s (v%s)", ui.Bold(cfg.Project), cfg.Version))
+	ui.Info(fmt.Sprintf("Starting: %s", ui.Highlight(fmt.Sprintf("%v", serviceNames))))
 
-// loadAndValidateConfig loads the ork.yml file and validates it
-func loadAndValidateConfig() (*config.Config, error) {
-	cfg, err := config.Load()
-	if err != nil {
-		return nil, utils.ConfigError(
-			"up.load",
-			"Failed to load configuration",
-			"Make sure ork.yml exists in the current directory",
-			err,
-		)
+	// No client or network - planning never calls Docker
+	orchestrator := service.NewOrchestrator(cfg.Project, nil, "")
+	for _, serviceName := range orderedServices {
+		orchestrator.AddService(serviceName, cfg.Services[serviceName])
 	}
 
-	if err := cfg.Validate(); err != nil {
-		return nil, utils.ConfigError(
-			"up.validate",
-			"Invalid configuration",
-			"Check your ork.yml for errors",
-			err,
-		)
-	}
+	return orchestrator.DryRun(orderedServices, cfg)
+}
+
+// buildTimingRows collects the recorded startup phases for each service, in start order
+func buildTimingRows(orchestrator *service.Orchestrator, serviceNames []string) []ui.TimingRow {
+	rows := make([]ui.TimingRow, 0, len(serviceNames))
+	for _, name := range serviceNames {
+		svc, ok := orchestrator.GetService(name)
+		if !ok {
+			continue
+		}
 
-	return cfg, nil
+		timings := svc.GetTimings()
+		rows = append(rows, ui.TimingRow{
+			Service:    name,
+			Build:      timings.Build,
+			Pull:       timings.Pull,
+			Create:     timings.Create,
+			Start:      timings.Start,
+			HealthWait: timings.HealthWait,
+			Total:      timings.Total,
+		})
+	}
+	return rows
 }
 
 // ============================================================================
-// Private Helpers - Service Validation
+// Private Helpers - Scaling
 // ============================================================================
 
-// validateServiceNames checks if all requested services exist in the config
-func validateServiceNames(serviceNames []string, cfg *config.Config) error {
-	for _, serviceName := range serviceNames {
-		if _, exists := cfg.Services[serviceName]; !exists {
-			availableServices := getAvailableServicesList(cfg)
-			suggestions := utils.FindSuggestions(serviceName, availableServices, 3)
+// parseScaleFlags parses repeated --scale SERVICE=N values into a map of service -> count
+// N must be a non-negative integer; a later value for the same service wins
+func parseScaleFlags(values []string) (map[string]int, error) {
+	scale := make(map[string]int, len(values))
+	for _, value := range values {
+		name, countText, ok := strings.Cut(value, "=")
+		name = strings.TrimSpace(name)
+		if !ok || name == "" {
+			return nil, utils.ConfigError(
+				"up.scale",
+				fmt.Sprintf("Invalid --scale value '%s'", value),
+				"Use --scale SERVICE=N, e.g. --scale db=0",
+				nil,
+			)
+		}
 
-			err := utils.ErrServiceNotFound(serviceName, suggestions)
-			err.Details = []string{
-				fmt.Sprintf("Available services: %s", ui.Dim(fmt.Sprintf("%v", availableServices))),
-			}
-			return err
+		count, err := strconv.Atoi(strings.TrimSpace(countText))
+		if err != nil || count < 0 {
+			return nil, utils.ConfigError(
+				"up.scale",
+				fmt.Sprintf("Invalid --scale count for %s: '%s'", name, countText),
+				"The count must be a whole number of 0 or more, e.g. --scale db=0",
+				err,
+			)
 		}
+		scale[name] = count
 	}
-	return nil
+	return scale, nil
 }
 
-// getAvailableServicesList returns a slice of available service names
-func getAvailableServicesList(cfg *config.Config) []string {
-	services := make([]string, 0, len(cfg.Services))
-	for name := range cfg.Services {
-		services = append(services, name)
+// applyScale applies --scale overrides to the services about to start
+// Services scaled to 0 are removed from both the requested and the ordered lists; each service
+// runs a single container, so a count above 1 is rejected
+func applyScale(cfg *config.Config, serviceNames, orderedServices []string, scale map[string]int) (requested, ordered []string, err error) {
+	names := make([]string, 0, len(scale))
+	for name := range scale {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	if err := validateServiceNames(names, cfg); err != nil {
+		return nil, nil, err
+	}
+
+	for _, name := range names {
+		if count := scale[name]; count > 1 {
+			return nil, nil, utils.ConfigError(
+				"up.scale",
+				fmt.Sprintf("Cannot scale %s to %d containers", name, count),
+				fmt.Sprintf("Each service runs a single container - use --scale %s=1, or %s=0 to skip it", name, name),
+				nil,
+			)
+		}
+	}
+
+	skip := func(name string) bool {
+		count, ok := scale[name]
+		return ok && count == 0
+	}
+	for _, name := range orderedServices {
+		if skip(name) {
+			ui.Info(fmt.Sprintf("Skipping %s %s", ui.Bold(name), ui.Dim("(--scale 0)")))
+			continue
+		}
+		ordered = append(ordered, name)
+	}
+	for _, name := range serviceNames {
+		if !skip(name) {
+			requested = append(requested, name)
+		}
+	}
+
+	if len(requested) == 0 {
+		return nil, nil, utils.ConfigError(
+			"up.scale",
+			"No services left to start",
+			"Every requested service is scaled to 0 - drop a --scale SERVICE=0",
+			nil,
+		)
 	}
-	return services
+	return requested, ordered, nil
 }
 
 // ============================================================================
@@ -207,6 +379,8 @@ func createDockerClient() (*docker.Client, error) {
 
 // handleUpError formats and displays errors with hints
 func handleUpError(err error) {
+	commandErr = err
+
 	if orkErr, ok := err.(*utils.OrkError); ok {
 		// Display structured error with hints
 		ui.Error(orkErr.Message)
@@ -0,0 +1,256 @@
+package cli
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/ork-cli/ork/internal/docker/dockertest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const upTestConfig = `version: "1.0"
+project: shop
+services:
+  db:
+    image: postgres:15
+  api:
+    image: node:18-alpine
+    ports:
+      - "8080:8080"
+    env:
+      DATABASE_HOST: db
+    depends_on: [db]
+  web:
+    image: nginx:alpine
+    depends_on: [api]
+`
+
+// ============================================================================
+// Dry Run Tests
+// ============================================================================
+
+func TestRunUp_DryRunMakesNoDockerCalls(t *testing.T) {
+	writeTestConfig(t, upTestConfig)
+	fake, _ := dockertest.NewServer(t)
+	before := len(fake.Requests())
+
+	var err error
+	out := captureStdout(t, func() {
+		err = runUp([]string{"web"}, nil, false, true, "", nil)
+	})
+	require.NoError(t, err)
+
+	assert.Len(t, fake.Requests(), before, "dry run must not contact Docker at all")
+	assert.Contains(t, out, "Dry run")
+	assert.Contains(t, out, "image node:18-alpine")
+	assert.Contains(t, out, "port 8080:8080")
+	assert.Contains(t, out, "env DATABASE_HOST=db")
+	assert.Contains(t, out, "label ork.service=api")
+}
+
+func TestRunUp_DryRunPrintsLevelsInOrder(t *testing.T) {
+	writeTestConfig(t, upTestConfig)
+	dockertest.NewServer(t)
+
+	out := captureStdout(t, func() {
+		require.NoError(t, runUp([]string{"web"}, nil, false, true, "", nil))
+	})
+
+	level1, level2, level3 := strings.Index(out, "Level 1: [db]"), strings.Index(out, "Level 2: [api]"), strings.Index(out, "Level 3: [web]")
+	require.True(t, level1 >= 0 && level2 >= 0 && level3 >= 0, "every level should be printed: %q", out)
+	assert.Less(t, level1, level2)
+	assert.Less(t, level2, level3)
+}
+
+// ============================================================================
+// Profile Tests
+// ============================================================================
+
+const upProfileTestConfig = `version: "1.0"
+project: shop
+services:
+  api:
+    image: node:18-alpine
+  debugger:
+    image: delve:latest
+    profiles: [debug]
+  grafana:
+    image: grafana:latest
+    profiles: [monitoring]
+`
+
+func TestRunUp_ProfileAddsItsServices(t *testing.T) {
+	writeTestConfig(t, upProfileTestConfig)
+	dockertest.NewServer(t)
+
+	out := captureStdout(t, func() {
+		require.NoError(t, runUp([]string{"api"}, []string{"debug"}, false, true, "", nil))
+	})
+
+	assert.Contains(t, out, "Starting: [api debugger]")
+	assert.NotContains(t, out, "grafana")
+}
+
+func TestRunUp_ProfileWithoutServiceNames(t *testing.T) {
+	writeTestConfig(t, upProfileTestConfig)
+	dockertest.NewServer(t)
+
+	out := captureStdout(t, func() {
+		require.NoError(t, runUp(nil, []string{"monitoring"}, false, true, "", nil))
+	})
+
+	assert.Contains(t, out, "Starting: [grafana]")
+}
+
+func TestRunUp_UnknownProfile(t *testing.T) {
+	writeTestConfig(t, upProfileTestConfig)
+
+	err := runUp(nil, []string{"debgu"}, false, true, "", nil)
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "Profile 'debgu' not found")
+}
+
+// ============================================================================
+// Pull Policy Tests
+// ============================================================================
+
+const upPullTestConfig = `version: "1.0"
+project: shop
+services:
+  db:
+    image: postgres:15
+  api:
+    image: node:18-alpine
+    depends_on: [db]
+`
+
+func TestRunUp_PullAlwaysPullsPresentImages(t *testing.T) {
+	writeTestConfig(t, upPullTestConfig)
+	fake, _ := dockertest.NewServer(t)
+
+	captureStdout(t, func() {
+		require.NoError(t, runUp([]string{"api"}, nil, false, false, "always", nil))
+	})
+
+	assert.Equal(t, 2, fake.RequestCount("POST /images/create"), "every image is pulled even though it's present")
+}
+
+func TestRunUp_DefaultPullSkipsPresentImages(t *testing.T) {
+	writeTestConfig(t, upPullTestConfig)
+	fake, _ := dockertest.NewServer(t)
+
+	captureStdout(t, func() {
+		require.NoError(t, runUp([]string{"api"}, nil, false, false, "", nil))
+	})
+
+	assert.False(t, fake.HasRequest("POST /images/create"))
+}
+
+func TestRunUp_RejectsInvalidPullPolicy(t *testing.T) {
+	writeTestConfig(t, upPullTestConfig)
+	fake, _ := dockertest.NewServer(t)
+	before := len(fake.Requests())
+
+	err := runUp([]string{"api"}, nil, false, false, "sometimes", nil)
+
+	assert.ErrorContains(t, err, "Invalid --pull value")
+	assert.Len(t, fake.Requests(), before)
+}
+
+func TestRunUp_PullAlwaysConflictsWithNoPull(t *testing.T) {
+	writeTestConfig(t, upPullTestConfig)
+	noPull = true
+	t.Cleanup(func() { noPull = false })
+
+	err := runUp([]string{"api"}, nil, false, false, "always", nil)
+
+	assert.ErrorContains(t, err, "--no-pull")
+}
+
+// ============================================================================
+// Scale Tests
+// ============================================================================
+
+func TestParseScaleFlags(t *testing.T) {
+	tests := []struct {
+		name    string
+		values  []string
+		want    map[string]int
+		wantErr string
+	}{
+		{name: "none", values: nil, want: map[string]int{}},
+		{name: "single", values: []string{"api=3"}, want: map[string]int{"api": 3}},
+		{name: "repeated", values: []string{"api=1", "db=0"}, want: map[string]int{"api": 1, "db": 0}},
+		{name: "later value wins", values: []string{"api=0", "api=1"}, want: map[string]int{"api": 1}},
+		{name: "surrounding spaces", values: []string{" api = 1 "}, want: map[string]int{"api": 1}},
+		{name: "missing count", values: []string{"api"}, wantErr: "Invalid --scale value 'api'"},
+		{name: "missing service", values: []string{"=2"}, wantErr: "Invalid --scale value '=2'"},
+		{name: "negative count", values: []string{"api=-1"}, wantErr: "Invalid --scale count for api"},
+		{name: "non-numeric count", values: []string{"api=two"}, wantErr: "Invalid --scale count for api"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseScaleFlags(tt.values)
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestRunUp_ScaleZeroSkipsDependency(t *testing.T) {
+	writeTestConfig(t, upPullTestConfig)
+	fake, _ := dockertest.NewServer(t)
+
+	out := captureStdout(t, func() {
+		require.NoError(t, runUp([]string{"api"}, nil, false, false, "", []string{"db=0"}))
+	})
+
+	assert.Equal(t, 1, fake.RequestCount("POST /containers/create"), "only api is created")
+	assert.Contains(t, out, "Level 2: [api]")
+	assert.NotContains(t, out, "Level 1")
+}
+
+func TestRunUp_ScaleOneKeepsService(t *testing.T) {
+	writeTestConfig(t, upPullTestConfig)
+	fake, _ := dockertest.NewServer(t)
+
+	captureStdout(t, func() {
+		require.NoError(t, runUp([]string{"api"}, nil, false, false, "", []string{"db=1"}))
+	})
+
+	assert.Equal(t, 2, fake.RequestCount("POST /containers/create"))
+}
+
+func TestRunUp_ScaleErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		scale   []string
+		wantErr string
+	}{
+		{name: "more than one container", scale: []string{"api=3"}, wantErr: "Cannot scale api to 3 containers"},
+		{name: "unknown service", scale: []string{"cache=0"}, wantErr: "cache"},
+		{name: "every requested service skipped", scale: []string{"api=0"}, wantErr: "No services left to start"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			writeTestConfig(t, upPullTestConfig)
+			fake, _ := dockertest.NewServer(t)
+
+			var err error
+			captureStdout(t, func() {
+				err = runUp([]string{"api"}, nil, false, false, "", tt.scale)
+			})
+
+			assert.ErrorContains(t, err, tt.wantErr)
+			assert.False(t, fake.HasRequest("POST /containers/create"))
+		})
+	}
+}
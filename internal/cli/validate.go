@@ -0,0 +1,101 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/ork-cli/ork/internal/ui"
+	"github.com/ork-cli/ork/pkg/utils"
+	"github.com/spf13/cobra"
+)
+
+// ============================================================================
+// Cobra Command Definition
+// ============================================================================
+
+var validateCmd = &cobra.Command{
+	Use:   "validate",
+	Short: "Check ork.yml and report every problem at once",
+	Long: `
+Validate ork.yml without starting anything.
+
+Unlike 'ork up', which stops at the first problem, this checks the whole
+configuration and lists every error it finds (project settings, service
+sources, ports, dependencies, and health checks), so they can all be fixed
+in one pass. It exits non-zero when any are found, for use in CI.`,
+	Example: `
+ork validate                     Check the project's ork.yml
+ork validate --file ci/ork.yml   Check a specific config file`,
+
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		if err := runValidate(); err != nil {
+			handleUpError(err)
+			return
+		}
+	},
+}
+
+func init() {
+	// Register the 'validate' command with the root command
+	rootCmd.AddCommand(validateCmd)
+}
+
+// ============================================================================
+// Main Orchestrator
+// ============================================================================
+
+// runValidate loads ork.yml and reports every validation error it contains
+func runValidate() error {
+	cfg, err := loadConfigUnvalidated()
+	if err != nil {
+		return err
+	}
+
+	errs := cfg.ValidateAll()
+	if len(errs) == 0 {
+		ui.Success(fmt.Sprintf("ork.yml is valid (%d service(s))", len(cfg.Services)))
+		return nil
+	}
+
+	problems := make([]string, len(errs))
+	for i, validationErr := range errs {
+		problems[i] = describeProblem(validationErr)
+	}
+	fmt.Print(ui.RenderNumberedList("Problems in ork.yml", problems))
+	ui.EmptyLine()
+
+	return utils.ConfigError(
+		"config.validate",
+		fmt.Sprintf("Found %d problem(s) in ork.yml", len(errs)),
+		"Fix the problems listed above and run 'ork validate' again",
+		nil,
+	)
+}
+
+// ============================================================================
+// Helper Functions
+// ============================================================================
+
+// genericValidationHint is the default hint on validation errors, which adds nothing per problem
+const genericValidationHint = "Check your configuration for errors"
+
+// describeProblem renders one validation error as its message followed by any hint,
+// details (e.g. the dependency cycle), and suggestions on indented lines
+func describeProblem(err error) string {
+	var orkErr *utils.OrkError
+	if !errors.As(err, &orkErr) {
+		return err.Error()
+	}
+
+	lines := []string{orkErr.Message}
+	if orkErr.Hint != "" && orkErr.Hint != genericValidationHint {
+		lines = append(lines, orkErr.Hint)
+	}
+	lines = append(lines, orkErr.Details...)
+	if len(orkErr.Suggestions) > 0 {
+		lines = append(lines, fmt.Sprintf("Did you mean: %s?", strings.Join(orkErr.Suggestions, ", ")))
+	}
+	return strings.Join(lines, "\n     ")
+}
@@ -0,0 +1,75 @@
+package cli
+
+import (
+	"testing"
+
+	"github.com/ork-cli/ork/pkg/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// ============================================================================
+// Validate Tests
+// ============================================================================
+
+func TestRunValidate_ReportsEveryProblem(t *testing.T) {
+	writeTestConfig(t, `version: "1.0"
+project: shop
+services:
+  api:
+    image: node:18
+    depends_on: [missing]
+  web:
+    image: nginx:alpine
+    ports: ["not-a-port"]
+`)
+
+	var err error
+	out := captureStdout(t, func() {
+		err = runValidate()
+	})
+
+	require.Error(t, err)
+	assert.True(t, utils.IsKind(err, utils.ErrorConfig))
+	assert.Equal(t, 2, utils.ExitCode(err))
+	assert.Contains(t, err.Error(), "Found 2 problem(s)")
+	assert.Contains(t, out, "1.")
+	assert.Contains(t, out, "service 'api': depends_on references unknown service 'missing'")
+	assert.Contains(t, out, "2.")
+	assert.Contains(t, out, "service 'web':")
+}
+
+func TestRunValidate_ShowsReasonAndCyclePath(t *testing.T) {
+	writeTestConfig(t, `version: "1.0"
+project: shop
+services:
+  a:
+    image: node:18
+    depends_on: [b]
+    health:
+      interval: 5x
+  b:
+    image: node:18
+    depends_on: [a]
+`)
+
+	var err error
+	out := captureStdout(t, func() {
+		err = runValidate()
+	})
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "Found 2 problem(s)")
+	assert.Contains(t, out, "Invalid configuration: services.a.health.interval")
+	assert.Contains(t, out, "not a valid duration")
+	assert.Contains(t, out, "Circular dependency detected")
+	assert.Contains(t, out, "a → b → a")
+	assert.NotContains(t, out, "operation:")
+	assert.NotContains(t, out, "service 'a': Invalid configuration")
+}
+
+func TestRunValidate_ValidConfig(t *testing.T) {
+	writeTestConfig(t, configShowTestConfig)
+
+	require.NoError(t, runValidate())
+}
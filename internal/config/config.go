@@ -1,10 +1,15 @@
 package config
 
 // Config represents the entire ork.yml file structure
+// Marshaling a loaded Config back to YAML reproduces an equivalent ork.yml, with unset fields left out
 type Config struct {
 	Version  string             `yaml:"version"`  // e.g., "1.0"
 	Project  string             `yaml:"project"`  // Project name
 	Services map[string]Service `yaml:"services"` // Map of service name -> Service
+
+	MaxParallel int `yaml:"max_parallel,omitempty"` // Max services started at once within a dependency level (default: 4)
+
+	BaseDir string `yaml:"-"` // Directory containing the config file, where .env files live (set by the loader)
 }
 
 // Service represents a single service definition
@@ -14,13 +19,34 @@ type Service struct {
 	Image string `yaml:"image,omitempty"` // Docker image (e.g., nginx:alpine)
 	Build *Build `yaml:"build,omitempty"` // Build from a local source
 
+	// Reuse another service's definition as a base (fields left unset here are inherited)
+	Extends string `yaml:"extends,omitempty"` // Name of the service to extend (e.g., api)
+
 	// Runtime configuration
 	Ports      []string          `yaml:"ports,omitempty"`      // Port mappings (e.g., "3000:3000")
 	Env        map[string]string `yaml:"env,omitempty"`        // Environment variables
-	DependsOn  []string          `yaml:"depends_on,omitempty"` // Service dependencies
+	DependsOn  DependsOn         `yaml:"depends_on,omitempty"` // Service dependencies (list of names, or map with conditions)
 	Health     *HealthCheck      `yaml:"health,omitempty"`     // Health check config
 	Command    []string          `yaml:"command,omitempty"`    // Override container command
 	Entrypoint []string          `yaml:"entrypoint,omitempty"` // Override entrypoint
+	Volumes    []string          `yaml:"volumes,omitempty"`    // Volume mounts (e.g., "./data:/var/lib/data:ro", "pgdata:/var/lib/postgresql/data")
+	Profiles   []string          `yaml:"profiles,omitempty"`   // Profiles this service belongs to (e.g., "debug", "monitoring")
+	Restart    string            `yaml:"restart,omitempty"`    // Restart policy: no (default), always, on-failure[:max-retries], unless-stopped
+	Resources  *Resources        `yaml:"resources,omitempty"`  // CPU and memory limits (unlimited when unset)
+	Labels     map[string]string `yaml:"labels,omitempty"`     // Extra container labels (e.g., Traefik routing); "ork." keys are reserved
+
+	// Network configuration
+	NetworkMode string   `yaml:"network_mode,omitempty"` // bridge (default, joins the project network), host, or none
+	Aliases     []string `yaml:"aliases,omitempty"`      // Extra DNS names on the project network (the service name is always one)
+
+	// Shutdown configuration
+	StopTimeout     string `yaml:"stop_timeout,omitempty"`      // Time between the stop signal and SIGKILL (e.g., 30s, default: 10s)
+	StopGracePeriod string `yaml:"stop_grace_period,omitempty"` // Time to wait for an exit after the stop signal, before stop_timeout starts (e.g., 2m, default: none)
+
+	// Readiness configuration
+	WaitForNativeHealth bool     `yaml:"wait_for_native_health,omitempty"` // Wait on the image's own HEALTHCHECK when no Ork check is set
+	WaitFor             []string `yaml:"wait_for,omitempty"`               // External host:port addresses that must accept connections before starting (e.g., db.internal:5432)
+	WaitForTimeout      string   `yaml:"wait_for_timeout,omitempty"`       // How long to wait for every wait_for address (e.g., 2m, default: 60s)
 }
 
 // Build represents build configuration for building from source
@@ -28,17 +54,57 @@ type Build struct {
 	Context    string            `yaml:"context"`              // Build context path
 	Dockerfile string            `yaml:"dockerfile,omitempty"` // Dockerfile path (default: Dockerfile)
 	Args       map[string]string `yaml:"args,omitempty"`       // Build arguments
+	Target     *string           `yaml:"target,omitempty"`     // Multi-stage build target (nil to build the final stage)
+	CacheFrom  []string          `yaml:"cache_from,omitempty"` // Images to use as build cache sources
+	HashTag    bool              `yaml:"hash_tag,omitempty"`   // Also tag the image with a hash of its inputs, and skip rebuilding unchanged inputs
 }
 
 // HealthCheck represents health check configuration
 type HealthCheck struct {
-	Endpoint string `yaml:"endpoint"` // HTTP endpoint to check (e.g., /health)
-	Interval string `yaml:"interval"` // Check interval (e.g., 5s)
-	Timeout  string `yaml:"timeout"`  // Request timeout (e.g., 3s)
-	Retries  int    `yaml:"retries"`  // Number of retries before unhealthy
+	Type        string            `yaml:"type,omitempty"`         // Check type: "http" (default) or "tcp"
+	Endpoint    string            `yaml:"endpoint,omitempty"`     // HTTP endpoint to check (e.g., /health)
+	Method      string            `yaml:"method,omitempty"`       // HTTP method for the check (default: GET)
+	Headers     map[string]string `yaml:"headers,omitempty"`      // HTTP headers sent with the check (e.g., Authorization)
+	Command     []string          `yaml:"command,omitempty"`      // Command to run inside the container (e.g., ["pg_isready"])
+	Interval    string            `yaml:"interval,omitempty"`     // Check interval (e.g., 5s)
+	Timeout     string            `yaml:"timeout,omitempty"`      // Request timeout (e.g., 3s)
+	Retries     int               `yaml:"retries,omitempty"`      // Number of retries before unhealthy
+	StartPeriod string            `yaml:"start_period,omitempty"` // Overall time to wait for healthy on startup (e.g., 2m, default: 30s)
+	Disable     bool              `yaml:"disable,omitempty"`      // Turn off the image's built-in HEALTHCHECK (no health checks at all)
+}
+
+// Health check kinds
+const (
+	HealthCheckNone    = ""        // No Ork health check configured
+	HealthCheckHTTP    = "http"    // HTTP GET against an endpoint on the first host port
+	HealthCheckTCP     = "tcp"     // TCP connect to the first host port
+	HealthCheckCommand = "command" // Command executed inside the container
+)
+
+// Kind returns which kind of health check is configured
+// A command takes precedence, then an explicit type, then an endpoint implies HTTP
+func (h *HealthCheck) Kind() string {
+	switch {
+	case h == nil, h.Disable:
+		return HealthCheckNone
+	case len(h.Command) > 0:
+		return HealthCheckCommand
+	case h.Type == HealthCheckTCP:
+		return HealthCheckTCP
+	case h.Endpoint != "":
+		return HealthCheckHTTP
+	default:
+		return HealthCheckNone
+	}
+}
+
+// Disabled reports whether health checks, including the image's own HEALTHCHECK, are turned off
+func (h *HealthCheck) Disabled() bool {
+	return h != nil && h.Disable
 }
 
 // GlobalConfig represents the global ~/.ork/config.yml file structure
 type GlobalConfig struct {
-	Workspaces []string `yaml:"workspaces"` // List of workspace directories to scan for git repos
+	Workspaces      []string `yaml:"workspaces"`        // List of workspace directories to scan for git repos
+	ExcludePatterns []string `yaml:"exclude,omitempty"` // Glob patterns for directories 'ork scan' skips (e.g., "archive", "legacy-*")
 }
@@ -0,0 +1,128 @@
+package config
+
+import (
+	"fmt"
+
+	"gopkg.in/yaml.v3"
+)
+
+// ============================================================================
+// Type Definitions
+// ============================================================================
+
+// Dependency conditions (matching docker-compose)
+const (
+	ConditionServiceStarted = "service_started" // The dependency's container has started (default)
+	ConditionServiceHealthy = "service_healthy" // The dependency has passed its health check
+)
+
+// Dependency is a single depends_on entry
+type Dependency struct {
+	Service   string // Name of the service depended on
+	Condition string // When the dependency is satisfied (empty means service_started, though ork up still waits on its health check)
+}
+
+// DependsOn lists the services a service depends on, in declaration order
+// In ork.yml it is either a list of names or a map of name -> {condition: ...}
+type DependsOn []Dependency
+
+// dependencyOptions is the map-form value for a single dependency
+type dependencyOptions struct {
+	Condition string `yaml:"condition,omitempty"`
+}
+
+// ============================================================================
+// Public API
+// ============================================================================
+
+// DependsOnServices builds a dependency list with the default condition for each name
+func DependsOnServices(names ...string) DependsOn {
+	deps := make(DependsOn, 0, len(names))
+	for _, name := range names {
+		deps = append(deps, Dependency{Service: name})
+	}
+	return deps
+}
+
+// Names returns the names of all dependencies, in declaration order
+func (d DependsOn) Names() []string {
+	if len(d) == 0 {
+		return nil
+	}
+
+	names := make([]string, 0, len(d))
+	for _, dep := range d {
+		names = append(names, dep.Service)
+	}
+	return names
+}
+
+// RequiresHealthy reports whether the dependency must be healthy before its dependent starts
+func (d Dependency) RequiresHealthy() bool {
+	return d.Condition == ConditionServiceHealthy
+}
+
+// UnmarshalYAML accepts both the simple list form and the map form with conditions:
+//
+//	depends_on: [postgres, redis]
+//	depends_on:
+//	  postgres:
+//	    condition: service_healthy
+func (d *DependsOn) UnmarshalYAML(node *yaml.Node) error {
+	switch node.Kind {
+	case yaml.SequenceNode:
+		var names []string
+		if err := node.Decode(&names); err != nil {
+			return err
+		}
+		*d = DependsOnServices(names...)
+		return nil
+
+	case yaml.MappingNode:
+		// Walk the key/value pairs directly to keep declaration order
+		deps := make(DependsOn, 0, len(node.Content)/2)
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			var name string
+			if err := node.Content[i].Decode(&name); err != nil {
+				return err
+			}
+
+			var opts dependencyOptions
+			if err := node.Content[i+1].Decode(&opts); err != nil {
+				return fmt.Errorf("invalid depends_on entry for '%s': %w", name, err)
+			}
+
+			deps = append(deps, Dependency{Service: name, Condition: opts.Condition})
+		}
+		*d = deps
+		return nil
+
+	default:
+		return fmt.Errorf("line %d: depends_on must be a list of service names or a map of service conditions", node.Line)
+	}
+}
+
+// MarshalYAML writes the simple list form unless a dependency has a condition
+func (d DependsOn) MarshalYAML() (any, error) {
+	hasConditions := false
+	for _, dep := range d {
+		if dep.Condition != "" {
+			hasConditions = true
+			break
+		}
+	}
+	if !hasConditions {
+		return d.Names(), nil
+	}
+
+	// Build a mapping node so the declaration order is preserved
+	node := &yaml.Node{Kind: yaml.MappingNode}
+	for _, dep := range d {
+		value := &yaml.Node{}
+		if err := value.Encode(dependencyOptions{Condition: dep.Condition}); err != nil {
+			return nil, err
+		}
+		node.Content = append(node.Content, &yaml.Node{Kind: yaml.ScalarNode, Value: dep.Service}, value)
+	}
+	return node, nil
+}
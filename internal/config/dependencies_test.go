@@ -0,0 +1,138 @@
+package config
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"gopkg.in/yaml.v3"
+)
+
+// TestDependsOn_UnmarshalList tests the simple list form still parses
+func TestDependsOn_UnmarshalList(t *testing.T) {
+	var svc Service
+	if err := yaml.Unmarshal([]byte("depends_on: [postgres, redis]\n"), &svc); err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+
+	want := DependsOn{{Service: "postgres"}, {Service: "redis"}}
+	if !reflect.DeepEqual(svc.DependsOn, want) {
+		t.Errorf("expected %v, got %v", want, svc.DependsOn)
+	}
+}
+
+// TestDependsOn_UnmarshalMap tests the map form with conditions, preserving declaration order
+func TestDependsOn_UnmarshalMap(t *testing.T) {
+	data := `
+depends_on:
+  redis:
+    condition: service_started
+  postgres:
+    condition: service_healthy
+  queue: {}
+`
+	var svc Service
+	if err := yaml.Unmarshal([]byte(data), &svc); err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+
+	want := DependsOn{
+		{Service: "redis", Condition: ConditionServiceStarted},
+		{Service: "postgres", Condition: ConditionServiceHealthy},
+		{Service: "queue"},
+	}
+	if !reflect.DeepEqual(svc.DependsOn, want) {
+		t.Errorf("expected %v, got %v", want, svc.DependsOn)
+	}
+	if !svc.DependsOn[1].RequiresHealthy() || svc.DependsOn[0].RequiresHealthy() {
+		t.Error("expected only postgres to require a healthy dependency")
+	}
+}
+
+// TestDependsOn_UnmarshalInvalid tests that a scalar is rejected
+func TestDependsOn_UnmarshalInvalid(t *testing.T) {
+	var svc Service
+	err := yaml.Unmarshal([]byte("depends_on: postgres\n"), &svc)
+	if err == nil || !strings.Contains(err.Error(), "depends_on must be a list") {
+		t.Errorf("expected shape error, got: %v", err)
+	}
+}
+
+// TestDependsOn_Names tests that names are returned in declaration order
+func TestDependsOn_Names(t *testing.T) {
+	deps := DependsOnServices("b", "a")
+	if got := deps.Names(); !reflect.DeepEqual(got, []string{"b", "a"}) {
+		t.Errorf("expected [b a], got %v", got)
+	}
+	if got := (DependsOn{}).Names(); got != nil {
+		t.Errorf("expected nil for no dependencies, got %v", got)
+	}
+}
+
+// TestDependsOn_MarshalRoundTrip tests both shapes survive a marshal/unmarshal round trip
+func TestDependsOn_MarshalRoundTrip(t *testing.T) {
+	tests := []struct {
+		name     string
+		deps     DependsOn
+		wantList bool
+	}{
+		{name: "plain names", deps: DependsOnServices("postgres", "redis"), wantList: true},
+		{name: "with conditions", deps: DependsOn{{Service: "redis"}, {Service: "postgres", Condition: ConditionServiceHealthy}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := yaml.Marshal(Service{Image: "node:18", DependsOn: tt.deps})
+			if err != nil {
+				t.Fatalf("expected no error, got: %v", err)
+			}
+			if isList := strings.Contains(string(data), "- postgres"); isList != tt.wantList {
+				t.Errorf("unexpected depends_on shape:\n%s", data)
+			}
+
+			var svc Service
+			if err := yaml.Unmarshal(data, &svc); err != nil {
+				t.Fatalf("expected no error, got: %v", err)
+			}
+			if !reflect.DeepEqual(svc.DependsOn, tt.deps) {
+				t.Errorf("expected %v, got %v", tt.deps, svc.DependsOn)
+			}
+		})
+	}
+}
+
+// TestValidateDependencies_Conditions tests condition validation against the dependency's health check
+func TestValidateDependencies_Conditions(t *testing.T) {
+	allServices := map[string]Service{
+		"postgres": {Image: "postgres:15", Health: &HealthCheck{Command: []string{"pg_isready"}}},
+		"redis":    {Image: "redis:7"},
+		"search":   {Image: "elasticsearch:8", WaitForNativeHealth: true},
+	}
+
+	tests := []struct {
+		name    string
+		deps    DependsOn
+		wantErr string
+	}{
+		{name: "started", deps: DependsOn{{Service: "redis", Condition: ConditionServiceStarted}}},
+		{name: "healthy with check", deps: DependsOn{{Service: "postgres", Condition: ConditionServiceHealthy}}},
+		{name: "healthy with native check", deps: DependsOn{{Service: "search", Condition: ConditionServiceHealthy}}},
+		{name: "healthy without check", deps: DependsOn{{Service: "redis", Condition: ConditionServiceHealthy}}, wantErr: "'redis' has no health check"},
+		{name: "unknown condition", deps: DependsOn{{Service: "redis", Condition: "service_ready"}}, wantErr: "invalid depends_on condition 'service_ready'"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateDependencies("api", tt.deps, allServices)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("expected no error, got: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("expected error containing %q, got: %v", tt.wantErr, err)
+			}
+		})
+	}
+}
@@ -3,9 +3,11 @@ package config
 import (
 	"bufio"
 	"fmt"
+	"net/url"
 	"os"
 	"path/filepath"
 	"regexp"
+	"sort"
 	"strings"
 )
 
@@ -16,6 +18,24 @@ import (
 // EnvVars represents a collection of environment variables
 type EnvVars map[string]string
 
+// InterpolateOptions controls how variable references are interpolated
+type InterpolateOptions struct {
+	KeepUnresolved bool // Leave unresolved references (e.g. ${UNKNOWN}) intact instead of emptying them
+}
+
+// EnvIssue is a service environment variable that would reach the container unresolved or empty
+type EnvIssue struct {
+	Service  string // Service whose environment holds the variable
+	Key      string // Environment variable with the problem
+	Variable string // Referenced variable that couldn't be resolved (empty when the value is simply empty)
+}
+
+// UnresolvedRef is a variable reference that could not be resolved during interpolation
+type UnresolvedRef struct {
+	Key      string // Variable whose value contains the reference
+	Variable string // Name of the referenced variable that was not found
+}
+
 // ============================================================================
 // Public API
 // ============================================================================
@@ -71,31 +91,20 @@ func LoadEnvFile(filePath string) (EnvVars, error) {
 	return envVars, nil
 }
 
-// LoadProjectEnv loads the project-level .env file from the current directory
-// Looks for .env in the directory where ork.yml is located
-func LoadProjectEnv() (EnvVars, error) {
-	// Get the current working directory
-	cwd, err := os.Getwd()
-	if err != nil {
-		return nil, fmt.Errorf("failed to get current directory: %w", err)
-	}
-
-	// Load .env from current directory
-	envPath := filepath.Join(cwd, ".env")
+// LoadProjectEnv loads the project-level .env file
+// Looks for .env in baseDir, the directory where ork.yml is located
+// An empty baseDir uses the current directory
+func LoadProjectEnv(baseDir string) (EnvVars, error) {
+	// Load .env from the project root
+	envPath := filepath.Join(baseDir, ".env")
 	return LoadEnvFile(envPath)
 }
 
 // LoadServiceEnv loads service-specific .env file
-// Looks for .env.<service-name> in the current directory
-func LoadServiceEnv(serviceName string) (EnvVars, error) {
-	// Get the current working directory
-	cwd, err := os.Getwd()
-	if err != nil {
-		return nil, fmt.Errorf("failed to get current directory: %w", err)
-	}
-
+// Looks for .env.<service-name> in baseDir (empty uses the current directory)
+func LoadServiceEnv(baseDir, serviceName string) (EnvVars, error) {
 	// Load .env.<service-name>
-	envPath := filepath.Join(cwd, fmt.Sprintf(".env.%s", serviceName))
+	envPath := filepath.Join(baseDir, fmt.Sprintf(".env.%s", serviceName))
 	return LoadEnvFile(envPath)
 }
 
@@ -122,110 +131,271 @@ func MergeEnvVars(envMaps ...EnvVars) EnvVars {
 //  2. Service-specific .env.<service> file
 //  3. Environment variables from the york.yml config
 //
-// After merging, all variable references (${VAR} or $VAR) are interpolated
-func LoadAllEnvForService(serviceName string, configEnv map[string]string) (EnvVars, error) {
-	// Load project-level .env
-	projectEnv, err := LoadProjectEnv()
+// The .env files are read from baseDir (empty is resolved like LoadProjectEnv)
+// After merging, ${file:<path>} references are replaced by that file's trimmed contents
+// (relative paths resolve against baseDir), then all variable references (${VAR} or $VAR) are interpolated
+func LoadAllEnvForService(baseDir, serviceName string, configEnv map[string]string) (EnvVars, error) {
+	merged, err := mergeEnvForService(baseDir, serviceName, configEnv)
 	if err != nil {
-		return nil, fmt.Errorf("failed to load project .env: %w", err)
+		return nil, err
 	}
 
-	// Load service-specific .env
-	serviceEnv, err := LoadServiceEnv(serviceName)
+	// Interpolate variable references
+	interpolated, err := InterpolateEnvVars(merged)
 	if err != nil {
-		return nil, fmt.Errorf("failed to load service .env: %w", err)
+		return nil, fmt.Errorf("failed to interpolate variables for service %s: %w", serviceName, err)
 	}
 
-	// Convert config env to EnvVars
-	cfgEnv := make(EnvVars)
-	for k, v := range configEnv {
-		cfgEnv[k] = v
-	}
+	return interpolated, nil
+}
 
-	// Merge with priority: project < service < config
-	merged := MergeEnvVars(projectEnv, serviceEnv, cfgEnv)
+// CheckEnvForService reports the variables in a service's environment (merged like LoadAllEnvForService)
+// that reference an undefined variable, or that end up empty
+// Issues are sorted by key, then variable
+func CheckEnvForService(baseDir, serviceName string, configEnv map[string]string) ([]EnvIssue, error) {
+	merged, err := mergeEnvForService(baseDir, serviceName, configEnv)
+	if err != nil {
+		return nil, err
+	}
 
-	// Interpolate variable references
-	interpolated, err := InterpolateEnvVars(merged)
+	// Keep unresolved references intact, so they aren't also reported as empty values
+	interpolated, unresolved, err := InterpolateEnvVarsWithOptions(merged, InterpolateOptions{KeepUnresolved: true})
 	if err != nil {
 		return nil, fmt.Errorf("failed to interpolate variables for service %s: %w", serviceName, err)
 	}
 
-	return interpolated, nil
+	issues := make([]EnvIssue, 0, len(unresolved))
+	for _, ref := range unresolved {
+		issues = append(issues, EnvIssue{Service: serviceName, Key: ref.Key, Variable: ref.Variable})
+	}
+	for key, value := range interpolated {
+		if value == "" {
+			issues = append(issues, EnvIssue{Service: serviceName, Key: key})
+		}
+	}
+
+	sort.Slice(issues, func(i, j int) bool {
+		if issues[i].Key != issues[j].Key {
+			return issues[i].Key < issues[j].Key
+		}
+		return issues[i].Variable < issues[j].Variable
+	})
+	return issues, nil
 }
 
 // InterpolateEnvVars interpolates variable references in environment values
 // Supports:
 //   - ${VAR_NAME} - standard form
 //   - $VAR_NAME - short form (word characters only)
-//   - ${VAR_NAME:-default} - with default value
+//   - ${VAR_NAME:-default} - with default value, which may itself hold references
+//     (e.g. ${A:-${B}}) and balanced braces (e.g. ${JSON:-{"debug":true}})
 //
 // Variables are resolved from:
 //  1. The provided EnvVars map (for self-referencing)
 //  2. System environment variables (os.Getenv)
 //
+// Unresolved references become empty strings
 // Returns an error if circular references are detected
 func InterpolateEnvVars(envVars EnvVars) (EnvVars, error) {
+	result, _, err := InterpolateEnvVarsWithOptions(envVars, InterpolateOptions{})
+	return result, err
+}
+
+// InterpolateEnvVarsWithOptions interpolates variable references like InterpolateEnvVars
+// Also returns every reference that could not be resolved, sorted by key then variable
+func InterpolateEnvVarsWithOptions(envVars EnvVars, opts InterpolateOptions) (EnvVars, []UnresolvedRef, error) {
 	result := make(EnvVars)
-	resolving := make(map[string]bool) // Track variables being resolved to detect circular refs
+	interp := &interpolator{
+		envVars:   envVars,
+		opts:      opts,
+		resolving: make(map[string]bool), // Track variables being resolved to detect circular refs
+		seen:      make(map[UnresolvedRef]bool),
+	}
 
 	// Interpolate each value
 	for key, value := range envVars {
-		interpolated, err := interpolateValue(value, envVars, resolving)
+		interp.currentKey = key
+		interpolated, err := interp.interpolateValue(value)
 		if err != nil {
-			return nil, fmt.Errorf("failed to interpolate variable %s: %w", key, err)
+			return nil, nil, fmt.Errorf("failed to interpolate variable %s: %w", key, err)
 		}
 		result[key] = interpolated
 	}
 
-	return result, nil
+	sort.Slice(interp.unresolved, func(i, j int) bool {
+		a, b := interp.unresolved[i], interp.unresolved[j]
+		if a.Key != b.Key {
+			return a.Key < b.Key
+		}
+		return a.Variable < b.Variable
+	})
+
+	return result, interp.unresolved, nil
+}
+
+// MaskEnvValue hides a value that looks like a secret, for printing an environment
+// Values of keys naming a password, secret, token, or key are replaced entirely, and a
+// password embedded in a URL (e.g. postgres://user:pass@db/app) is masked in place
+func MaskEnvValue(key, value string) string {
+	if value == "" {
+		return value
+	}
+	if isSensitiveEnvKey(key) {
+		return maskedValue
+	}
+
+	// Rewrite the raw userinfo rather than re-encoding the URL, so the rest prints exactly as written
+	if u, err := url.Parse(value); err == nil && u.User != nil && strings.Contains(value, "://") {
+		if _, hasPassword := u.User.Password(); hasPassword {
+			scheme, rest, _ := strings.Cut(value, "://")
+			userinfo, host, _ := strings.Cut(rest, "@")
+			username, _, _ := strings.Cut(userinfo, ":")
+			return scheme + "://" + username + ":" + maskedValue + "@" + host
+		}
+	}
+	return value
 }
 
 // ============================================================================
-// Private Helpers - Variable Interpolation
+// Private Helpers - Masking
 // ============================================================================
 
-// Regular expressions for variable references
-var (
-	// Matches ${VAR_NAME} or ${VAR_NAME:-default}
-	varRefWithBraces = regexp.MustCompile(`\$\{([A-Za-z_][A-Za-z0-9_]*)(:-([^}]*))?}`)
-	// Matches $VAR_NAME (word characters only, no braces)
-	varRefShort = regexp.MustCompile(`\$([A-Za-z_][A-Za-z0-9_]*)`)
-)
+// maskedValue replaces secret values in printed output
+const maskedValue = "****"
 
-// interpolateValue interpolates all variable references in a single value
-func interpolateValue(value string, envVars EnvVars, resolving map[string]bool) (string, error) {
-	var interpolationError error
+// Key fragments that mark a variable as secret wherever they appear (e.g. DB_PASSWORD, GITHUB_TOKEN)
+var sensitiveKeyFragments = []string{"PASSWORD", "PASSWD", "SECRET", "TOKEN", "CREDENTIAL", "APIKEY"}
 
-	// First, handle ${VAR} and ${VAR:-default} (with braces)
-	result := varRefWithBraces.ReplaceAllStringFunc(value, func(match string) string {
-		// If we already have an error, don't process more replacements
-		if interpolationError != nil {
-			return match
-		}
+// Key words that mark a variable as secret only as a whole "_"-separated word (e.g. API_KEY, not KEYBOARD)
+var sensitiveKeyWords = map[string]bool{"KEY": true, "PASS": true, "AUTH": true, "PRIVATE": true}
 
-		submatches := varRefWithBraces.FindStringSubmatch(match)
-		varName := submatches[1]
-		defaultValue := ""
-		if len(submatches) > 3 {
-			defaultValue = submatches[3]
+// isSensitiveEnvKey reports whether an env key names a secret
+func isSensitiveEnvKey(key string) bool {
+	upper := strings.ToUpper(key)
+	for _, fragment := range sensitiveKeyFragments {
+		if strings.Contains(upper, fragment) {
+			return true
 		}
+	}
+	for _, word := range strings.Split(upper, "_") {
+		if sensitiveKeyWords[word] {
+			return true
+		}
+	}
+	return false
+}
 
-		// Resolve the variable
-		resolved, err := resolveVariable(varName, envVars, resolving, defaultValue)
-		if err != nil {
-			interpolationError = err
-			return match
+// ============================================================================
+// Private Helpers - Env Merging
+// ============================================================================
+
+// mergeEnvForService merges a service's .env files and config env, without interpolating them
+func mergeEnvForService(baseDir, serviceName string, configEnv map[string]string) (EnvVars, error) {
+	// Load project-level .env
+	projectEnv, err := LoadProjectEnv(baseDir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load project .env: %w", err)
+	}
+
+	// Load service-specific .env
+	serviceEnv, err := LoadServiceEnv(baseDir, serviceName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load service .env: %w", err)
+	}
+
+	// Convert config env to EnvVars
+	cfgEnv := make(EnvVars)
+	for k, v := range configEnv {
+		cfgEnv[k] = v
+	}
+
+	// Merge with priority: project < service < config
+	merged := MergeEnvVars(projectEnv, serviceEnv, cfgEnv)
+
+	// Read ${file:...} references before interpolation, so references inside the files are still resolved
+	if err := readFileValues(baseDir, merged); err != nil {
+		return nil, err
+	}
+	return merged, nil
+}
+
+// fileRef matches a ${file:<path>} reference, which is replaced by the file's contents
+// (e.g., TLS_CERT=${file:./certs/dev.pem}); a bare "file:" value is left alone, since URLs use it
+var fileRef = regexp.MustCompile(`\$\{file:([^}]+)\}`)
+
+// readFileValues replaces each ${file:<path>} reference in envVars with the file's contents, trimmed of surrounding whitespace
+// Relative paths are resolved against dir; a missing or unreadable file is an error naming the variable
+func readFileValues(dir string, envVars EnvVars) error {
+	keys := make([]string, 0, len(envVars))
+	for key, value := range envVars {
+		if fileRef.MatchString(value) {
+			keys = append(keys, key)
 		}
-		return resolved
-	})
+	}
+	sort.Strings(keys) // Report the same error first on every run
+
+	for _, key := range keys {
+		var readErr error
+		envVars[key] = fileRef.ReplaceAllStringFunc(envVars[key], func(match string) string {
+			if readErr != nil {
+				return match
+			}
+
+			path := strings.TrimSpace(fileRef.FindStringSubmatch(match)[1])
+			if !filepath.IsAbs(path) {
+				path = filepath.Join(dir, path)
+			}
+
+			contents, err := os.ReadFile(path)
+			if err != nil {
+				readErr = fmt.Errorf("failed to read %s from file: %w", key, err)
+				return match
+			}
+			return strings.TrimSpace(string(contents))
+		})
+		if readErr != nil {
+			return readErr
+		}
+	}
+	return nil
+}
 
-	// Check for errors from braces replacement
-	if interpolationError != nil {
-		return "", interpolationError
+// ============================================================================
+// Private Helpers - Variable Interpolation
+// ============================================================================
+
+// Matches $VAR_NAME (word characters only, no braces)
+// The braced form is parsed by parseBracedRef, since defaults can nest
+var varRefShort = regexp.MustCompile(`\$([A-Za-z_][A-Za-z0-9_]*)`)
+
+// bracedRef is a parsed ${VAR_NAME} or ${VAR_NAME:-default} reference
+type bracedRef struct {
+	name         string
+	defaultValue string // Raw default text, interpolated only if it is used
+	hasDefault   bool
+	end          int // Index just past the closing brace
+}
+
+// interpolator holds the state for a single InterpolateEnvVarsWithOptions run
+type interpolator struct {
+	envVars    EnvVars
+	opts       InterpolateOptions
+	resolving  map[string]bool
+	currentKey string                 // Top-level key being interpolated (for reporting)
+	unresolved []UnresolvedRef        // References that could not be resolved
+	seen       map[UnresolvedRef]bool // Dedupes unresolved references
+}
+
+// interpolateValue interpolates all variable references in a single value
+func (i *interpolator) interpolateValue(value string) (string, error) {
+	// First, handle ${VAR} and ${VAR:-default} (with braces)
+	result, err := i.interpolateBraced(value)
+	if err != nil {
+		return "", err
 	}
 
 	// Then, handle $VAR (short form, no braces)
+	var interpolationError error
 	result = varRefShort.ReplaceAllStringFunc(result, func(match string) string {
 		// If we already have an error, don't process more replacements
 		if interpolationError != nil {
@@ -236,7 +406,7 @@ func interpolateValue(value string, envVars EnvVars, resolving map[string]bool)
 		varName := submatches[1]
 
 		// Resolve the variable
-		resolved, err := resolveVariable(varName, envVars, resolving, "")
+		resolved, err := i.resolveVariable(varName, match, "", false)
 		if err != nil {
 			interpolationError = err
 			return match
@@ -252,26 +422,107 @@ func interpolateValue(value string, envVars EnvVars, resolving map[string]bool)
 	return result, nil
 }
 
+// interpolateBraced interpolates only the ${VAR} and ${VAR:-default} references in a value
+// Malformed references (e.g. "${", "${1X}", "${A:-unterminated") are kept as literal text
+func (i *interpolator) interpolateBraced(value string) (string, error) {
+	var result strings.Builder
+
+	for pos := 0; pos < len(value); {
+		if strings.HasPrefix(value[pos:], "${") {
+			if ref, ok := parseBracedRef(value, pos); ok {
+				resolved, err := i.resolveVariable(ref.name, value[pos:ref.end], ref.defaultValue, ref.hasDefault)
+				if err != nil {
+					return "", err
+				}
+				result.WriteString(resolved)
+				pos = ref.end
+				continue
+			}
+		}
+
+		result.WriteByte(value[pos])
+		pos++
+	}
+
+	return result.String(), nil
+}
+
+// parseBracedRef parses the reference starting at value[start:], which begins with "${"
+// The default runs to the brace that closes the reference, so it may contain nested
+// ${...} references and balanced braces; colons and slashes need no escaping
+// Reports false if the text is not a well-formed reference
+func parseBracedRef(value string, start int) (bracedRef, bool) {
+	pos := start + len("${")
+
+	// Variable name: a letter or underscore, then letters, digits, or underscores
+	nameStart := pos
+	for pos < len(value) && isVarNameByte(value[pos], pos == nameStart) {
+		pos++
+	}
+	if pos == nameStart {
+		return bracedRef{}, false
+	}
+	ref := bracedRef{name: value[nameStart:pos]}
+
+	// ${VAR}
+	if pos < len(value) && value[pos] == '}' {
+		ref.end = pos + 1
+		return ref, true
+	}
+
+	// ${VAR:-default}
+	if !strings.HasPrefix(value[pos:], ":-") {
+		return bracedRef{}, false
+	}
+	pos += len(":-")
+
+	depth := 0
+	for defaultStart := pos; pos < len(value); pos++ {
+		switch value[pos] {
+		case '{':
+			depth++
+		case '}':
+			if depth == 0 {
+				ref.defaultValue = value[defaultStart:pos]
+				ref.hasDefault = true
+				ref.end = pos + 1
+				return ref, true
+			}
+			depth--
+		}
+	}
+
+	return bracedRef{}, false // Unterminated
+}
+
+// isVarNameByte reports whether c may appear in a variable name (digits can't start one)
+func isVarNameByte(c byte, first bool) bool {
+	switch {
+	case c == '_', c >= 'A' && c <= 'Z', c >= 'a' && c <= 'z':
+		return true
+	case c >= '0' && c <= '9':
+		return !first
+	}
+	return false
+}
+
 // resolveVariable resolves a single variable reference
 // Looks up in envVars first, then os.Getenv, then uses defaultValue
-func resolveVariable(varName string, envVars EnvVars, resolving map[string]bool, defaultValue string) (string, error) {
+// An unresolved reference is recorded and becomes empty, or stays as the literal match with KeepUnresolved
+func (i *interpolator) resolveVariable(varName, match, defaultValue string, hasDefault bool) (string, error) {
 	// Check for circular reference
-	if resolving[varName] {
+	if i.resolving[varName] {
 		return "", fmt.Errorf("circular reference detected: %s", varName)
 	}
 
 	// Try to get from envVars first
-	if val, exists := envVars[varName]; exists {
+	if val, exists := i.envVars[varName]; exists {
 		// Mark as resolving to detect circular references
-		resolving[varName] = true
-		defer delete(resolving, varName)
+		i.resolving[varName] = true
+		defer delete(i.resolving, varName)
 
 		// Recursively interpolate the value (in case it also contains variables)
-		interpolated, err := interpolateValue(val, envVars, resolving)
-		if err != nil {
-			return "", err
-		}
-		return interpolated, nil
+		return i.interpolateValue(val)
 	}
 
 	// Try system environment variable
@@ -279,12 +530,22 @@ func resolveVariable(varName string, envVars EnvVars, resolving map[string]bool,
 		return val, nil
 	}
 
-	// Use default value if provided
-	if defaultValue != "" {
-		return defaultValue, nil
+	// Use default value if provided (an explicit empty default counts as resolved)
+	// Its own references are only resolved (and reported) when the default is used
+	if hasDefault {
+		return i.interpolateBraced(defaultValue)
+	}
+
+	// Not found and no default - record it
+	ref := UnresolvedRef{Key: i.currentKey, Variable: varName}
+	if !i.seen[ref] {
+		i.seen[ref] = true
+		i.unresolved = append(i.unresolved, ref)
 	}
 
-	// Return an empty string if not found and no default
+	if i.opts.KeepUnresolved {
+		return match, nil
+	}
 	return "", nil
 }
 
@@ -3,6 +3,7 @@ package config
 import (
 	"os"
 	"path/filepath"
+	"reflect"
 	"strings"
 	"testing"
 )
@@ -300,7 +301,7 @@ func TestLoadProjectEnv_FileExists(t *testing.T) {
 	defer os.Chdir(originalDir)
 	os.Chdir(tempDir)
 
-	envVars, err := LoadProjectEnv()
+	envVars, err := LoadProjectEnv("")
 	if err != nil {
 		t.Fatalf("expected no error, got: %v", err)
 	}
@@ -318,7 +319,7 @@ func TestLoadProjectEnv_FileNotExists(t *testing.T) {
 	defer os.Chdir(originalDir)
 	os.Chdir(tempDir)
 
-	envVars, err := LoadProjectEnv()
+	envVars, err := LoadProjectEnv("")
 	if err != nil {
 		t.Fatalf("expected no error for missing file, got: %v", err)
 	}
@@ -340,7 +341,7 @@ func TestLoadServiceEnv_FileExists(t *testing.T) {
 	defer os.Chdir(originalDir)
 	os.Chdir(tempDir)
 
-	envVars, err := LoadServiceEnv("api")
+	envVars, err := LoadServiceEnv("", "api")
 	if err != nil {
 		t.Fatalf("expected no error, got: %v", err)
 	}
@@ -358,7 +359,7 @@ func TestLoadServiceEnv_FileNotExists(t *testing.T) {
 	defer os.Chdir(originalDir)
 	os.Chdir(tempDir)
 
-	envVars, err := LoadServiceEnv("api")
+	envVars, err := LoadServiceEnv("", "api")
 	if err != nil {
 		t.Fatalf("expected no error for missing file, got: %v", err)
 	}
@@ -368,6 +369,75 @@ func TestLoadServiceEnv_FileNotExists(t *testing.T) {
 	}
 }
 
+// TestLoadProjectEnv_FromSubdirectory tests the project-root .env is loaded when run from a subdirectory
+func TestLoadProjectEnv_FromSubdirectory(t *testing.T) {
+	projectDir := t.TempDir()
+	subDir := filepath.Join(projectDir, "services", "api")
+	if err := os.MkdirAll(subDir, 0755); err != nil {
+		t.Fatalf("failed to create subdirectory: %v", err)
+	}
+	os.WriteFile(filepath.Join(projectDir, ".env"), []byte("PROJECT_VAR=root"), 0644)
+	os.WriteFile(filepath.Join(subDir, ".env"), []byte("PROJECT_VAR=subdir"), 0644)
+
+	originalDir, _ := os.Getwd()
+	defer os.Chdir(originalDir)
+	os.Chdir(subDir)
+
+	envVars, err := LoadProjectEnv(projectDir)
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+
+	if envVars["PROJECT_VAR"] != "root" {
+		t.Errorf("expected the project-root .env ('root'), got '%s'", envVars["PROJECT_VAR"])
+	}
+}
+
+// TestLoadProjectEnv_EmptyBaseDirUsesCurrentDir tests an empty base directory reads .env from the current directory
+func TestLoadProjectEnv_EmptyBaseDirUsesCurrentDir(t *testing.T) {
+	tempDir := t.TempDir()
+	os.WriteFile(filepath.Join(tempDir, ".env"), []byte("PROJECT_VAR=cwd"), 0644)
+
+	originalDir, _ := os.Getwd()
+	defer os.Chdir(originalDir)
+	os.Chdir(tempDir)
+
+	envVars, err := LoadProjectEnv("")
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if envVars["PROJECT_VAR"] != "cwd" {
+		t.Errorf("expected the current directory's .env ('cwd'), got '%s'", envVars["PROJECT_VAR"])
+	}
+}
+
+// TestLoadAllEnvForService_FromSubdirectory tests both .env files are read from the base directory
+func TestLoadAllEnvForService_FromSubdirectory(t *testing.T) {
+	projectDir := t.TempDir()
+	subDir := filepath.Join(projectDir, "web")
+	if err := os.Mkdir(subDir, 0755); err != nil {
+		t.Fatalf("failed to create subdirectory: %v", err)
+	}
+	os.WriteFile(filepath.Join(projectDir, ".env"), []byte("REGION=eu"), 0644)
+	os.WriteFile(filepath.Join(projectDir, ".env.api"), []byte("API_KEY=secret"), 0644)
+
+	originalDir, _ := os.Getwd()
+	defer os.Chdir(originalDir)
+	os.Chdir(subDir)
+
+	result, err := LoadAllEnvForService(projectDir, "api", nil)
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+
+	if result["REGION"] != "eu" {
+		t.Errorf("expected REGION from project .env, got '%s'", result["REGION"])
+	}
+	if result["API_KEY"] != "secret" {
+		t.Errorf("expected API_KEY from project .env.api, got '%s'", result["API_KEY"])
+	}
+}
+
 // ============================================================================
 // MergeEnvVars Tests
 // ============================================================================
@@ -457,7 +527,7 @@ func TestLoadAllEnvForService_AllSources(t *testing.T) {
 	defer os.Chdir(originalDir)
 	os.Chdir(tempDir)
 
-	result, err := LoadAllEnvForService("api", configEnv)
+	result, err := LoadAllEnvForService("", "api", configEnv)
 	if err != nil {
 		t.Fatalf("expected no error, got: %v", err)
 	}
@@ -492,7 +562,7 @@ func TestLoadAllEnvForService_OnlyConfigEnv(t *testing.T) {
 	defer os.Chdir(originalDir)
 	os.Chdir(tempDir)
 
-	result, err := LoadAllEnvForService("api", configEnv)
+	result, err := LoadAllEnvForService("", "api", configEnv)
 	if err != nil {
 		t.Fatalf("expected no error, got: %v", err)
 	}
@@ -514,7 +584,7 @@ func TestLoadAllEnvForService_EmptyConfigEnv(t *testing.T) {
 	defer os.Chdir(originalDir)
 	os.Chdir(tempDir)
 
-	result, err := LoadAllEnvForService("api", nil)
+	result, err := LoadAllEnvForService("", "api", nil)
 	if err != nil {
 		t.Fatalf("expected no error, got: %v", err)
 	}
@@ -524,6 +594,61 @@ func TestLoadAllEnvForService_EmptyConfigEnv(t *testing.T) {
 	}
 }
 
+// TestLoadAllEnvForService_FileValue tests that ${file:...} references are replaced by the file's trimmed contents
+func TestLoadAllEnvForService_FileValue(t *testing.T) {
+	tempDir := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(tempDir, "secrets"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(tempDir, "secrets", "db_password"), []byte("s3cret\n\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(tempDir, "db_url"), []byte("postgres://${DB_HOST}:5432/shop\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	configEnv := map[string]string{
+		"DB_HOST":     "postgres",
+		"DB_PASSWORD": "${file:./secrets/db_password}",
+		"DB_URL":      "${file:db_url}",
+		"AUTH_HEADER": "Bearer ${file:secrets/db_password}",
+		"SQLITE_URL":  "file:./dev.db", // A bare file: value is a literal, not a reference
+	}
+
+	result, err := LoadAllEnvForService(tempDir, "api", configEnv)
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+
+	if result["DB_PASSWORD"] != "s3cret" {
+		t.Errorf("expected trailing newlines trimmed to 's3cret', got %q", result["DB_PASSWORD"])
+	}
+	if result["DB_URL"] != "postgres://postgres:5432/shop" {
+		t.Errorf("expected references in the file to be interpolated, got %q", result["DB_URL"])
+	}
+	if result["AUTH_HEADER"] != "Bearer s3cret" {
+		t.Errorf("expected the reference replaced inside a longer value, got %q", result["AUTH_HEADER"])
+	}
+	if result["SQLITE_URL"] != "file:./dev.db" {
+		t.Errorf("expected 'file:./dev.db' unchanged, got %q", result["SQLITE_URL"])
+	}
+}
+
+// TestLoadAllEnvForService_MissingFileValue tests that a ${file:...} reference to a missing file is an error
+func TestLoadAllEnvForService_MissingFileValue(t *testing.T) {
+	tempDir := t.TempDir()
+
+	configEnv := map[string]string{"TLS_CERT": "${file:./certs/missing.pem}"}
+
+	_, err := LoadAllEnvForService(tempDir, "api", configEnv)
+	if err == nil {
+		t.Fatal("expected error for missing file, got nil")
+	}
+	if !strings.Contains(err.Error(), "TLS_CERT") || !strings.Contains(err.Error(), "missing.pem") {
+		t.Errorf("expected error naming the variable and file, got: %v", err)
+	}
+}
+
 // ============================================================================
 // parseLine Tests
 // ============================================================================
@@ -1006,3 +1131,289 @@ func TestInterpolateEnvVars_EmptyDefaultValue(t *testing.T) {
 		t.Errorf("expected '%s', got '%s'", expected, result["VALUE"])
 	}
 }
+
+// TestInterpolateEnvVars_NestedDefaults tests defaults that reference other variables
+func TestInterpolateEnvVars_NestedDefaults(t *testing.T) {
+	t.Setenv("ORK_TEST_FALLBACK_HOST", "")
+
+	envVars := EnvVars{
+		"DEFAULT_HOST": "db.internal",
+		"HOST":         "${DB_HOST:-${DEFAULT_HOST}}",
+		"PORT":         "${DB_PORT:-${DEFAULT_PORT:-5432}}",
+		"OVERRIDDEN":   "${DEFAULT_HOST:-${UNUSED}}",
+		"URL":          "postgres://${DB_HOST:-${ORK_TEST_FALLBACK_HOST:-localhost}}:${DB_PORT:-5432}/app",
+	}
+
+	result, err := InterpolateEnvVars(envVars)
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+
+	expected := map[string]string{
+		"HOST":       "db.internal",
+		"PORT":       "5432",
+		"OVERRIDDEN": "db.internal",
+		"URL":        "postgres://localhost:5432/app",
+	}
+	for key, want := range expected {
+		if result[key] != want {
+			t.Errorf("%s: expected '%s', got '%s'", key, want, result[key])
+		}
+	}
+}
+
+// TestInterpolateEnvVars_DefaultsWithSpecialCharacters tests defaults with colons, slashes, and braces
+func TestInterpolateEnvVars_DefaultsWithSpecialCharacters(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    string
+		expected string
+	}{
+		{name: "url", value: "${API:-https://api.example.com:8443/v1?x=1}", expected: "https://api.example.com:8443/v1?x=1"},
+		{name: "colon dash inside default", value: "${A:-b:-c}", expected: "b:-c"},
+		{name: "balanced braces", value: `${FLAGS:-{"debug":{"level":2}}}`, expected: `{"debug":{"level":2}}`},
+		{name: "text after reference", value: "${A:-x}}", expected: "x}"},
+		{name: "dollar in default", value: "${PRICE:-$5}", expected: "$5"},
+		{name: "unterminated stays literal", value: "${A:-{oops}", expected: "${A:-{oops}"},
+		{name: "invalid name stays literal", value: "${1A}", expected: "${1A}"},
+		{name: "empty reference stays literal", value: "cost: ${}", expected: "cost: ${}"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := InterpolateEnvVars(EnvVars{"VALUE": tt.value})
+			if err != nil {
+				t.Fatalf("expected no error, got: %v", err)
+			}
+			if result["VALUE"] != tt.expected {
+				t.Errorf("expected '%s', got '%s'", tt.expected, result["VALUE"])
+			}
+		})
+	}
+}
+
+// TestInterpolateEnvVars_NestedDefaultCircularReference tests cycles through a nested default
+func TestInterpolateEnvVars_NestedDefaultCircularReference(t *testing.T) {
+	envVars := EnvVars{
+		"A": "${MISSING:-${B}}",
+		"B": "${A}",
+	}
+
+	_, err := InterpolateEnvVars(envVars)
+	if err == nil {
+		t.Fatal("expected circular reference error, got nil")
+	}
+	if !strings.Contains(err.Error(), "circular reference") {
+		t.Errorf("expected circular reference error, got: %v", err)
+	}
+}
+
+// ============================================================================
+// InterpolateEnvVarsWithOptions Tests
+// ============================================================================
+
+// TestInterpolateEnvVarsWithOptions_KeepUnresolved tests unresolved references stay literal
+func TestInterpolateEnvVarsWithOptions_KeepUnresolved(t *testing.T) {
+	envVars := EnvVars{
+		"PORT":  "3000",
+		"URL":   "http://localhost:${UNDEFINED_PORT}/api",
+		"SHORT": "$UNDEFINED_HOST:$PORT",
+	}
+
+	result, unresolved, err := InterpolateEnvVarsWithOptions(envVars, InterpolateOptions{KeepUnresolved: true})
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+
+	if result["URL"] != "http://localhost:${UNDEFINED_PORT}/api" {
+		t.Errorf("expected URL reference to stay intact, got '%s'", result["URL"])
+	}
+	if result["SHORT"] != "$UNDEFINED_HOST:3000" {
+		t.Errorf("expected short reference to stay intact, got '%s'", result["SHORT"])
+	}
+
+	expected := []UnresolvedRef{
+		{Key: "SHORT", Variable: "UNDEFINED_HOST"},
+		{Key: "URL", Variable: "UNDEFINED_PORT"},
+	}
+	if !reflect.DeepEqual(unresolved, expected) {
+		t.Errorf("expected unresolved %v, got %v", expected, unresolved)
+	}
+}
+
+// TestInterpolateEnvVarsWithOptions_DefaultModeEmpties tests the default mode still empties and reports
+func TestInterpolateEnvVarsWithOptions_DefaultModeEmpties(t *testing.T) {
+	envVars := EnvVars{
+		"URL": "http://localhost:${UNDEFINED_PORT}/api",
+	}
+
+	result, unresolved, err := InterpolateEnvVarsWithOptions(envVars, InterpolateOptions{})
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+
+	if result["URL"] != "http://localhost:/api" {
+		t.Errorf("expected empty substitution, got '%s'", result["URL"])
+	}
+	if len(unresolved) != 1 || unresolved[0].Variable != "UNDEFINED_PORT" {
+		t.Errorf("expected UNDEFINED_PORT to be reported, got %v", unresolved)
+	}
+}
+
+// TestInterpolateEnvVarsWithOptions_DefaultsAreResolved tests that references with defaults are not reported
+func TestInterpolateEnvVarsWithOptions_DefaultsAreResolved(t *testing.T) {
+	envVars := EnvVars{
+		"A": "${MISSING:-fallback}",
+		"B": "x${MISSING:-}y",
+	}
+
+	result, unresolved, err := InterpolateEnvVarsWithOptions(envVars, InterpolateOptions{KeepUnresolved: true})
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+
+	if result["A"] != "fallback" || result["B"] != "xy" {
+		t.Errorf("expected defaults to apply, got A='%s' B='%s'", result["A"], result["B"])
+	}
+	if len(unresolved) != 0 {
+		t.Errorf("expected no unresolved references, got %v", unresolved)
+	}
+}
+
+// TestInterpolateEnvVarsWithOptions_NestedUnresolved tests unresolved references inside referenced vars
+func TestInterpolateEnvVarsWithOptions_NestedUnresolved(t *testing.T) {
+	envVars := EnvVars{
+		"HOST": "${UNDEFINED_HOST}",
+		"URL":  "http://${HOST}/api",
+	}
+
+	result, unresolved, err := InterpolateEnvVarsWithOptions(envVars, InterpolateOptions{KeepUnresolved: true})
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+
+	if result["URL"] != "http://${UNDEFINED_HOST}/api" {
+		t.Errorf("expected nested reference to stay intact, got '%s'", result["URL"])
+	}
+
+	expected := []UnresolvedRef{
+		{Key: "HOST", Variable: "UNDEFINED_HOST"},
+		{Key: "URL", Variable: "UNDEFINED_HOST"},
+	}
+	if !reflect.DeepEqual(unresolved, expected) {
+		t.Errorf("expected unresolved %v, got %v", expected, unresolved)
+	}
+}
+
+// TestInterpolateEnvVarsWithOptions_NestedDefaultReporting tests references inside a default are reported only when used
+func TestInterpolateEnvVarsWithOptions_NestedDefaultReporting(t *testing.T) {
+	envVars := EnvVars{
+		"SET":    "yes",
+		"USED":   "${MISSING:-${INNER_MISSING}}",
+		"UNUSED": "${SET:-${NEVER_LOOKED_UP}}",
+	}
+
+	result, unresolved, err := InterpolateEnvVarsWithOptions(envVars, InterpolateOptions{KeepUnresolved: true})
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+
+	if result["USED"] != "${INNER_MISSING}" {
+		t.Errorf("expected the unresolved inner reference to stay intact, got '%s'", result["USED"])
+	}
+	if result["UNUSED"] != "yes" {
+		t.Errorf("expected 'yes', got '%s'", result["UNUSED"])
+	}
+
+	expected := []UnresolvedRef{{Key: "USED", Variable: "INNER_MISSING"}}
+	if !reflect.DeepEqual(unresolved, expected) {
+		t.Errorf("expected unresolved %v, got %v", expected, unresolved)
+	}
+}
+
+// ============================================================================
+// CheckEnvForService Tests
+// ============================================================================
+
+// TestCheckEnvForService_ReportsUndefinedVariable tests a reference to an undefined variable is reported
+func TestCheckEnvForService_ReportsUndefinedVariable(t *testing.T) {
+	tempDir := t.TempDir()
+	configEnv := map[string]string{
+		"DATABASE_URL": "postgres://app:${ORK_TEST_UNDEFINED_SECRET}@db:5432/shop",
+		"LOG_LEVEL":    "info",
+	}
+
+	issues, err := CheckEnvForService(tempDir, "api", configEnv)
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+
+	expected := []EnvIssue{{Service: "api", Key: "DATABASE_URL", Variable: "ORK_TEST_UNDEFINED_SECRET"}}
+	if !reflect.DeepEqual(issues, expected) {
+		t.Errorf("expected issues %v, got %v", expected, issues)
+	}
+}
+
+// TestCheckEnvForService_ReportsEmptyValue tests a variable that resolves to empty is reported
+func TestCheckEnvForService_ReportsEmptyValue(t *testing.T) {
+	tempDir := t.TempDir()
+	os.WriteFile(filepath.Join(tempDir, ".env.api"), []byte("API_KEY="), 0644)
+
+	issues, err := CheckEnvForService(tempDir, "api", map[string]string{"REGION": "eu"})
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+
+	expected := []EnvIssue{{Service: "api", Key: "API_KEY"}}
+	if !reflect.DeepEqual(issues, expected) {
+		t.Errorf("expected issues %v, got %v", expected, issues)
+	}
+}
+
+// TestCheckEnvForService_FullyResolved tests a config whose references all resolve reports clean
+func TestCheckEnvForService_FullyResolved(t *testing.T) {
+	tempDir := t.TempDir()
+	t.Setenv("ORK_TEST_DB_PASSWORD", "hunter2")
+	os.WriteFile(filepath.Join(tempDir, ".env"), []byte("DB_HOST=db.internal"), 0644)
+	configEnv := map[string]string{
+		"DATABASE_URL": "postgres://app:${ORK_TEST_DB_PASSWORD}@${DB_HOST}:5432/shop",
+		"PORT":         "${ORK_TEST_UNDEFINED_PORT:-3000}",
+	}
+
+	issues, err := CheckEnvForService(tempDir, "api", configEnv)
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if len(issues) != 0 {
+		t.Errorf("expected no issues, got %v", issues)
+	}
+}
+
+// TestMaskEnvValue tests that secret-looking values are masked and everything else is kept
+func TestMaskEnvValue(t *testing.T) {
+	tests := []struct {
+		key   string
+		value string
+		want  string
+	}{
+		{key: "DB_PASSWORD", value: "hunter2", want: "****"},
+		{key: "GITHUB_TOKEN", value: "ghp_abc", want: "****"},
+		{key: "client_secret", value: "s3cr3t", want: "****"},
+		{key: "API_KEY", value: "abc123", want: "****"},
+		{key: "STRIPE_APIKEY", value: "sk_live", want: "****"},
+		{key: "DB_PASSWORD", value: "", want: ""},
+		{key: "KEYBOARD_LAYOUT", value: "us", want: "us"},
+		{key: "LOG_LEVEL", value: "debug", want: "debug"},
+		{key: "DATABASE_URL", value: "postgres://app:hunter2@db:5432/app", want: "postgres://app:****@db:5432/app"},
+		{key: "REDIS_URL", value: "redis://db:6379", want: "redis://db:6379"},
+		{key: "ADMIN_URL", value: "http://admin@localhost", want: "http://admin@localhost"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.key+"="+tt.value, func(t *testing.T) {
+			if got := MaskEnvValue(tt.key, tt.value); got != tt.want {
+				t.Errorf("MaskEnvValue(%q, %q) = %q, want %q", tt.key, tt.value, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,174 @@
+package config
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"sort"
+	"strings"
+)
+
+// ============================================================================
+// Private Helpers - Service Extension
+// ============================================================================
+
+// resolveExtends merges each service that sets 'extends' with the service it extends
+// Bases are resolved first, so a chain (worker -> api -> base) inherits all the way down
+// Returns an error for an unknown base or an extends cycle (e.g., a -> b -> a)
+func (c *Config) resolveExtends() error {
+	// Sorted so the first error reported is deterministic
+	names := make([]string, 0, len(c.Services))
+	for name := range c.Services {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	resolved := make(map[string]bool)
+	for _, name := range names {
+		if err := c.resolveServiceExtends(name, resolved, nil); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// resolveServiceExtends merges one service with its (already resolved) base
+// path holds the services currently being resolved, so reaching one again is a cycle
+func (c *Config) resolveServiceExtends(name string, resolved map[string]bool, path []string) error {
+	if resolved[name] {
+		return nil
+	}
+	for i, visiting := range path {
+		if visiting == name {
+			cycle := append(append([]string{}, path[i:]...), name)
+			return fmt.Errorf("extends cycle detected: %s", strings.Join(cycle, " -> "))
+		}
+	}
+
+	service := c.Services[name]
+	if service.Extends == "" {
+		resolved[name] = true
+		return nil
+	}
+
+	base, ok := c.Services[service.Extends]
+	if !ok {
+		return fmt.Errorf("service '%s' extends unknown service '%s'", name, service.Extends)
+	}
+	if err := c.resolveServiceExtends(service.Extends, resolved, append(path, name)); err != nil {
+		return err
+	}
+	base = c.Services[service.Extends]
+
+	c.Services[name] = mergeService(base, service)
+	resolved[name] = true
+	return nil
+}
+
+// mergeService returns child with every field it leaves unset taken from base
+// Env and labels are merged key by key (child keys win); every other field is replaced as a whole.
+// A child that sets its own source (git, image, or build) doesn't inherit the base's.
+// Inherited values are copied, since interpolation later rewrites each service's fields in place
+func mergeService(base, child Service) Service {
+	merged := child
+
+	if child.Git == "" && child.Image == "" && child.Build == nil {
+		merged.Git, merged.Image, merged.Build = base.Git, base.Image, cloneBuild(base.Build)
+	}
+
+	merged.Env = mergeStringMaps(base.Env, child.Env)
+	merged.Labels = mergeStringMaps(base.Labels, child.Labels)
+
+	if child.Ports == nil {
+		merged.Ports = slices.Clone(base.Ports)
+	}
+	if child.DependsOn == nil {
+		merged.DependsOn = slices.Clone(base.DependsOn)
+	}
+	if child.Health == nil {
+		merged.Health = cloneHealth(base.Health)
+	}
+	if child.Command == nil {
+		merged.Command = slices.Clone(base.Command)
+	}
+	if child.Entrypoint == nil {
+		merged.Entrypoint = slices.Clone(base.Entrypoint)
+	}
+	if child.Volumes == nil {
+		merged.Volumes = slices.Clone(base.Volumes)
+	}
+	if child.Profiles == nil {
+		merged.Profiles = slices.Clone(base.Profiles)
+	}
+	if child.Restart == "" {
+		merged.Restart = base.Restart
+	}
+	if child.Resources == nil && base.Resources != nil {
+		resources := *base.Resources
+		merged.Resources = &resources
+	}
+	if child.NetworkMode == "" {
+		merged.NetworkMode = base.NetworkMode
+	}
+	if child.Aliases == nil {
+		merged.Aliases = slices.Clone(base.Aliases)
+	}
+	if child.StopTimeout == "" {
+		merged.StopTimeout = base.StopTimeout
+	}
+	if child.StopGracePeriod == "" {
+		merged.StopGracePeriod = base.StopGracePeriod
+	}
+	if !child.WaitForNativeHealth {
+		merged.WaitForNativeHealth = base.WaitForNativeHealth
+	}
+	if child.WaitFor == nil {
+		merged.WaitFor = slices.Clone(base.WaitFor)
+	}
+	if child.WaitForTimeout == "" {
+		merged.WaitForTimeout = base.WaitForTimeout
+	}
+
+	return merged
+}
+
+// mergeStringMaps returns a new map with base's entries overridden by child's (nil if both are empty)
+func mergeStringMaps(base, child map[string]string) map[string]string {
+	if len(base) == 0 && len(child) == 0 {
+		return child
+	}
+
+	merged := make(map[string]string, len(base)+len(child))
+	maps.Copy(merged, base)
+	maps.Copy(merged, child)
+	return merged
+}
+
+// cloneBuild returns a deep copy of a build section (nil stays nil)
+func cloneBuild(build *Build) *Build {
+	if build == nil {
+		return nil
+	}
+
+	clone := *build
+	clone.Args = maps.Clone(build.Args)
+	clone.CacheFrom = slices.Clone(build.CacheFrom)
+	if build.Target != nil {
+		target := *build.Target
+		clone.Target = &target
+	}
+	return &clone
+}
+
+// cloneHealth returns a deep copy of a health check (nil stays nil)
+func cloneHealth(health *HealthCheck) *HealthCheck {
+	if health == nil {
+		return nil
+	}
+
+	clone := *health
+	clone.Command = slices.Clone(health.Command)
+	clone.Headers = maps.Clone(health.Headers)
+	return &clone
+}
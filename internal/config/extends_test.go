@@ -0,0 +1,261 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// loadExtendsConfig writes content to a temporary ork.yml and loads it
+func loadExtendsConfig(t *testing.T, content string) (*Config, error) {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "ork.yml")
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatalf("failed to create test config file: %v", err)
+	}
+	return LoadFrom(path)
+}
+
+// TestExtends_InheritsImageAndEnv tests that an extending service inherits the fields it leaves unset
+func TestExtends_InheritsImageAndEnv(t *testing.T) {
+	cfg, err := loadExtendsConfig(t, `
+version: "1.0"
+project: shop
+services:
+  api:
+    image: node:18
+    env:
+      NODE_ENV: development
+      LOG_LEVEL: info
+    labels:
+      team: payments
+    health:
+      endpoint: /health
+  worker:
+    extends: api
+    command: ["node", "worker.js"]
+    env:
+      QUEUE: jobs
+`)
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+
+	worker := cfg.Services["worker"]
+	if worker.Image != "node:18" {
+		t.Errorf("expected inherited image 'node:18', got '%s'", worker.Image)
+	}
+	wantEnv := map[string]string{"NODE_ENV": "development", "LOG_LEVEL": "info", "QUEUE": "jobs"}
+	if !reflect.DeepEqual(worker.Env, wantEnv) {
+		t.Errorf("expected env to be deep-merged into %v, got %v", wantEnv, worker.Env)
+	}
+	if worker.Labels["team"] != "payments" {
+		t.Errorf("expected inherited label team=payments, got %v", worker.Labels)
+	}
+	if worker.Health == nil || worker.Health.Endpoint != "/health" {
+		t.Errorf("expected inherited health check, got %+v", worker.Health)
+	}
+	if !reflect.DeepEqual(worker.Command, []string{"node", "worker.js"}) {
+		t.Errorf("expected the worker's own command, got %v", worker.Command)
+	}
+
+	// The base is left untouched
+	if _, ok := cfg.Services["api"].Env["QUEUE"]; ok {
+		t.Error("expected the base service's env not to pick up the child's keys")
+	}
+}
+
+// TestExtends_ChildOverridesWin tests that fields set on the extending service replace the base's
+func TestExtends_ChildOverridesWin(t *testing.T) {
+	cfg, err := loadExtendsConfig(t, `
+version: "1.0"
+project: shop
+services:
+  base:
+    image: node:18
+    ports: ["3000:3000"]
+    env:
+      NODE_ENV: development
+    health:
+      endpoint: /health
+      timeout: 3s
+  api:
+    extends: base
+    image: node:20
+    ports: ["4000:3000"]
+    env:
+      NODE_ENV: production
+    health:
+      endpoint: /ready
+  web:
+    extends: base
+    build:
+      context: ./web
+`)
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+
+	api := cfg.Services["api"]
+	if api.Image != "node:20" {
+		t.Errorf("expected the child's image 'node:20', got '%s'", api.Image)
+	}
+	if !reflect.DeepEqual(api.Ports, []string{"4000:3000"}) {
+		t.Errorf("expected the child's ports to replace the base's, got %v", api.Ports)
+	}
+	if api.Env["NODE_ENV"] != "production" {
+		t.Errorf("expected the child's env value to win, got '%s'", api.Env["NODE_ENV"])
+	}
+	if api.Health.Endpoint != "/ready" || api.Health.Timeout != "" {
+		t.Errorf("expected the child's health check to replace the base's as a whole, got %+v", api.Health)
+	}
+
+	// A child with its own source doesn't also inherit the base's image
+	web := cfg.Services["web"]
+	if web.Image != "" || web.Build == nil {
+		t.Errorf("expected web to keep only its build source, got image '%s' and build %+v", web.Image, web.Build)
+	}
+}
+
+// TestExtends_Chain tests that extends chains inherit through every level
+func TestExtends_Chain(t *testing.T) {
+	cfg, err := loadExtendsConfig(t, `
+version: "1.0"
+project: shop
+services:
+  worker:
+    extends: api
+  api:
+    extends: base
+    env:
+      SERVICE: api
+  base:
+    image: node:18
+    env:
+      NODE_ENV: development
+`)
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+
+	worker := cfg.Services["worker"]
+	if worker.Image != "node:18" {
+		t.Errorf("expected image inherited through the chain, got '%s'", worker.Image)
+	}
+	wantEnv := map[string]string{"NODE_ENV": "development", "SERVICE": "api"}
+	if !reflect.DeepEqual(worker.Env, wantEnv) {
+		t.Errorf("expected env %v, got %v", wantEnv, worker.Env)
+	}
+}
+
+// TestExtends_InterpolatesInheritedFieldsOnce tests that inherited fields aren't shared with the base,
+// so an escaped $$ is unescaped once per service rather than once per service extending it
+func TestExtends_InterpolatesInheritedFieldsOnce(t *testing.T) {
+	cfg, err := loadExtendsConfig(t, `
+version: "1.0"
+project: shop
+services:
+  api:
+    image: node:18
+    command: ["echo", "$${HOME}"]
+  worker:
+    extends: api
+`)
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+
+	for _, name := range []string{"api", "worker"} {
+		if got := cfg.Services[name].Command[1]; got != "${HOME}" {
+			t.Errorf("%s: expected command argument '${HOME}', got '%s'", name, got)
+		}
+	}
+}
+
+// TestExtends_Errors tests extends cycles and unknown base services
+func TestExtends_Errors(t *testing.T) {
+	tests := []struct {
+		name     string
+		services string
+		wantErr  string
+	}{
+		{
+			name: "two-service cycle",
+			services: `
+  a:
+    extends: b
+  b:
+    extends: a`,
+			wantErr: "extends cycle detected: a -> b -> a",
+		},
+		{
+			name: "self reference",
+			services: `
+  a:
+    extends: a`,
+			wantErr: "extends cycle detected: a -> a",
+		},
+		{
+			name: "cycle behind a valid service",
+			services: `
+  a:
+    extends: b
+  b:
+    extends: c
+  c:
+    extends: b`,
+			wantErr: "extends cycle detected: b -> c -> b",
+		},
+		{
+			name: "unknown base",
+			services: `
+  api:
+    extends: bsae`,
+			wantErr: "service 'api' extends unknown service 'bsae'",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := loadExtendsConfig(t, "version: \"1.0\"\nproject: shop\nservices:"+tt.services+"\n")
+			if err == nil {
+				t.Fatal("expected error, got nil")
+			}
+			if !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("expected error containing %q, got: %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+// TestLoad_YAMLAnchors tests that YAML anchors and merge keys work for reusing service blocks
+func TestLoad_YAMLAnchors(t *testing.T) {
+	cfg, err := loadExtendsConfig(t, `
+version: "1.0"
+project: shop
+services:
+  api: &node
+    image: node:18
+    env: &env
+      NODE_ENV: development
+  worker:
+    <<: *node
+    env:
+      <<: *env
+      QUEUE: jobs
+`)
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+
+	worker := cfg.Services["worker"]
+	if worker.Image != "node:18" {
+		t.Errorf("expected image from the anchor, got '%s'", worker.Image)
+	}
+	wantEnv := map[string]string{"NODE_ENV": "development", "QUEUE": "jobs"}
+	if !reflect.DeepEqual(worker.Env, wantEnv) {
+		t.Errorf("expected env %v, got %v", wantEnv, worker.Env)
+	}
+}
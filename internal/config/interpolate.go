@@ -0,0 +1,145 @@
+package config
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// ============================================================================
+// Private Helpers - Config Interpolation
+// ============================================================================
+
+// interpolateServices substitutes ${VAR} and ${VAR:-default} references in service fields
+// Runs after YAML parsing, so quoting in ork.yml never changes what gets substituted
+// Values come from vars (the project .env) first, then the process environment
+// Only the braced form is expanded; $$ escapes a literal $ and unresolved references stay intact
+// env and depends_on are left alone: env is interpolated when the container starts
+func (c *Config) interpolateServices(vars EnvVars) error {
+	walker := &fieldInterpolator{
+		interp: &interpolator{
+			envVars:   vars,
+			opts:      InterpolateOptions{KeepUnresolved: true},
+			resolving: make(map[string]bool),
+			seen:      make(map[UnresolvedRef]bool),
+		},
+	}
+
+	// Sorted so the first error reported is deterministic
+	names := make([]string, 0, len(c.Services))
+	for name := range c.Services {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		service := c.Services[name]
+		if err := walker.service(&service); err != nil {
+			return fmt.Errorf("service '%s': %w", name, err)
+		}
+		c.Services[name] = service
+	}
+
+	return nil
+}
+
+// fieldInterpolator walks the string fields of a service, stopping at the first error
+type fieldInterpolator struct {
+	interp *interpolator
+	err    error
+}
+
+// service interpolates every string field of s in place
+func (f *fieldInterpolator) service(s *Service) error {
+	f.err = nil
+
+	f.str("git", &s.Git)
+	f.str("image", &s.Image)
+	f.list("ports", s.Ports)
+	f.list("command", s.Command)
+	f.list("entrypoint", s.Entrypoint)
+	f.list("volumes", s.Volumes)
+	f.str("restart", &s.Restart)
+	f.dict("labels", s.Labels)
+	f.str("network_mode", &s.NetworkMode)
+	f.list("aliases", s.Aliases)
+	f.str("stop_timeout", &s.StopTimeout)
+	f.str("stop_grace_period", &s.StopGracePeriod)
+	f.list("wait_for", s.WaitFor)
+	f.str("wait_for_timeout", &s.WaitForTimeout)
+
+	if b := s.Build; b != nil {
+		f.str("build.context", &b.Context)
+		f.str("build.dockerfile", &b.Dockerfile)
+		f.dict("build.args", b.Args)
+		if b.Target != nil {
+			f.str("build.target", b.Target)
+		}
+		f.list("build.cache_from", b.CacheFrom)
+	}
+
+	if h := s.Health; h != nil {
+		f.str("health.endpoint", &h.Endpoint)
+		f.str("health.method", &h.Method)
+		f.dict("health.headers", h.Headers)
+		f.list("health.command", h.Command)
+		f.str("health.interval", &h.Interval)
+		f.str("health.timeout", &h.Timeout)
+		f.str("health.start_period", &h.StartPeriod)
+	}
+
+	if r := s.Resources; r != nil {
+		f.str("resources.cpus", &r.CPUs)
+		f.str("resources.memory", &r.Memory)
+	}
+
+	return f.err
+}
+
+// str interpolates a single string field
+func (f *fieldInterpolator) str(field string, value *string) {
+	if f.err != nil {
+		return
+	}
+
+	interpolated, err := f.value(*value)
+	if err != nil {
+		f.err = fmt.Errorf("%s: %w", field, err)
+		return
+	}
+	*value = interpolated
+}
+
+// list interpolates each entry of a list field
+func (f *fieldInterpolator) list(field string, values []string) {
+	for i := range values {
+		f.str(field, &values[i])
+	}
+}
+
+// dict interpolates the values (never the keys) of a map field
+func (f *fieldInterpolator) dict(field string, values map[string]string) {
+	for key, value := range values {
+		f.str(field+"."+key, &value)
+		values[key] = value
+	}
+}
+
+// value expands the braced references in a single config value
+// $$ is an escaped literal $, so "$${VAR}" stays as "${VAR}"
+func (f *fieldInterpolator) value(value string) (string, error) {
+	if !strings.Contains(value, "$") {
+		return value, nil
+	}
+
+	parts := strings.Split(value, "$$")
+	for i, part := range parts {
+		interpolated, err := f.interp.interpolateBraced(part)
+		if err != nil {
+			return "", err
+		}
+		parts[i] = interpolated
+	}
+
+	return strings.Join(parts, "$"), nil
+}
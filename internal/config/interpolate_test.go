@@ -0,0 +1,258 @@
+package config
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/ork-cli/ork/pkg/utils"
+)
+
+// loadInterpolationConfig writes ork.yml (and an optional .env) to a temp dir and loads it
+func loadInterpolationConfig(t *testing.T, configContent, envContent string) (*Config, error) {
+	t.Helper()
+
+	tempDir := t.TempDir()
+	configPath := filepath.Join(tempDir, "ork.yml")
+	if err := os.WriteFile(configPath, []byte(configContent), 0644); err != nil {
+		t.Fatalf("failed to create test config file: %v", err)
+	}
+	if envContent != "" {
+		if err := os.WriteFile(filepath.Join(tempDir, ".env"), []byte(envContent), 0644); err != nil {
+			t.Fatalf("failed to create .env file: %v", err)
+		}
+	}
+
+	return LoadWithOptions(LoadOptions{File: configPath})
+}
+
+// TestLoad_InterpolatesImageTag tests image: myapp:${TAG:-latest} from each source
+func TestLoad_InterpolatesImageTag(t *testing.T) {
+	configContent := `
+version: "1.0"
+project: test
+services:
+  app:
+    image: myapp:${TAG:-latest}
+`
+
+	tests := []struct {
+		name     string
+		env      string // .env contents
+		setenv   string // Process environment value for TAG
+		expected string
+	}{
+		{name: "default", expected: "myapp:latest"},
+		{name: "from .env", env: "TAG=1.4.2\n", expected: "myapp:1.4.2"},
+		{name: "from environment", setenv: "2.0.0", expected: "myapp:2.0.0"},
+		{name: ".env wins over environment", env: "TAG=1.4.2\n", setenv: "2.0.0", expected: "myapp:1.4.2"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("TAG", tt.setenv)
+
+			cfg, err := loadInterpolationConfig(t, configContent, tt.env)
+			if err != nil {
+				t.Fatalf("expected no error, got: %v", err)
+			}
+			if got := cfg.Services["app"].Image; got != tt.expected {
+				t.Errorf("expected image '%s', got '%s'", tt.expected, got)
+			}
+		})
+	}
+}
+
+// TestLoad_InterpolatesServiceFields tests interpolation across list, map, and nested fields
+func TestLoad_InterpolatesServiceFields(t *testing.T) {
+	configContent := `
+version: "1.0"
+project: test
+services:
+  api:
+    build:
+      context: ./api
+      args:
+        VERSION: ${VERSION}
+    ports:
+      - "${API_PORT:-8080}:8080"
+    command: ["serve", "--port", "${API_PORT:-8080}"]
+    labels:
+      traefik.http.routers.api.rule: Host(` + "`${DOMAIN}`" + `)
+    health:
+      endpoint: /health
+      timeout: ${HEALTH_TIMEOUT:-3s}
+    resources:
+      memory: ${API_MEMORY}
+`
+	envContent := "VERSION=1.2.3\nAPI_PORT=9000\nDOMAIN=api.localhost\nAPI_MEMORY=512m\n"
+
+	cfg, err := loadInterpolationConfig(t, configContent, envContent)
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+
+	api := cfg.Services["api"]
+	if got := api.Build.Args["VERSION"]; got != "1.2.3" {
+		t.Errorf("expected build arg VERSION '1.2.3', got '%s'", got)
+	}
+	if !reflect.DeepEqual(api.Ports, []string{"9000:8080"}) {
+		t.Errorf("expected ports [9000:8080], got %v", api.Ports)
+	}
+	if !reflect.DeepEqual(api.Command, []string{"serve", "--port", "9000"}) {
+		t.Errorf("expected interpolated command, got %v", api.Command)
+	}
+	if got := api.Labels["traefik.http.routers.api.rule"]; got != "Host(`api.localhost`)" {
+		t.Errorf("expected interpolated label, got '%s'", got)
+	}
+	if got := api.Health.Timeout; got != "3s" {
+		t.Errorf("expected health timeout '3s', got '%s'", got)
+	}
+	if got := api.Resources.Memory; got != "512m" {
+		t.Errorf("expected memory '512m', got '%s'", got)
+	}
+}
+
+// TestLoad_InterpolatesHealthRequest tests the HTTP health check's method and header values are interpolated
+func TestLoad_InterpolatesHealthRequest(t *testing.T) {
+	configContent := `
+version: "1.0"
+project: test
+services:
+  api:
+    image: node:18
+    health:
+      endpoint: /health
+      method: ${HEALTH_METHOD:-GET}
+      headers:
+        Authorization: Bearer ${API_TOKEN}
+`
+	envContent := "HEALTH_METHOD=HEAD\nAPI_TOKEN=s3cret\n"
+
+	cfg, err := loadInterpolationConfig(t, configContent, envContent)
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+
+	health := cfg.Services["api"].Health
+	if health.Method != "HEAD" {
+		t.Errorf("expected health method 'HEAD', got '%s'", health.Method)
+	}
+	if got := health.Headers["Authorization"]; got != "Bearer s3cret" {
+		t.Errorf("expected interpolated Authorization header, got '%s'", got)
+	}
+}
+
+// TestLoad_InterpolationLeavesLiterals tests what interpolation deliberately does not touch
+func TestLoad_InterpolationLeavesLiterals(t *testing.T) {
+	t.Setenv("HOME", "/home/tester")
+	t.Setenv("TAG", "")
+
+	configContent := `
+version: "1.0"
+project: test
+services:
+  app:
+    image: myapp:${TAG}
+    command: ["sh", "-c", "echo $HOME && echo $${HOME}"]
+    env:
+      GREETING: ${HOME}
+`
+
+	cfg, err := loadInterpolationConfig(t, configContent, "")
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+
+	app := cfg.Services["app"]
+	// Unresolved references stay intact so validation and docker report them as written
+	if app.Image != "myapp:${TAG}" {
+		t.Errorf("expected unresolved reference to stay intact, got '%s'", app.Image)
+	}
+	// Short form is left for the container's shell, and $$ escapes a literal $
+	if got := app.Command[2]; got != "echo $HOME && echo ${HOME}" {
+		t.Errorf("expected shell variables to stay literal, got '%s'", got)
+	}
+	// env is interpolated when the container starts, not at load time
+	if got := app.Env["GREETING"]; got != "${HOME}" {
+		t.Errorf("expected env to be left alone, got '%s'", got)
+	}
+}
+
+// TestLoad_UnresolvedImageFailsValidation tests that an image left with an unset ${VAR} is reported by name
+func TestLoad_UnresolvedImageFailsValidation(t *testing.T) {
+	t.Setenv("API_TAG", "")
+
+	configContent := `
+version: "1.0"
+project: test
+services:
+  api:
+    image: ghcr.io/org/api:${API_TAG}
+`
+
+	cfg, err := loadInterpolationConfig(t, configContent, "")
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+
+	err = cfg.Validate()
+	var orkErr *utils.OrkError
+	if !errors.As(err, &orkErr) {
+		t.Fatalf("expected a validation error, got: %v", err)
+	}
+	if !strings.Contains(orkErr.Hint, "${API_TAG}, which is not set") {
+		t.Errorf("expected hint naming the unset variable, got: %q", orkErr.Hint)
+	}
+}
+
+// TestLoad_InterpolationQuotedValues tests that YAML quoting doesn't change substitution
+func TestLoad_InterpolationQuotedValues(t *testing.T) {
+	configContent := `
+version: "1.0"
+project: test
+services:
+  plain:
+    image: myapp:${TAG}
+  double:
+    image: "myapp:${TAG}"
+  single:
+    image: 'myapp:${TAG}'
+`
+
+	// A value containing YAML syntax must not be re-parsed after substitution
+	cfg, err := loadInterpolationConfig(t, configContent, "TAG=\"v1 # not: a comment\"\n")
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+
+	for _, name := range []string{"plain", "double", "single"} {
+		if got := cfg.Services[name].Image; got != "myapp:v1 # not: a comment" {
+			t.Errorf("service %s: expected image 'myapp:v1 # not: a comment', got '%s'", name, got)
+		}
+	}
+}
+
+// TestLoad_InterpolationError tests that interpolation errors name the service and field
+func TestLoad_InterpolationError(t *testing.T) {
+	configContent := `
+version: "1.0"
+project: test
+services:
+  app:
+    image: myapp:${LOOP}
+`
+
+	_, err := loadInterpolationConfig(t, configContent, "LOOP=${LOOP}\n")
+	if err == nil {
+		t.Fatal("expected error for circular reference, got nil")
+	}
+	for _, want := range []string{"service 'app'", "image", "circular reference"} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("expected error to contain %q, got: %v", want, err)
+		}
+	}
+}
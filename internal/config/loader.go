@@ -1,7 +1,11 @@
 package config
 
 import (
+	"bytes"
+	"errors"
 	"fmt"
+	"io"
+	"io/fs"
 	"os"
 	"path/filepath"
 
@@ -25,28 +29,36 @@ func defaultWorkspaces() []string {
 // Public API
 // ============================================================================
 
+// LoadOptions controls how the project configuration is loaded
+type LoadOptions struct {
+	File   string // Explicit config file path (empty searches the current directory and its parents)
+	Strict bool   // Reject unknown fields in the config file
+}
+
 // Load reads and parses the ork.yml configuration file
-// It looks for ork.yml in the current directory, falling back to .ork.yml
+// It looks for ork.yml (falling back to .ork.yml) in the current directory, then in each parent directory
 func Load() (*Config, error) {
-	// Find the config file
-	configPath, err := findConfigFile()
-	if err != nil {
-		return nil, err
-	}
+	return LoadWithOptions(LoadOptions{})
+}
 
-	// Read the file contents
-	data, err := os.ReadFile(configPath)
-	if err != nil {
-		return nil, fmt.Errorf("failed to read config file %s: %w", configPath, err)
-	}
+// LoadFrom reads and parses the config file at path (e.g., "ork.prod.yml"), skipping the search
+// for ork.yml; relative volume paths and .env files resolve against the file's directory
+func LoadFrom(path string) (*Config, error) {
+	return loadFile(path, false)
+}
 
-	// Parse YAML into our Config struct
-	var config Config
-	if err := yaml.Unmarshal(data, &config); err != nil {
-		return nil, fmt.Errorf("failed to parse YAML in %s: %w", configPath, err)
+// LoadWithOptions reads and parses the project configuration file
+// Uses opts.File when set (like LoadFrom), otherwise searches the current directory and its parents like Load
+func LoadWithOptions(opts LoadOptions) (*Config, error) {
+	if opts.File != "" {
+		return loadFile(opts.File, opts.Strict)
 	}
 
-	return &config, nil
+	configPath, err := findConfigFile()
+	if err != nil {
+		return nil, err
+	}
+	return loadFile(configPath, opts.Strict)
 }
 
 // LoadGlobal reads and parses the global ~/.ork/config.yml file
@@ -90,7 +102,57 @@ func LoadGlobal() (*GlobalConfig, error) {
 // Private Helpers
 // ============================================================================
 
-// findConfigFile searches for ork.yml or .ork.yml in the current directory
+// loadFile reads and parses the config file at configPath
+// With strict, unknown fields are rejected
+func loadFile(configPath string, strict bool) (*Config, error) {
+	if configPath == "" {
+		return nil, fmt.Errorf("config file path is empty")
+	}
+	if absPath, err := filepath.Abs(configPath); err == nil {
+		configPath = absPath // So relative volume paths resolve against the file's directory
+	}
+
+	// Read the file contents
+	data, err := os.ReadFile(configPath)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, fmt.Errorf("config file %s not found", configPath)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read config file %s: %w", configPath, err)
+	}
+
+	// Parse YAML into our Config struct
+	var config Config
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(strict)
+	if err := decoder.Decode(&config); err != nil && err != io.EOF {
+		return nil, fmt.Errorf("failed to parse YAML in %s: %w", configPath, err)
+	}
+
+	// Merge services with the services they extend, before anything reads their fields
+	if err := config.resolveExtends(); err != nil {
+		return nil, fmt.Errorf("failed to resolve extends in %s: %w", configPath, err)
+	}
+
+	// Resolve volume host paths (and later .env files) relative to the config file
+	config.BaseDir = filepath.Dir(configPath)
+
+	// Substitute ${VAR} references in service fields from the project .env and environment
+	projectEnv, err := LoadProjectEnv(config.BaseDir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load project .env for %s: %w", configPath, err)
+	}
+	if err := config.interpolateServices(projectEnv); err != nil {
+		return nil, fmt.Errorf("failed to interpolate variables in %s: %w", configPath, err)
+	}
+
+	config.resolveVolumePaths(config.BaseDir)
+
+	return &config, nil
+}
+
+// findConfigFile searches for ork.yml or .ork.yml in the current directory and its parents,
+// so ork works from anywhere inside a project
 func findConfigFile() (string, error) {
 	// Get the current working directory
 	cwd, err := os.Getwd()
@@ -98,18 +160,37 @@ func findConfigFile() (string, error) {
 		return "", fmt.Errorf("failed to get current directory: %w", err)
 	}
 
-	// Try ork.yml first
-	configPath := filepath.Join(cwd, "ork.yml")
-	if _, err := os.Stat(configPath); err == nil {
+	if projectDir, ok := findProjectDir(cwd); ok {
+		configPath, _ := configFileIn(projectDir)
 		return configPath, nil
 	}
 
-	// Fall back to .ork.yml
-	configPath = filepath.Join(cwd, ".ork.yml")
-	if _, err := os.Stat(configPath); err == nil {
-		return configPath, nil
+	// No config file found
+	return "", fmt.Errorf("no ork.yml or .ork.yml found in %s or its parent directories", cwd)
+}
+
+// findProjectDir walks up from dir to the nearest directory containing ork.yml or .ork.yml
+func findProjectDir(dir string) (string, bool) {
+	for {
+		if _, ok := configFileIn(dir); ok {
+			return dir, true
+		}
+
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return "", false // Reached the filesystem root
+		}
+		dir = parent
 	}
+}
 
-	// No config file found
-	return "", fmt.Errorf("no ork.yml or .ork.yml found in %s", cwd)
+// configFileIn returns the config file in dir, preferring ork.yml over .ork.yml
+func configFileIn(dir string) (string, bool) {
+	for _, name := range []string{"ork.yml", ".ork.yml"} {
+		configPath := filepath.Join(dir, name)
+		if _, err := os.Stat(configPath); err == nil {
+			return configPath, true
+		}
+	}
+	return "", false
 }
@@ -3,8 +3,11 @@ package config
 import (
 	"os"
 	"path/filepath"
+	"reflect"
 	"strings"
 	"testing"
+
+	"gopkg.in/yaml.v3"
 )
 
 // TestLoad_ValidConfig tests loading a valid ork.yml file
@@ -256,7 +259,7 @@ services:
 	if frontend.Image != "nginx:alpine" {
 		t.Errorf("expected frontend image 'nginx:alpine', got '%s'", frontend.Image)
 	}
-	if len(frontend.DependsOn) != 1 || frontend.DependsOn[0] != "api" {
+	if len(frontend.DependsOn) != 1 || frontend.DependsOn[0].Service != "api" {
 		t.Errorf("expected frontend to depend on 'api', got %v", frontend.DependsOn)
 	}
 
@@ -317,6 +320,59 @@ func TestFindConfigFile_Success(t *testing.T) {
 	}
 }
 
+// TestFindConfigFile_FromSubdirectory tests the search walks up to the project's ork.yml
+func TestFindConfigFile_FromSubdirectory(t *testing.T) {
+	projectDir := t.TempDir()
+	subDir := filepath.Join(projectDir, "services", "api")
+	if err := os.MkdirAll(subDir, 0755); err != nil {
+		t.Fatalf("failed to create subdirectory: %v", err)
+	}
+	os.WriteFile(filepath.Join(projectDir, "ork.yml"), []byte("version: 1.0"), 0644)
+
+	originalDir, _ := os.Getwd()
+	defer os.Chdir(originalDir)
+	os.Chdir(subDir)
+
+	foundPath, err := findConfigFile()
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+
+	// Compare resolved paths, since the temp directory may sit behind a symlink
+	want, _ := filepath.EvalSymlinks(filepath.Join(projectDir, "ork.yml"))
+	got, _ := filepath.EvalSymlinks(foundPath)
+	if got != want {
+		t.Errorf("expected %s, got %s", want, got)
+	}
+}
+
+// TestFindConfigFile_NearestWins tests a nested project's config wins over an outer one
+func TestFindConfigFile_NearestWins(t *testing.T) {
+	outerDir := t.TempDir()
+	innerDir := filepath.Join(outerDir, "inner")
+	subDir := filepath.Join(innerDir, "src")
+	if err := os.MkdirAll(subDir, 0755); err != nil {
+		t.Fatalf("failed to create subdirectory: %v", err)
+	}
+	os.WriteFile(filepath.Join(outerDir, "ork.yml"), []byte("version: 1.0"), 0644)
+	os.WriteFile(filepath.Join(innerDir, ".ork.yml"), []byte("version: 1.0"), 0644)
+
+	originalDir, _ := os.Getwd()
+	defer os.Chdir(originalDir)
+	os.Chdir(subDir)
+
+	foundPath, err := findConfigFile()
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+
+	want, _ := filepath.EvalSymlinks(filepath.Join(innerDir, ".ork.yml"))
+	got, _ := filepath.EvalSymlinks(foundPath)
+	if got != want {
+		t.Errorf("expected the inner project's config %s, got %s", want, got)
+	}
+}
+
 // TestFindConfigFile_NotFound tests error when no config file exists
 func TestFindConfigFile_NotFound(t *testing.T) {
 	tempDir := t.TempDir()
@@ -334,3 +390,318 @@ func TestFindConfigFile_NotFound(t *testing.T) {
 		t.Errorf("expected 'no ork.yml or .ork.yml found' error, got: %v", err)
 	}
 }
+
+// TestLoad_HealthCheckCommand tests parsing a command-based health check
+func TestLoad_HealthCheckCommand(t *testing.T) {
+	tempDir := t.TempDir()
+
+	configContent := `
+version: "1.0"
+project: test-project
+services:
+  postgres:
+    image: postgres:15-alpine
+    health:
+      command: ["pg_isready", "-U", "postgres"]
+      interval: 2s
+      timeout: 1s
+`
+	if err := os.WriteFile(filepath.Join(tempDir, "ork.yml"), []byte(configContent), 0644); err != nil {
+		t.Fatalf("failed to create test config file: %v", err)
+	}
+	t.Chdir(tempDir)
+
+	cfg, err := Load()
+	if err != nil {
+		t.Fatalf("expected no error loading config, got: %v", err)
+	}
+
+	health := cfg.Services["postgres"].Health
+	if health == nil {
+		t.Fatal("expected health check to be parsed")
+	}
+
+	want := []string{"pg_isready", "-U", "postgres"}
+	if strings.Join(health.Command, " ") != strings.Join(want, " ") {
+		t.Errorf("expected command %v, got %v", want, health.Command)
+	}
+	if health.Endpoint != "" {
+		t.Errorf("expected no endpoint, got '%s'", health.Endpoint)
+	}
+}
+
+// TestLoad_BuildTarget tests that an explicitly empty build target is distinguishable from an omitted one
+func TestLoad_BuildTarget(t *testing.T) {
+	tempDir := t.TempDir()
+
+	configContent := `
+version: "1.0"
+project: test-project
+services:
+  api:
+    build:
+      context: ./api
+      target: ""
+  worker:
+    build:
+      context: ./worker
+`
+	if err := os.WriteFile(filepath.Join(tempDir, "ork.yml"), []byte(configContent), 0644); err != nil {
+		t.Fatalf("failed to create test config file: %v", err)
+	}
+	t.Chdir(tempDir)
+
+	cfg, err := Load()
+	if err != nil {
+		t.Fatalf("expected no error loading config, got: %v", err)
+	}
+
+	if cfg.Services["api"].Build.Target == nil {
+		t.Error("expected explicit empty target to be set")
+	}
+	if cfg.Services["worker"].Build.Target != nil {
+		t.Error("expected omitted target to be nil")
+	}
+
+	if err := cfg.Validate(); err == nil {
+		t.Error("expected validation to reject the empty target")
+	}
+}
+
+// TestLoadWithOptions_Strict tests that strict mode rejects unknown fields
+func TestLoadWithOptions_Strict(t *testing.T) {
+	tempDir := t.TempDir()
+	configPath := filepath.Join(tempDir, "custom.yml")
+
+	configContent := `
+version: "1.0"
+project: test-project
+services:
+  api:
+    image: node:18
+    prots: ["3000:3000"]
+`
+	if err := os.WriteFile(configPath, []byte(configContent), 0644); err != nil {
+		t.Fatalf("failed to create test config file: %v", err)
+	}
+
+	if _, err := LoadWithOptions(LoadOptions{File: configPath}); err != nil {
+		t.Fatalf("expected lenient load to succeed, got: %v", err)
+	}
+
+	_, err := LoadWithOptions(LoadOptions{File: configPath, Strict: true})
+	if err == nil {
+		t.Fatal("expected strict load to reject unknown field")
+	}
+	if !strings.Contains(err.Error(), "prots") {
+		t.Errorf("expected error to name the unknown field, got: %v", err)
+	}
+}
+
+// TestLoadWithOptions_BaseDir tests BaseDir points at the config file's directory, not CWD
+func TestLoadWithOptions_BaseDir(t *testing.T) {
+	projectDir := t.TempDir()
+	subDir := filepath.Join(projectDir, "frontend")
+	if err := os.Mkdir(subDir, 0755); err != nil {
+		t.Fatalf("failed to create subdirectory: %v", err)
+	}
+
+	configContent := `
+version: "1.0"
+project: test-project
+services:
+  api:
+    image: node:18
+`
+	if err := os.WriteFile(filepath.Join(projectDir, "ork.yml"), []byte(configContent), 0644); err != nil {
+		t.Fatalf("failed to create test config file: %v", err)
+	}
+
+	originalDir, _ := os.Getwd()
+	defer os.Chdir(originalDir)
+	os.Chdir(subDir)
+
+	config, err := LoadWithOptions(LoadOptions{File: "../ork.yml"})
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+
+	// Resolve symlinks (e.g. /tmp on macOS) before comparing
+	want, _ := filepath.EvalSymlinks(projectDir)
+	got, _ := filepath.EvalSymlinks(config.BaseDir)
+	if got != want {
+		t.Errorf("expected BaseDir %s, got %s", want, got)
+	}
+}
+
+// TestLoadFrom_ExplicitPath tests loading a config file by path, under any name
+func TestLoadFrom_ExplicitPath(t *testing.T) {
+	dir := t.TempDir()
+	configPath := filepath.Join(dir, "ork.prod.yml")
+	configContent := `
+version: "1.0"
+project: prod-stack
+services:
+  api:
+    image: node:18
+`
+	if err := os.WriteFile(configPath, []byte(configContent), 0644); err != nil {
+		t.Fatalf("failed to create test config file: %v", err)
+	}
+
+	config, err := LoadFrom(configPath)
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if config.Project != "prod-stack" {
+		t.Errorf("expected project 'prod-stack', got '%s'", config.Project)
+	}
+	if config.BaseDir != dir {
+		t.Errorf("expected BaseDir %s, got %s", dir, config.BaseDir)
+	}
+}
+
+// TestLoadFrom_MissingPath tests that a missing explicit path is an error, not a fallback to discovery
+func TestLoadFrom_MissingPath(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "ork.yml"), []byte("project: discovered\n"), 0644); err != nil {
+		t.Fatalf("failed to create test config file: %v", err)
+	}
+	t.Chdir(dir)
+
+	_, err := LoadFrom("ork.staging.yml")
+	if err == nil {
+		t.Fatal("expected error for missing config file, got nil")
+	}
+	if !strings.Contains(err.Error(), "ork.staging.yml not found") {
+		t.Errorf("expected 'not found' error naming the file, got: %v", err)
+	}
+}
+
+// TestLoadFrom_TakesPrecedenceOverDiscovery tests that an explicit path wins over ork.yml in the current directory
+func TestLoadFrom_TakesPrecedenceOverDiscovery(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "ork.yml"), []byte("project: discovered\n"), 0644); err != nil {
+		t.Fatalf("failed to create test config file: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "ork.prod.yml"), []byte("project: explicit\n"), 0644); err != nil {
+		t.Fatalf("failed to create test config file: %v", err)
+	}
+	t.Chdir(dir)
+
+	discovered, err := Load()
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if discovered.Project != "discovered" {
+		t.Errorf("expected discovery to find ork.yml, got project '%s'", discovered.Project)
+	}
+
+	for name, load := range map[string]func() (*Config, error){
+		"LoadFrom":        func() (*Config, error) { return LoadFrom("ork.prod.yml") },
+		"LoadWithOptions": func() (*Config, error) { return LoadWithOptions(LoadOptions{File: "ork.prod.yml"}) },
+	} {
+		config, err := load()
+		if err != nil {
+			t.Fatalf("%s: expected no error, got: %v", name, err)
+		}
+		if config.Project != "explicit" {
+			t.Errorf("%s: expected the explicit file to win, got project '%s'", name, config.Project)
+		}
+	}
+}
+
+// TestLoad_MarshalRoundTrip tests that marshaling a loaded config and loading it again yields an equal Config
+func TestLoad_MarshalRoundTrip(t *testing.T) {
+	tempDir := t.TempDir()
+
+	configContent := `
+version: "1.0"
+project: round-trip
+max_parallel: 2
+services:
+  frontend:
+    image: nginx:alpine
+    ports: ["3000:80", "5353:53/udp"]
+    env:
+      API_URL: http://localhost:${API_PORT:-8080}
+      EMPTY: ""
+    depends_on: [api]
+    profiles: [web]
+    labels:
+      traefik.enable: "true"
+    aliases: [web]
+    wait_for_native_health: true
+  api:
+    git: github.com/org/api
+    command: ["npm", "start"]
+    entrypoint: ["/bin/sh", "-c"]
+    volumes: ["./src:/app/src:ro", "cache:/cache"]
+    depends_on:
+      postgres:
+        condition: service_healthy
+      cache:
+        condition: service_started
+    health:
+      type: tcp
+      interval: 5s
+      timeout: 3s
+      retries: 3
+      start_period: 1m
+    restart: on-failure:3
+    resources:
+      cpus: "0.5"
+      memory: 512m
+    stop_timeout: 30s
+  cache:
+    image: redis:7
+    network_mode: host
+    health:
+      disable: true
+  postgres:
+    build:
+      context: ./database
+      dockerfile: Dockerfile.postgres
+      args:
+        PG_VERSION: "15"
+      target: ""
+      cache_from: [postgres:15]
+    health:
+      command: ["pg_isready"]
+`
+	configPath := filepath.Join(tempDir, "ork.yml")
+	if err := os.WriteFile(configPath, []byte(configContent), 0644); err != nil {
+		t.Fatalf("failed to create test config file: %v", err)
+	}
+
+	loaded, err := LoadWithOptions(LoadOptions{File: configPath, Strict: true})
+	if err != nil {
+		t.Fatalf("expected no error loading config, got: %v", err)
+	}
+
+	data, err := yaml.Marshal(loaded)
+	if err != nil {
+		t.Fatalf("expected no error marshaling config, got: %v", err)
+	}
+
+	// Unset fields are left out rather than written as empty values
+	for _, unset := range []string{"git: \"\"", "dockerfile: \"\"", "endpoint:", "retries: 0", "BaseDir", "basedir"} {
+		if strings.Contains(string(data), unset) {
+			t.Errorf("expected marshaled config not to contain %q, got:\n%s", unset, data)
+		}
+	}
+
+	roundTripPath := filepath.Join(tempDir, "ork.roundtrip.yml")
+	if err := os.WriteFile(roundTripPath, data, 0644); err != nil {
+		t.Fatalf("failed to write marshaled config: %v", err)
+	}
+
+	reloaded, err := LoadWithOptions(LoadOptions{File: roundTripPath, Strict: true})
+	if err != nil {
+		t.Fatalf("expected no error reloading marshaled config, got: %v\n%s", err, data)
+	}
+
+	if !reflect.DeepEqual(loaded, reloaded) {
+		t.Errorf("expected round-tripped config to equal the original\noriginal: %+v\nreloaded: %+v\nyaml:\n%s", loaded, reloaded, data)
+	}
+}
@@ -0,0 +1,79 @@
+package config
+
+import (
+	"fmt"
+	"regexp"
+)
+
+// ============================================================================
+// Type Definitions
+// ============================================================================
+
+// Network modes (matching Docker's --network values Ork supports)
+const (
+	NetworkModeBridge = "bridge" // Join the project network (default)
+	NetworkModeHost   = "host"   // Share the host's network stack
+	NetworkModeNone   = "none"   // No networking at all
+)
+
+// ============================================================================
+// Public API
+// ============================================================================
+
+// UsesProjectNetwork reports whether the service joins the project network
+// Host and none networking replace the project network entirely
+func (s Service) UsesProjectNetwork() bool {
+	return s.NetworkMode == "" || s.NetworkMode == NetworkModeBridge
+}
+
+// NetworkAliases returns the DNS names the service answers to on the project network
+// The service name always comes first, so services can reach each other by their ork.yml name
+func (s Service) NetworkAliases(serviceName string) []string {
+	aliases := []string{serviceName}
+	seen := map[string]bool{serviceName: true}
+	for _, alias := range s.Aliases {
+		if !seen[alias] {
+			seen[alias] = true
+			aliases = append(aliases, alias)
+		}
+	}
+	return aliases
+}
+
+// ============================================================================
+// Private Validators
+// ============================================================================
+
+// networkAliasPattern matches a DNS name usable as a network alias (e.g., "db", "api.internal")
+var networkAliasPattern = regexp.MustCompile(`^[A-Za-z0-9]([A-Za-z0-9_.-]*[A-Za-z0-9])?$`)
+
+// validateAliases ensures aliases are valid DNS names and that the service joins the project network
+func validateAliases(service Service) error {
+	if len(service.Aliases) > 0 && !service.UsesProjectNetwork() {
+		return fmt.Errorf("aliases require the project network, but network_mode is '%s'", service.NetworkMode)
+	}
+
+	for _, alias := range service.Aliases {
+		if !networkAliasPattern.MatchString(alias) {
+			return fmt.Errorf("invalid alias '%s', expected a DNS name like \"db\" or \"api.internal\"", alias)
+		}
+	}
+
+	return nil
+}
+
+// validateNetworkMode ensures the network mode is one Ork supports
+// Published ports make no sense without networking, so they are rejected for "none"
+func validateNetworkMode(service Service) error {
+	switch service.NetworkMode {
+	case "", NetworkModeBridge, NetworkModeHost:
+		return nil
+	case NetworkModeNone:
+		if len(service.Ports) > 0 {
+			return fmt.Errorf("cannot publish ports with network_mode 'none'")
+		}
+		return nil
+	default:
+		return fmt.Errorf("invalid network_mode '%s', expected one of: bridge, host, none", service.NetworkMode)
+	}
+}
@@ -0,0 +1,100 @@
+package config
+
+import (
+	"reflect"
+	"testing"
+)
+
+// TestValidateNetworkMode tests the supported network modes and their port restrictions
+func TestValidateNetworkMode(t *testing.T) {
+	tests := []struct {
+		name    string
+		service Service
+		wantErr bool
+	}{
+		{name: "default", service: Service{Ports: []string{"8080:80"}}},
+		{name: "bridge", service: Service{NetworkMode: "bridge", Ports: []string{"8080:80"}}},
+		{name: "host", service: Service{NetworkMode: "host"}},
+		{name: "none", service: Service{NetworkMode: "none"}},
+		{name: "none with ports", service: Service{NetworkMode: "none", Ports: []string{"8080:80"}}, wantErr: true},
+		{name: "unknown", service: Service{NetworkMode: "overlay"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateNetworkMode(tt.service)
+			if tt.wantErr && err == nil {
+				t.Errorf("expected error, got nil")
+			}
+			if !tt.wantErr && err != nil {
+				t.Errorf("expected no error, got: %v", err)
+			}
+		})
+	}
+}
+
+// TestUsesProjectNetwork tests only bridge networking joins the project network
+func TestUsesProjectNetwork(t *testing.T) {
+	tests := map[string]bool{
+		"":       true,
+		"bridge": true,
+		"host":   false,
+		"none":   false,
+	}
+
+	for mode, want := range tests {
+		if got := (Service{NetworkMode: mode}).UsesProjectNetwork(); got != want {
+			t.Errorf("UsesProjectNetwork() with network_mode %q = %v, want %v", mode, got, want)
+		}
+	}
+}
+
+// TestNetworkAliases tests the service name is always the first alias, followed by deduplicated custom aliases
+func TestNetworkAliases(t *testing.T) {
+	tests := []struct {
+		name    string
+		aliases []string
+		want    []string
+	}{
+		{name: "implicit service name", aliases: nil, want: []string{"postgres"}},
+		{name: "custom aliases", aliases: []string{"db", "db.internal"}, want: []string{"postgres", "db", "db.internal"}},
+		{name: "duplicates dropped", aliases: []string{"db", "postgres", "db"}, want: []string{"postgres", "db"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Service{Aliases: tt.aliases}.NetworkAliases("postgres")
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("NetworkAliases() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestValidateAliases tests aliases must be DNS names on a service that joins the project network
+func TestValidateAliases(t *testing.T) {
+	tests := []struct {
+		name    string
+		service Service
+		wantErr bool
+	}{
+		{name: "none", service: Service{}},
+		{name: "valid", service: Service{Aliases: []string{"db", "db.internal", "cache-1"}}},
+		{name: "empty", service: Service{Aliases: []string{""}}, wantErr: true},
+		{name: "spaces", service: Service{Aliases: []string{"my db"}}, wantErr: true},
+		{name: "leading dash", service: Service{Aliases: []string{"-db"}}, wantErr: true},
+		{name: "host network", service: Service{NetworkMode: "host", Aliases: []string{"db"}}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateAliases(tt.service)
+			if tt.wantErr && err == nil {
+				t.Errorf("expected error, got nil")
+			}
+			if !tt.wantErr && err != nil {
+				t.Errorf("expected no error, got: %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,64 @@
+package config
+
+import "sort"
+
+// ============================================================================
+// Public API
+// ============================================================================
+
+// ProfileServices groups services by the profiles they declare
+// Returns a map of profile name -> sorted service names; services without profiles are omitted
+// A service that declares multiple profiles appears under each of them
+func (c *Config) ProfileServices() map[string][]string {
+	profiles := make(map[string][]string)
+
+	for name, service := range c.Services {
+		seen := make(map[string]bool) // Guard against a service listing the same profile twice
+		for _, profile := range service.Profiles {
+			if profile == "" || seen[profile] {
+				continue
+			}
+			seen[profile] = true
+			profiles[profile] = append(profiles[profile], name)
+		}
+	}
+
+	for profile := range profiles {
+		sort.Strings(profiles[profile])
+	}
+
+	return profiles
+}
+
+// ProfileNames returns all declared profile names in sorted order
+func (c *Config) ProfileNames() []string {
+	profiles := c.ProfileServices()
+
+	names := make([]string, 0, len(profiles))
+	for name := range profiles {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+// ServicesInProfiles returns the services that declare any of the given profiles, in sorted order
+// Each service appears once even when it matches several of the profiles
+func (c *Config) ServicesInProfiles(profiles []string) []string {
+	byProfile := c.ProfileServices()
+
+	seen := make(map[string]bool)
+	var names []string
+	for _, profile := range profiles {
+		for _, name := range byProfile[profile] {
+			if !seen[name] {
+				seen[name] = true
+				names = append(names, name)
+			}
+		}
+	}
+	sort.Strings(names)
+
+	return names
+}
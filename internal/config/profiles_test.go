@@ -0,0 +1,91 @@
+package config
+
+import (
+	"reflect"
+	"testing"
+)
+
+// TestProfileServices_GroupsByProfile tests that services are grouped under each declared profile
+func TestProfileServices_GroupsByProfile(t *testing.T) {
+	cfg := &Config{
+		Services: map[string]Service{
+			"api":        {Image: "node:18"},
+			"debugger":   {Image: "delve:latest", Profiles: []string{"debug"}},
+			"grafana":    {Image: "grafana:latest", Profiles: []string{"monitoring"}},
+			"prometheus": {Image: "prom:latest", Profiles: []string{"monitoring", "debug"}},
+		},
+	}
+
+	got := cfg.ProfileServices()
+	want := map[string][]string{
+		"debug":      {"debugger", "prometheus"},
+		"monitoring": {"grafana", "prometheus"},
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("expected %v, got %v", want, got)
+	}
+}
+
+// TestProfileServices_NoProfiles tests that a config without profiles yields an empty map
+func TestProfileServices_NoProfiles(t *testing.T) {
+	cfg := &Config{
+		Services: map[string]Service{
+			"api": {Image: "node:18"},
+		},
+	}
+
+	if got := cfg.ProfileServices(); len(got) != 0 {
+		t.Errorf("expected no profiles, got %v", got)
+	}
+}
+
+// TestProfileServices_DuplicateAndEmpty tests that repeated and empty profile names are ignored
+func TestProfileServices_DuplicateAndEmpty(t *testing.T) {
+	cfg := &Config{
+		Services: map[string]Service{
+			"api": {Image: "node:18", Profiles: []string{"debug", "", "debug"}},
+		},
+	}
+
+	want := map[string][]string{"debug": {"api"}}
+	if got := cfg.ProfileServices(); !reflect.DeepEqual(got, want) {
+		t.Errorf("expected %v, got %v", want, got)
+	}
+}
+
+// TestProfileNames_Sorted tests that profile names are returned in sorted order
+func TestProfileNames_Sorted(t *testing.T) {
+	cfg := &Config{
+		Services: map[string]Service{
+			"a": {Image: "x", Profiles: []string{"zeta"}},
+			"b": {Image: "x", Profiles: []string{"alpha", "mid"}},
+		},
+	}
+
+	want := []string{"alpha", "mid", "zeta"}
+	if got := cfg.ProfileNames(); !reflect.DeepEqual(got, want) {
+		t.Errorf("expected %v, got %v", want, got)
+	}
+}
+
+// TestServicesInProfiles_UnionOfProfiles tests that services from every given profile are returned once, sorted
+func TestServicesInProfiles_UnionOfProfiles(t *testing.T) {
+	cfg := &Config{
+		Services: map[string]Service{
+			"api":        {Image: "node:18"},
+			"debugger":   {Image: "delve:latest", Profiles: []string{"debug"}},
+			"grafana":    {Image: "grafana:latest", Profiles: []string{"monitoring"}},
+			"prometheus": {Image: "prom:latest", Profiles: []string{"monitoring", "debug"}},
+		},
+	}
+
+	want := []string{"debugger", "grafana", "prometheus"}
+	if got := cfg.ServicesInProfiles([]string{"monitoring", "debug"}); !reflect.DeepEqual(got, want) {
+		t.Errorf("expected %v, got %v", want, got)
+	}
+
+	if got := cfg.ServicesInProfiles([]string{"missing"}); len(got) != 0 {
+		t.Errorf("expected no services for an unknown profile, got %v", got)
+	}
+}
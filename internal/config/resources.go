@@ -0,0 +1,75 @@
+package config
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/docker/go-units"
+)
+
+// ============================================================================
+// Type Definitions
+// ============================================================================
+
+// Resources caps how much CPU and memory a service's container may use
+type Resources struct {
+	CPUs   string `yaml:"cpus,omitempty"`   // Number of CPUs, fractional allowed (e.g., "0.5", "2")
+	Memory string `yaml:"memory,omitempty"` // Memory limit with an optional unit (e.g., "512m", "1g")
+}
+
+// ============================================================================
+// Public API
+// ============================================================================
+
+// ParseCPUs converts a CPU count like "1.5" to Docker's NanoCPUs (1.5 CPUs = 1_500_000_000)
+// An empty value means no limit and returns 0
+func ParseCPUs(value string) (int64, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return 0, nil
+	}
+
+	// NaN and Inf parse as floats but have no NanoCPUs value, and a tiny count would round to no limit
+	cpus, err := strconv.ParseFloat(value, 64)
+	if err != nil || math.IsNaN(cpus) || cpus <= 0 {
+		return 0, fmt.Errorf("invalid cpus '%s', expected a positive number", value)
+	}
+	nanoCPUs := cpus * 1e9
+	if nanoCPUs < 1 || nanoCPUs >= math.MaxInt64 {
+		return 0, fmt.Errorf("invalid cpus '%s', expected a number between 0.000000001 and %d", value, math.MaxInt64/1_000_000_000)
+	}
+	return int64(nanoCPUs), nil
+}
+
+// ParseMemory converts a memory size like "512m" or "1g" to bytes (binary units, like docker run)
+// An empty value means no limit and returns 0
+func ParseMemory(value string) (int64, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return 0, nil
+	}
+
+	bytes, err := units.RAMInBytes(value)
+	if err != nil || bytes <= 0 {
+		return 0, fmt.Errorf("invalid memory '%s', expected a positive size", value)
+	}
+	return bytes, nil
+}
+
+// NanoCPUs returns the CPU limit in Docker's NanoCPUs (0 when unset, including on a nil Resources)
+func (r *Resources) NanoCPUs() (int64, error) {
+	if r == nil {
+		return 0, nil
+	}
+	return ParseCPUs(r.CPUs)
+}
+
+// MemoryBytes returns the memory limit in bytes (0 when unset, including on a nil Resources)
+func (r *Resources) MemoryBytes() (int64, error) {
+	if r == nil {
+		return 0, nil
+	}
+	return ParseMemory(r.Memory)
+}
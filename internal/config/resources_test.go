@@ -0,0 +1,141 @@
+package config
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/ork-cli/ork/pkg/utils"
+)
+
+// TestParseMemory tests memory sizes are converted to bytes
+func TestParseMemory(t *testing.T) {
+	tests := []struct {
+		value string
+		want  int64
+	}{
+		{value: "", want: 0},
+		{value: "512m", want: 512 * 1024 * 1024},
+		{value: "512M", want: 512 * 1024 * 1024},
+		{value: "1g", want: 1024 * 1024 * 1024},
+		{value: "1.5g", want: 1536 * 1024 * 1024},
+		{value: "256k", want: 256 * 1024},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			got, err := ParseMemory(tt.value)
+			if err != nil {
+				t.Fatalf("expected no error, got: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("ParseMemory(%q) = %d, want %d", tt.value, got, tt.want)
+			}
+		})
+	}
+}
+
+// TestParseMemory_Invalid tests garbage memory sizes are rejected
+func TestParseMemory_Invalid(t *testing.T) {
+	for _, value := range []string{"512x", "lots", "-1g", "0"} {
+		if _, err := ParseMemory(value); err == nil {
+			t.Errorf("expected error for memory %q, got nil", value)
+		}
+	}
+}
+
+// TestParseCPUs tests CPU counts are converted to NanoCPUs
+func TestParseCPUs(t *testing.T) {
+	tests := []struct {
+		value string
+		want  int64
+	}{
+		{value: "", want: 0},
+		{value: "0.5", want: 500_000_000},
+		{value: "1.5", want: 1_500_000_000},
+		{value: "2", want: 2_000_000_000},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			got, err := ParseCPUs(tt.value)
+			if err != nil {
+				t.Fatalf("expected no error, got: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("ParseCPUs(%q) = %d, want %d", tt.value, got, tt.want)
+			}
+		})
+	}
+}
+
+// TestParseCPUs_Invalid tests non-positive, non-finite, out-of-range, and non-numeric CPU counts are rejected
+func TestParseCPUs_Invalid(t *testing.T) {
+	for _, value := range []string{"half", "0", "-1", "1.5x", "NaN", "nan", "Inf", "+Inf", "-Inf", "infinity", "1e-12", "1e10"} {
+		if _, err := ParseCPUs(value); err == nil {
+			t.Errorf("expected error for cpus %q, got nil", value)
+		}
+	}
+}
+
+// TestResources_NilIsUnlimited tests a service without resources has no limits
+func TestResources_NilIsUnlimited(t *testing.T) {
+	var resources *Resources
+
+	cpus, err := resources.NanoCPUs()
+	if err != nil || cpus != 0 {
+		t.Errorf("expected 0 CPUs and no error, got %d, %v", cpus, err)
+	}
+	memory, err := resources.MemoryBytes()
+	if err != nil || memory != 0 {
+		t.Errorf("expected 0 bytes and no error, got %d, %v", memory, err)
+	}
+}
+
+// TestValidate_InvalidResources tests the validator rejects garbage limits with a hint
+func TestValidate_InvalidResources(t *testing.T) {
+	tests := []struct {
+		name      string
+		resources *Resources
+		wantErr   string
+		wantHint  string
+	}{
+		{name: "memory", resources: &Resources{Memory: "512x"}, wantErr: "invalid memory '512x'", wantHint: "512m"},
+		{name: "cpus", resources: &Resources{CPUs: "lots"}, wantErr: "invalid cpus 'lots'", wantHint: "0.5"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := &Config{
+				Version:  "1.0",
+				Project:  "shop",
+				Services: map[string]Service{"api": {Image: "node:18", Resources: tt.resources}},
+			}
+
+			err := cfg.Validate()
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("expected error containing %q, got: %v", tt.wantErr, err)
+			}
+			var orkErr *utils.OrkError
+			if !errors.As(err, &orkErr) || orkErr.Kind != utils.ErrorValidation {
+				t.Fatalf("expected a validation error, got: %T", err)
+			}
+			if !strings.Contains(orkErr.Hint, tt.wantHint) {
+				t.Errorf("expected hint mentioning %q, got: %q", tt.wantHint, orkErr.Hint)
+			}
+		})
+	}
+}
+
+// TestValidate_ValidResources tests well-formed limits pass validation
+func TestValidate_ValidResources(t *testing.T) {
+	cfg := &Config{
+		Version:  "1.0",
+		Project:  "shop",
+		Services: map[string]Service{"api": {Image: "node:18", Resources: &Resources{CPUs: "1.5", Memory: "1g"}}},
+	}
+
+	if err := cfg.Validate(); err != nil {
+		t.Errorf("expected no error, got: %v", err)
+	}
+}
@@ -0,0 +1,57 @@
+package config
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ============================================================================
+// Type Definitions
+// ============================================================================
+
+// Restart policies (matching Docker's --restart values)
+const (
+	RestartNo            = "no"             // Never restart (default)
+	RestartAlways        = "always"         // Always restart, including after the daemon restarts
+	RestartOnFailure     = "on-failure"     // Restart only on a non-zero exit, optionally capped as on-failure:<n>
+	RestartUnlessStopped = "unless-stopped" // Like always, but not if the container was stopped by hand
+)
+
+// RestartPolicy is a parsed restart policy
+type RestartPolicy struct {
+	Name       string // One of the Restart* constants
+	MaxRetries int    // Max restart attempts (on-failure only, 0 means unlimited)
+}
+
+// ============================================================================
+// Public API
+// ============================================================================
+
+// ParseRestartPolicy parses a restart value like "always" or "on-failure:5"
+// An empty value means "no"
+func ParseRestartPolicy(value string) (RestartPolicy, error) {
+	name, retries, hasRetries := strings.Cut(strings.TrimSpace(value), ":")
+
+	switch name {
+	case "":
+		return RestartPolicy{Name: RestartNo}, nil
+	case RestartNo, RestartAlways, RestartUnlessStopped:
+		if hasRetries {
+			return RestartPolicy{}, fmt.Errorf("restart policy '%s' does not take a retry count", name)
+		}
+		return RestartPolicy{Name: name}, nil
+	case RestartOnFailure:
+		policy := RestartPolicy{Name: name}
+		if hasRetries {
+			count, err := strconv.Atoi(retries)
+			if err != nil || count < 0 {
+				return RestartPolicy{}, fmt.Errorf("invalid max retries '%s' in restart policy, expected a non-negative number", retries)
+			}
+			policy.MaxRetries = count
+		}
+		return policy, nil
+	default:
+		return RestartPolicy{}, fmt.Errorf("invalid restart policy '%s', expected one of: no, always, on-failure[:max-retries], unless-stopped", value)
+	}
+}
@@ -0,0 +1,76 @@
+package config
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// ============================================================================
+// Type Definitions
+// ============================================================================
+
+// DefaultStopTimeout is how long a container gets to shut down gracefully before it is killed
+const DefaultStopTimeout = 10 * time.Second
+
+// ============================================================================
+// Public API
+// ============================================================================
+
+// ParseStopTimeout parses a stop timeout like "30s" or "2m"
+// An empty value means the default; "0s" kills the container right away
+func ParseStopTimeout(value string) (time.Duration, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return DefaultStopTimeout, nil
+	}
+
+	timeout, err := time.ParseDuration(value)
+	if err != nil || timeout < 0 {
+		return 0, fmt.Errorf("invalid stop_timeout '%s', expected a non-negative duration like \"30s\" or \"2m\"", value)
+	}
+	return timeout, nil
+}
+
+// ParseStopGracePeriod parses a stop grace period like "30s" or "2m"
+// An empty value (or "0s") means no grace period: the container is stopped right away
+func ParseStopGracePeriod(value string) (time.Duration, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return 0, nil
+	}
+
+	grace, err := time.ParseDuration(value)
+	if err != nil || grace < 0 {
+		return 0, fmt.Errorf("invalid stop_grace_period '%s', expected a non-negative duration like \"30s\" or \"2m\"", value)
+	}
+	return grace, nil
+}
+
+// ResolveStopTimeout returns how long the service gets between the stop signal and SIGKILL
+// A non-nil override (the --timeout flag) wins over the service's stop_timeout, which wins over the default
+func ResolveStopTimeout(override *time.Duration, service Service) time.Duration {
+	if override != nil {
+		return *override
+	}
+
+	timeout, err := ParseStopTimeout(service.StopTimeout)
+	if err != nil {
+		return DefaultStopTimeout
+	}
+	return timeout
+}
+
+// ResolveStopGracePeriod returns how long to wait for the service to exit after its stop signal, before its stop timeout starts
+// A non-nil override (the --timeout flag) replaces the whole shutdown window, so it skips the grace period
+func ResolveStopGracePeriod(override *time.Duration, service Service) time.Duration {
+	if override != nil {
+		return 0
+	}
+
+	grace, err := ParseStopGracePeriod(service.StopGracePeriod)
+	if err != nil {
+		return 0
+	}
+	return grace
+}
@@ -0,0 +1,105 @@
+package config
+
+import (
+	"testing"
+	"time"
+)
+
+// TestResolveStopTimeout tests the --timeout flag wins over stop_timeout, which wins over the default
+func TestResolveStopTimeout(t *testing.T) {
+	flag := 2 * time.Second
+	immediate := time.Duration(0)
+
+	tests := []struct {
+		name     string
+		override *time.Duration
+		service  Service
+		want     time.Duration
+	}{
+		{name: "default", service: Service{}, want: DefaultStopTimeout},
+		{name: "service config", service: Service{StopTimeout: "45s"}, want: 45 * time.Second},
+		{name: "flag over service config", override: &flag, service: Service{StopTimeout: "45s"}, want: 2 * time.Second},
+		{name: "flag over default", override: &flag, service: Service{}, want: 2 * time.Second},
+		{name: "zero flag kills immediately", override: &immediate, service: Service{StopTimeout: "45s"}, want: 0},
+		{name: "invalid service config falls back to default", service: Service{StopTimeout: "soon"}, want: DefaultStopTimeout},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ResolveStopTimeout(tt.override, tt.service); got != tt.want {
+				t.Errorf("ResolveStopTimeout() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestResolveStopGracePeriod tests stop_grace_period applies unless the --timeout flag replaces the shutdown window
+func TestResolveStopGracePeriod(t *testing.T) {
+	flag := 2 * time.Second
+
+	tests := []struct {
+		name     string
+		override *time.Duration
+		service  Service
+		want     time.Duration
+	}{
+		{name: "none by default", service: Service{}, want: 0},
+		{name: "service config", service: Service{StopGracePeriod: "2m"}, want: 2 * time.Minute},
+		{name: "independent of stop_timeout", service: Service{StopTimeout: "5s", StopGracePeriod: "1m"}, want: time.Minute},
+		{name: "flag skips grace period", override: &flag, service: Service{StopGracePeriod: "2m"}, want: 0},
+		{name: "invalid service config falls back to none", service: Service{StopGracePeriod: "soon"}, want: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ResolveStopGracePeriod(tt.override, tt.service); got != tt.want {
+				t.Errorf("ResolveStopGracePeriod() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestParseStopTimeout_Invalid tests malformed and negative stop timeouts are rejected
+func TestParseStopTimeout_Invalid(t *testing.T) {
+	for _, value := range []string{"soon", "30", "-5s"} {
+		t.Run(value, func(t *testing.T) {
+			if _, err := ParseStopTimeout(value); err == nil {
+				t.Errorf("expected error for stop_timeout %q, got nil", value)
+			}
+		})
+	}
+}
+
+// TestValidate_InvalidStopTimeout tests Validate rejects a service with a bad stop_timeout
+func TestValidate_InvalidStopTimeout(t *testing.T) {
+	cfg := &Config{
+		Version: "1.0",
+		Project: "test-project",
+		Services: map[string]Service{
+			"queue": {Image: "rabbitmq:3", StopTimeout: "forever"},
+		},
+	}
+
+	if err := cfg.Validate(); err == nil {
+		t.Fatal("expected error for invalid stop_timeout, got nil")
+	}
+}
+
+// TestValidate_InvalidStopGracePeriod tests Validate rejects a service with a bad stop_grace_period
+func TestValidate_InvalidStopGracePeriod(t *testing.T) {
+	for _, value := range []string{"soon", "30", "-5s"} {
+		t.Run(value, func(t *testing.T) {
+			cfg := &Config{
+				Version: "1.0",
+				Project: "test-project",
+				Services: map[string]Service{
+					"queue": {Image: "rabbitmq:3", StopGracePeriod: value},
+				},
+			}
+
+			if err := cfg.Validate(); err == nil {
+				t.Errorf("expected error for stop_grace_period %q, got nil", value)
+			}
+		})
+	}
+}
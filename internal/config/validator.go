@@ -1,65 +1,173 @@
 package config
 
 import (
+	"errors"
 	"fmt"
+	"slices"
+	"sort"
 	"strings"
+	"time"
+
+	"github.com/distribution/reference"
+	"github.com/docker/go-connections/nat"
+	"github.com/ork-cli/ork/pkg/utils"
 )
 
 // ============================================================================
 // Public API
 // ============================================================================
 
+// ValidateOptions controls how strictly the config is validated
+type ValidateOptions struct {
+	AllowNoServices bool // Accept a config without services (for commands that don't run anything)
+}
+
 // Validate checks if the config is valid and returns helpful error messages
 func (c *Config) Validate() error {
-	// Check required fields
-	if c.Version == "" {
-		return fmt.Errorf("version is required in ork.yml")
+	return c.ValidateWithOptions(ValidateOptions{})
+}
+
+// ValidateWithOptions checks the config like Validate, relaxing the checks set in opts
+func (c *Config) ValidateWithOptions(opts ValidateOptions) error {
+	return c.validate(opts, true).first()
+}
+
+// ValidateAll checks the config like Validate, but reports every problem instead of stopping at the first
+// Services are checked in name order, so the errors come back in a stable order
+func (c *Config) ValidateAll() []error {
+	return c.validate(ValidateOptions{}, false)
+}
+
+// ============================================================================
+// Private Orchestrator
+// ============================================================================
+
+// validationErrors collects validation errors, stopping after the first one when failFast is set
+type validationErrors []error
+
+// first returns the first collected error (nil if there are none)
+func (errs validationErrors) first() error {
+	if len(errs) == 0 {
+		return nil
 	}
+	return errs[0]
+}
 
-	if c.Project == "" {
-		return fmt.Errorf("project name is required in ork.yml")
+// validate runs every config check, returning as soon as one fails when failFast is set
+func (c *Config) validate(opts ValidateOptions, failFast bool) validationErrors {
+	var errs validationErrors
+	add := func(err error) bool {
+		if err != nil {
+			errs = append(errs, err)
+		}
+		return failFast && len(errs) > 0
 	}
 
-	if len(c.Services) == 0 {
-		return fmt.Errorf("at least one service must be defined in ork.yml")
+	// Check required fields
+	if c.Version == "" && add(fmt.Errorf("version is required in ork.yml")) {
+		return errs
 	}
 
-	// Validate each service
-	for name, service := range c.Services {
-		if err := validateService(name, service, c.Services); err != nil {
-			return fmt.Errorf("service '%s': %w", name, err)
-		}
+	if c.Project == "" && add(fmt.Errorf("project name is required in ork.yml")) {
+		return errs
 	}
 
-	return nil
-}
+	if len(c.Services) == 0 && !opts.AllowNoServices && add(fmt.Errorf("at least one service must be defined in ork.yml")) {
+		return errs
+	}
 
-// ============================================================================
-// Private Orchestrator
-// ============================================================================
+	if c.MaxParallel < 0 && add(fmt.Errorf("max_parallel must be positive, got %d", c.MaxParallel)) {
+		return errs
+	}
 
-// validateService validates a single service definition
-// This orchestrates the validation by delegating to specialized validators
-func validateService(name string, service Service, allServices map[string]Service) error {
-	if err := validateServiceSource(service); err != nil {
-		return err
+	// Validate each service
+	names := make([]string, 0, len(c.Services))
+	for name := range c.Services {
+		names = append(names, name)
 	}
+	sort.Strings(names)
 
-	if err := validateBuildConfig(service); err != nil {
-		return err
+	for _, name := range names {
+		for _, check := range serviceChecks(name, c.Services[name], c.Services) {
+			if err := check(); err != nil && add(withServiceName(name, err)) {
+				return errs
+			}
+		}
 	}
 
-	if err := validateDependencies(name, service.DependsOn, allServices); err != nil {
-		return err
+	// Validate project-wide constraints across services
+	if add(validateNoDependencyCycles(c.Services)) {
+		return errs
 	}
 
-	if err := validatePorts(service.Ports); err != nil {
+	add(validateHostPortConflicts(c.Services))
+	return errs
+}
+
+// withServiceName names the service an error belongs to, unless its message already does.
+// Ork errors keep their hint and details so callers can still render them.
+func withServiceName(name string, err error) error {
+	var orkErr *utils.OrkError
+	if !errors.As(err, &orkErr) {
+		return fmt.Errorf("service '%s': %w", name, err)
+	}
+	if strings.Contains(orkErr.Message, "services."+name+".") {
 		return err
 	}
+	named := *orkErr
+	named.Message = fmt.Sprintf("service '%s': %s", name, orkErr.Message)
+	return &named
+}
 
+// validateService validates a single service definition, returning its first problem
+func validateService(name string, service Service, allServices map[string]Service) error {
+	for _, check := range serviceChecks(name, service, allServices) {
+		if err := check(); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
+// serviceChecks returns every check for a single service definition, in the order they're reported
+// Each check delegates to a specialized validator
+func serviceChecks(name string, service Service, allServices map[string]Service) []func() error {
+	return []func() error{
+		func() error { return validateServiceSource(service) },
+		func() error { return validateImageReference(name, service.Image) },
+		func() error { return validateBuildConfig(service) },
+		func() error { return validateDependencies(name, service.DependsOn, allServices) },
+		func() error { return validatePorts(service.Ports) },
+		func() error { return validateHealthCheck(service.Health) },
+		func() error { return validateHealthDurations(name, service.Health) },
+		func() error { return validateHealthPorts(service) },
+		func() error {
+			if service.Health.Disabled() && service.WaitForNativeHealth {
+				return fmt.Errorf("cannot wait for native health when health checks are disabled")
+			}
+			return nil
+		},
+		func() error { return validateVolumes(service.Volumes) },
+		func() error {
+			_, err := ParseRestartPolicy(service.Restart)
+			return err
+		},
+		func() error { return validateResources(service.Resources) },
+		func() error {
+			_, err := ParseStopTimeout(service.StopTimeout)
+			return err
+		},
+		func() error {
+			_, err := ParseStopGracePeriod(service.StopGracePeriod)
+			return err
+		},
+		func() error { return validateWaitFor(service) },
+		func() error { return validateNetworkMode(service) },
+		func() error { return validateAliases(service) },
+		func() error { return validateLabels(service.Labels) },
+	}
+}
+
 // ============================================================================
 // Private Validators - Source
 // ============================================================================
@@ -79,6 +187,107 @@ func validateServiceSource(service Service) error {
 	return nil
 }
 
+// validateImageReference ensures an image parses as [registry/]repository[:tag][@digest]
+// Malformed references get a "did you mean" suggestion when a simple fix makes them valid
+func validateImageReference(serviceName, image string) error {
+	if image == "" {
+		return nil
+	}
+
+	// Interpolation keeps a ${VAR} it can't resolve, which would otherwise be reported as a typo
+	if variable, ok := unresolvedVariable(image); ok {
+		return utils.ErrInvalidConfig(
+			fmt.Sprintf("services.%s.image", serviceName),
+			fmt.Sprintf("'%s' references ${%s}, which is not set - define it in .env next to ork.yml or in the shell environment, or give it a default like ${%s:-latest}", image, variable, variable),
+		)
+	}
+
+	_, err := reference.ParseNormalizedNamed(image)
+	if err == nil {
+		return nil
+	}
+
+	orkErr := utils.ErrInvalidConfig(
+		fmt.Sprintf("services.%s.image", serviceName),
+		fmt.Sprintf("'%s' is not a valid image reference - use [registry/]repository[:tag][@digest], e.g. \"nginx:alpine\" or \"ghcr.io/org/api:1.2\"", image),
+	)
+	orkErr.Err = err
+	orkErr.Details = []string{err.Error()}
+	compact := compactImage(image)
+	orkErr.Suggestions = utils.FindSuggestions(compact[:repositoryEnd(compact)], imageCorrections(image), 3)
+	return orkErr
+}
+
+// unresolvedVariable returns the name of the first ${VAR} reference left in a value
+func unresolvedVariable(value string) (string, bool) {
+	for start := strings.Index(value, "${"); start != -1; {
+		if ref, ok := parseBracedRef(value, start); ok {
+			return ref.name, true
+		}
+		next := strings.Index(value[start+1:], "${")
+		if next == -1 {
+			break
+		}
+		start += 1 + next
+	}
+	return "", false
+}
+
+// imageCorrections returns valid references produced by fixing common typos in an image:
+// stray whitespace, a URL scheme, uppercase repository names, and an empty trailing tag
+func imageCorrections(image string) []string {
+	compact := compactImage(image)
+	fixes := []string{
+		compact,
+		lowercaseRepository(compact),
+		strings.TrimSuffix(compact, ":"),
+		lowercaseRepository(strings.TrimSuffix(compact, ":")),
+	}
+
+	var corrections []string
+	for _, fix := range fixes {
+		if fix == image || fix == "" {
+			continue
+		}
+		if _, err := reference.ParseNormalizedNamed(fix); err != nil {
+			continue
+		}
+		if !slices.Contains(corrections, fix) {
+			corrections = append(corrections, fix)
+		}
+	}
+	return corrections
+}
+
+// compactImage drops whitespace and any URL scheme from an image (e.g., "https://nginx :alpine" -> "nginx:alpine")
+func compactImage(image string) string {
+	compact := strings.Join(strings.Fields(image), "")
+	if _, rest, ok := strings.Cut(compact, "://"); ok {
+		compact = rest
+	}
+	return compact
+}
+
+// lowercaseRepository lowercases everything before the tag or digest, which Docker requires;
+// tags may legitimately contain uppercase letters
+func lowercaseRepository(image string) string {
+	end := repositoryEnd(image)
+	return strings.ToLower(image[:end]) + image[end:]
+}
+
+// repositoryEnd returns where the repository ends and the tag or digest begins
+// A colon before the last "/" is a registry port, not a tag
+func repositoryEnd(image string) int {
+	end := len(image)
+	if at := strings.Index(image, "@"); at >= 0 {
+		end = at
+	}
+	if colon := strings.LastIndex(image[:end], ":"); colon > strings.LastIndex(image[:end], "/") {
+		end = colon
+	}
+	return end
+}
+
 // countSources returns how many sources are configured
 func countSources(service Service) int {
 	count := 0
@@ -100,9 +309,34 @@ func countSources(service Service) int {
 
 // validateBuildConfig ensures build configuration is valid
 func validateBuildConfig(service Service) error {
-	if service.Build != nil && service.Build.Context == "" {
+	if service.Build == nil {
+		return nil
+	}
+	if service.Build.Context == "" {
 		return fmt.Errorf("build.context is required when using build")
 	}
+	if service.Build.Target != nil && strings.TrimSpace(*service.Build.Target) == "" {
+		return fmt.Errorf("build.target cannot be empty when specified")
+	}
+	for _, image := range service.Build.CacheFrom {
+		if _, err := reference.ParseNormalizedNamed(image); err != nil {
+			return fmt.Errorf("build.cache_from entry '%s' is not a valid image reference", image)
+		}
+	}
+	return nil
+}
+
+// ============================================================================
+// Private Validators - Volumes
+// ============================================================================
+
+// validateVolumes ensures all volume specs are well-formed
+func validateVolumes(volumes []string) error {
+	for _, spec := range volumes {
+		if _, err := ParseVolume(spec); err != nil {
+			return fmt.Errorf("invalid volume '%s': %w", spec, err)
+		}
+	}
 	return nil
 }
 
@@ -110,17 +344,88 @@ func validateBuildConfig(service Service) error {
 // Private Validators - Dependencies
 // ============================================================================
 
-// validateDependencies checks that all dependencies exist and no self-dependencies
-func validateDependencies(serviceName string, deps []string, allServices map[string]Service) error {
+// validateDependencies checks that all dependencies exist, no self-dependencies, and conditions are valid
+func validateDependencies(serviceName string, deps DependsOn, allServices map[string]Service) error {
 	for _, dep := range deps {
-		if dep == serviceName {
+		if dep.Service == serviceName {
 			return fmt.Errorf("service cannot depend on itself")
 		}
 
-		if _, exists := allServices[dep]; !exists {
-			return fmt.Errorf("depends_on references unknown service '%s'", dep)
+		target, exists := allServices[dep.Service]
+		if !exists {
+			return fmt.Errorf("depends_on references unknown service '%s'", dep.Service)
+		}
+
+		switch dep.Condition {
+		case "", ConditionServiceStarted:
+		case ConditionServiceHealthy:
+			if target.Health.Kind() == HealthCheckNone && !target.WaitForNativeHealth {
+				return fmt.Errorf("depends_on '%s' requires service_healthy, but '%s' has no health check", dep.Service, dep.Service)
+			}
+		default:
+			return fmt.Errorf("invalid depends_on condition '%s' for '%s', expected '%s' or '%s'",
+				dep.Condition, dep.Service, ConditionServiceStarted, ConditionServiceHealthy)
+		}
+	}
+	return nil
+}
+
+// validateNoDependencyCycles fails fast on depends_on cycles, reporting the cycle in order (a → b → a)
+// Uses a depth-first search, walking services in sorted order so the reported cycle is deterministic
+func validateNoDependencyCycles(services map[string]Service) error {
+	names := make([]string, 0, len(services))
+	for name := range services {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	const (
+		unvisited = iota
+		visiting  // On the current DFS path
+		done      // Fully explored, known to be cycle-free
+	)
+	state := make(map[string]int, len(services))
+	var path []string
+
+	var visit func(name string) []string
+	visit = func(name string) []string {
+		state[name] = visiting
+		path = append(path, name)
+
+		for _, dep := range services[name].DependsOn.Names() {
+			if _, exists := services[dep]; !exists {
+				continue // Reported by validateDependencies
+			}
+
+			switch state[dep] {
+			case visiting:
+				// The cycle starts where dep first appears on the current path
+				for i, onPath := range path {
+					if onPath == dep {
+						return append(append([]string(nil), path[i:]...), dep)
+					}
+				}
+			case unvisited:
+				if cycle := visit(dep); cycle != nil {
+					return cycle
+				}
+			}
+		}
+
+		path = path[:len(path)-1]
+		state[name] = done
+		return nil
+	}
+
+	for _, name := range names {
+		if state[name] != unvisited {
+			continue
+		}
+		if cycle := visit(name); cycle != nil {
+			return utils.ErrCircularDependency(cycle)
 		}
 	}
+
 	return nil
 }
 
@@ -128,12 +433,237 @@ func validateDependencies(serviceName string, deps []string, allServices map[str
 // Private Validators - Ports
 // ============================================================================
 
-// validatePorts ensures port mappings are in the correct format
+// validatePorts ensures port mappings are "host:container[/protocol]" with valid ports
+// Ranges ("3000-3002:4000-4002") must be the same size on both sides, since each host port maps to one container port
 func validatePorts(ports []string) error {
 	for _, port := range ports {
-		if !strings.Contains(port, ":") {
+		if strings.Count(port, ":") != 1 {
 			return fmt.Errorf("invalid port format '%s', expected 'host:container' (e.g., '3000:3000')", port)
 		}
+		if _, err := parsePortSpec(port); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// parsePortSpec parses a "host:container[/protocol]" mapping into one binding per port
+// Only tcp and udp are supported, and port 0 (a random host port) is rejected, since Ork needs to know the port
+func parsePortSpec(port string) ([]nat.PortMapping, error) {
+	mappings, err := nat.ParsePortSpec(port)
+	if err != nil {
+		return nil, fmt.Errorf("invalid port mapping '%s': %w", port, err)
+	}
+
+	for _, mapping := range mappings {
+		if proto := mapping.Port.Proto(); proto != "tcp" && proto != "udp" {
+			return nil, fmt.Errorf("invalid port mapping '%s': unsupported protocol '%s', expected tcp or udp", port, proto)
+		}
+		if strings.Contains(mapping.Binding.HostPort, "-") {
+			return nil, fmt.Errorf("invalid port mapping '%s': a host port range needs a container range of the same size", port)
+		}
+		if mapping.Port.Int() == 0 || mapping.Binding.HostPort == "0" {
+			return nil, fmt.Errorf("invalid port mapping '%s': ports must be between 1 and 65535", port)
+		}
+	}
+	return mappings, nil
+}
+
+// ============================================================================
+// Private Validators - Resources
+// ============================================================================
+
+// validateResources ensures CPU and memory limits parse to positive values
+func validateResources(resources *Resources) error {
+	if _, err := resources.NanoCPUs(); err != nil {
+		validationErr := utils.ValidationError("config.validate", err.Error(), nil)
+		validationErr.Hint = "Set resources.cpus to a positive number of CPUs, e.g. \"0.5\" or \"2\""
+		return validationErr
+	}
+
+	if _, err := resources.MemoryBytes(); err != nil {
+		validationErr := utils.ValidationError("config.validate", err.Error(), nil)
+		validationErr.Hint = "Set resources.memory to a size with a unit, e.g. \"512m\" or \"1g\""
+		return validationErr
+	}
+
+	return nil
+}
+
+// ============================================================================
+// Private Validators - Labels
+// ============================================================================
+
+// reservedLabelPrefix marks labels Ork sets itself to track its containers
+const reservedLabelPrefix = "ork."
+
+// validateLabels ensures custom labels have keys and don't claim Ork's reserved namespace
+func validateLabels(labels map[string]string) error {
+	keys := make([]string, 0, len(labels))
+	for key := range labels {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		if strings.TrimSpace(key) == "" {
+			return fmt.Errorf("label keys cannot be empty")
+		}
+		if strings.HasPrefix(key, reservedLabelPrefix) {
+			validationErr := utils.ValidationError("config.validate", fmt.Sprintf("label '%s' uses the reserved 'ork.' prefix", key), nil)
+			validationErr.Hint = "Ork manages ork.* labels itself - rename the label (e.g., \"com.example.team\")"
+			return validationErr
+		}
+	}
+
+	return nil
+}
+
+// ============================================================================
+// Private Validators - Health Checks
+// ============================================================================
+
+// validateHealthCheck ensures at most one health check type is configured
+func validateHealthCheck(health *HealthCheck) error {
+	if health == nil {
+		return nil
+	}
+
+	if health.Disable && (health.Type != "" || health.Endpoint != "" || len(health.Command) > 0) {
+		return fmt.Errorf("disabled health check cannot specify a type, endpoint, or command")
+	}
+
+	if health.Type != "" && health.Type != HealthCheckHTTP && health.Type != HealthCheckTCP {
+		return fmt.Errorf("invalid health check type '%s', expected 'http' or 'tcp'", health.Type)
+	}
+
+	if health.Endpoint != "" && len(health.Command) > 0 {
+		return fmt.Errorf("health check can only specify one of: endpoint or command")
+	}
+
+	if health.Type == HealthCheckTCP && (health.Endpoint != "" || len(health.Command) > 0) {
+		return fmt.Errorf("tcp health check cannot specify an endpoint or command")
+	}
+
+	if health.Type == HealthCheckHTTP && health.Endpoint == "" {
+		return fmt.Errorf("http health check requires an endpoint (e.g., /health)")
+	}
+
+	if (health.Method != "" || len(health.Headers) > 0) && health.Kind() != HealthCheckHTTP {
+		return fmt.Errorf("health check method and headers require an http endpoint")
+	}
+
+	return nil
+}
+
+// validateHealthPorts ensures http and tcp health checks have a host port to probe
+// Both connect to the service's first published port, so a service without ports can never pass
+func validateHealthPorts(service Service) error {
+	kind := service.Health.Kind()
+	if (kind == HealthCheckHTTP || kind == HealthCheckTCP) && len(service.Ports) == 0 {
+		return fmt.Errorf("%s health check requires a published port, but the service has no ports", kind)
+	}
+	return nil
+}
+
+// validateHealthDurations ensures the health check's interval, timeout, and start period parse as durations
+// Empty values are allowed and fall back to the defaults
+func validateHealthDurations(serviceName string, health *HealthCheck) error {
+	if health == nil {
+		return nil
+	}
+
+	durations := []struct {
+		field string
+		value string
+	}{
+		{"interval", health.Interval},
+		{"timeout", health.Timeout},
+		{"start_period", health.StartPeriod},
+	}
+
+	for _, d := range durations {
+		if d.value == "" {
+			continue
+		}
+		if parsed, err := time.ParseDuration(d.value); err != nil || parsed <= 0 {
+			return utils.ErrInvalidConfig(
+				fmt.Sprintf("services.%s.health.%s", serviceName, d.field),
+				fmt.Sprintf("'%s' is not a valid duration - use a positive value like \"5s\", \"500ms\", or \"1m\"", d.value),
+			)
+		}
+	}
+
+	return nil
+}
+
+// ============================================================================
+// Private Validators - Project-wide
+// ============================================================================
+
+// validateHostPortConflicts ensures no host port is bound more than once across all services
+// Duplicate container-side ports are legal, since each container has its own network namespace
+func validateHostPortConflicts(services map[string]Service) error {
+	// Iterate in a stable order so the reported conflict is deterministic
+	names := make([]string, 0, len(services))
+	for name := range services {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	// Track which service first claimed each host port
+	owners := make(map[string]string)
+	for _, name := range names {
+		var hostPorts []string
+		for _, port := range services[name].Ports {
+			hostPorts = append(hostPorts, expandHostPorts(port)...)
+		}
+
+		for _, hostPort := range hostPorts {
+			owner, taken := owners[hostPort]
+			if !taken {
+				owners[hostPort] = name
+				continue
+			}
+
+			if owner == name {
+				return utils.ValidationError(
+					"config.validate",
+					fmt.Sprintf("service '%s' binds host port %s more than once", name, hostPort),
+					nil,
+				)
+			}
+
+			return utils.ValidationError(
+				"config.validate",
+				fmt.Sprintf("host port %s is bound by both '%s' and '%s'", hostPort, owner, name),
+				nil,
+			)
+		}
 	}
+
 	return nil
 }
+
+// expandHostPorts returns every host port a "host:container" port mapping binds, expanding ranges
+// UDP ports keep a "/udp" suffix, since tcp and udp can share a host port
+// Invalid mappings (reported by validatePorts) bind nothing
+func expandHostPorts(port string) []string {
+	if strings.Count(port, ":") != 1 {
+		return nil
+	}
+	mappings, err := parsePortSpec(port)
+	if err != nil {
+		return nil
+	}
+
+	hostPorts := make([]string, 0, len(mappings))
+	for _, mapping := range mappings {
+		hostPort := mapping.Binding.HostPort
+		if mapping.Port.Proto() == "udp" {
+			hostPort += "/udp"
+		}
+		hostPorts = append(hostPorts, hostPort)
+	}
+	return hostPorts
+}
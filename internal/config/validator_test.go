@@ -1,8 +1,11 @@
 package config
 
 import (
+	"errors"
 	"strings"
 	"testing"
+
+	"github.com/ork-cli/ork/pkg/utils"
 )
 
 // TestValidate_Success tests that a valid config passes validation
@@ -80,6 +83,25 @@ func TestValidate_NoServices(t *testing.T) {
 	}
 }
 
+// TestValidateWithOptions_AllowNoServices tests that an empty services map can be accepted
+func TestValidateWithOptions_AllowNoServices(t *testing.T) {
+	cfg := &Config{
+		Version:  "1.0",
+		Project:  "test-project",
+		Services: map[string]Service{},
+	}
+
+	if err := cfg.ValidateWithOptions(ValidateOptions{AllowNoServices: true}); err != nil {
+		t.Errorf("expected no error with AllowNoServices, got: %v", err)
+	}
+
+	// The other required fields are still checked
+	cfg.Project = ""
+	if err := cfg.ValidateWithOptions(ValidateOptions{AllowNoServices: true}); err == nil {
+		t.Error("expected error for missing project, got nil")
+	}
+}
+
 // TestValidateServiceSource_NoSource tests that service with no source fails
 func TestValidateServiceSource_NoSource(t *testing.T) {
 	service := Service{
@@ -246,6 +268,114 @@ func TestCountSources(t *testing.T) {
 	}
 }
 
+// TestValidateImageReference_Valid tests that well-formed image references pass
+func TestValidateImageReference_Valid(t *testing.T) {
+	images := []string{
+		"nginx",
+		"nginx:alpine",
+		"library/nginx:1.25",
+		"bitnami/redis:7.2-debian-12",
+		"nginx@sha256:" + strings.Repeat("a", 64),
+		"nginx:alpine@sha256:" + strings.Repeat("b", 64),
+		"ghcr.io/org/api:1.2",
+		"localhost:5000/app",
+		"registry.example.com:8443/team/app:v1.0.0-RC1",
+	}
+
+	for _, image := range images {
+		t.Run(image, func(t *testing.T) {
+			if err := validateImageReference("web", image); err != nil {
+				t.Errorf("expected %q to be valid, got: %v", image, err)
+			}
+		})
+	}
+}
+
+// TestValidateImageReference_Malformed tests that malformed references fail with a suggestion when one is obvious
+func TestValidateImageReference_Malformed(t *testing.T) {
+	tests := []struct {
+		image          string
+		wantSuggestion string
+	}{
+		{image: "nginx :alpine", wantSuggestion: "nginx:alpine"},
+		{image: "UPPERCASE/Repo", wantSuggestion: "uppercase/repo"},
+		{image: "Nginx:Alpine", wantSuggestion: "nginx:Alpine"},
+		{image: "https://ghcr.io/org/api:1.2", wantSuggestion: "ghcr.io/org/api:1.2"},
+		{image: "nginx:", wantSuggestion: "nginx"},
+		{image: "nginx@sha256:abc"},
+		{image: "nginx:alpine:latest"},
+		{image: "-nginx"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.image, func(t *testing.T) {
+			err := validateImageReference("web", tt.image)
+			if err == nil {
+				t.Fatalf("expected %q to be rejected", tt.image)
+			}
+			if !utils.IsKind(err, utils.ErrorValidation) {
+				t.Errorf("expected validation error, got: %v", err)
+			}
+			if !strings.Contains(err.Error(), "services.web.image") {
+				t.Errorf("expected error naming the service and field, got: %v", err)
+			}
+
+			orkErr := err.(*utils.OrkError)
+			if !strings.Contains(orkErr.Hint, tt.image) {
+				t.Errorf("expected hint to quote the image, got: %q", orkErr.Hint)
+			}
+			if tt.wantSuggestion == "" {
+				if len(orkErr.Suggestions) != 0 {
+					t.Errorf("expected no suggestions, got: %v", orkErr.Suggestions)
+				}
+				return
+			}
+			if len(orkErr.Suggestions) == 0 || orkErr.Suggestions[0] != tt.wantSuggestion {
+				t.Errorf("expected suggestion %q, got: %v", tt.wantSuggestion, orkErr.Suggestions)
+			}
+		})
+	}
+}
+
+// TestValidateImageReference_UnresolvedVariable tests that a ${VAR} left by interpolation is named, not treated as a typo
+func TestValidateImageReference_UnresolvedVariable(t *testing.T) {
+	for _, image := range []string{"${API_TAG}", "ghcr.io/org/api:${API_TAG}", "api:${1X}-${API_TAG}"} {
+		t.Run(image, func(t *testing.T) {
+			err := validateImageReference("api", image)
+			if err == nil {
+				t.Fatalf("expected %q to be rejected", image)
+			}
+
+			orkErr := err.(*utils.OrkError)
+			if !strings.Contains(orkErr.Hint, "${API_TAG}, which is not set") {
+				t.Errorf("expected hint about the unset variable, got: %q", orkErr.Hint)
+			}
+			if len(orkErr.Suggestions) != 0 {
+				t.Errorf("expected no typo suggestions, got: %v", orkErr.Suggestions)
+			}
+		})
+	}
+}
+
+// TestValidate_InvalidImage tests that a malformed image fails the whole config
+func TestValidate_InvalidImage(t *testing.T) {
+	cfg := &Config{
+		Version: "1.0",
+		Project: "test-project",
+		Services: map[string]Service{
+			"web": {Image: "nginx :alpine"},
+		},
+	}
+
+	err := cfg.Validate()
+	if err == nil {
+		t.Fatal("expected error for invalid image, got nil")
+	}
+	if !strings.Contains(err.Error(), "services.web.image") {
+		t.Errorf("expected error naming the service and field, got: %v", err)
+	}
+}
+
 // TestValidateBuildConfig_MissingContext tests build without context fails
 func TestValidateBuildConfig_MissingContext(t *testing.T) {
 	service := Service{
@@ -291,13 +421,85 @@ func TestValidateBuildConfig_NoBuildSection(t *testing.T) {
 	}
 }
 
+// TestValidateBuildConfig_EmptyTarget tests an explicitly empty build target fails
+func TestValidateBuildConfig_EmptyTarget(t *testing.T) {
+	target := ""
+	service := Service{
+		Build: &Build{
+			Context: "./app",
+			Target:  &target,
+		},
+	}
+
+	err := validateBuildConfig(service)
+	if err == nil {
+		t.Fatal("expected error for empty build target, got nil")
+	}
+
+	if !strings.Contains(err.Error(), "build.target cannot be empty") {
+		t.Errorf("expected 'build.target cannot be empty' error, got: %v", err)
+	}
+}
+
+// TestValidateBuildConfig_ValidTarget tests a named build target passes
+func TestValidateBuildConfig_ValidTarget(t *testing.T) {
+	target := "builder"
+	service := Service{
+		Build: &Build{
+			Context: "./app",
+			Target:  &target,
+		},
+	}
+
+	err := validateBuildConfig(service)
+	if err != nil {
+		t.Errorf("expected no error for valid build target, got: %v", err)
+	}
+}
+
+// TestValidateBuildConfig_CacheFrom tests cache_from entries must be image references
+func TestValidateBuildConfig_CacheFrom(t *testing.T) {
+	tests := []struct {
+		name      string
+		cacheFrom []string
+		wantErr   bool
+	}{
+		{name: "short name", cacheFrom: []string{"node"}},
+		{name: "registry with tag", cacheFrom: []string{"ghcr.io/org/api:latest"}},
+		{name: "registry with port and digest", cacheFrom: []string{"localhost:5000/api@sha256:" + strings.Repeat("a", 64)}},
+		{name: "uppercase", cacheFrom: []string{"Org/API"}, wantErr: true},
+		{name: "empty entry", cacheFrom: []string{"node", ""}, wantErr: true},
+		{name: "whitespace", cacheFrom: []string{"my image"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service := Service{
+				Build: &Build{
+					Context:   "./app",
+					CacheFrom: tt.cacheFrom,
+				},
+			}
+
+			err := validateBuildConfig(service)
+			if tt.wantErr {
+				if err == nil || !strings.Contains(err.Error(), "not a valid image reference") {
+					t.Errorf("expected invalid image reference error, got: %v", err)
+				}
+			} else if err != nil {
+				t.Errorf("expected no error, got: %v", err)
+			}
+		})
+	}
+}
+
 // TestValidateDependencies_UnknownService tests reference to an unknown service fails
 func TestValidateDependencies_UnknownService(t *testing.T) {
 	allServices := map[string]Service{
 		"api": {Image: "node:18"},
 	}
 
-	err := validateDependencies("frontend", []string{"api", "postgres"}, allServices)
+	err := validateDependencies("frontend", DependsOnServices("api", "postgres"), allServices)
 	if err == nil {
 		t.Fatal("expected error for unknown dependency, got nil")
 	}
@@ -313,7 +515,7 @@ func TestValidateDependencies_SelfDependency(t *testing.T) {
 		"api": {Image: "node:18"},
 	}
 
-	err := validateDependencies("api", []string{"api"}, allServices)
+	err := validateDependencies("api", DependsOnServices("api"), allServices)
 	if err == nil {
 		t.Fatal("expected error for self-dependency, got nil")
 	}
@@ -331,19 +533,105 @@ func TestValidateDependencies_ValidDependencies(t *testing.T) {
 		"postgres": {Image: "postgres:15"},
 	}
 
-	err := validateDependencies("frontend", []string{"api", "postgres"}, allServices)
+	err := validateDependencies("frontend", DependsOnServices("api", "postgres"), allServices)
 	if err != nil {
 		t.Errorf("expected no error for valid dependencies, got: %v", err)
 	}
 }
 
+// TestValidateNoDependencyCycles tests that cycles are reported with their full ordered path
+func TestValidateNoDependencyCycles(t *testing.T) {
+	tests := []struct {
+		name      string
+		services  map[string]Service
+		wantCycle string // Empty when no cycle is expected
+	}{
+		{
+			name: "no cycle",
+			services: map[string]Service{
+				"api":      {Image: "node:18", DependsOn: DependsOnServices("postgres", "redis")},
+				"worker":   {Image: "node:18", DependsOn: DependsOnServices("postgres")},
+				"postgres": {Image: "postgres:15"},
+				"redis":    {Image: "redis:7"},
+			},
+		},
+		{
+			name: "two services",
+			services: map[string]Service{
+				"a": {Image: "busybox", DependsOn: DependsOnServices("b")},
+				"b": {Image: "busybox", DependsOn: DependsOnServices("a")},
+			},
+			wantCycle: "a → b → a",
+		},
+		{
+			name: "three services",
+			services: map[string]Service{
+				"a": {Image: "busybox", DependsOn: DependsOnServices("b")},
+				"b": {Image: "busybox", DependsOn: DependsOnServices("c")},
+				"c": {Image: "busybox", DependsOn: DependsOnServices("a")},
+			},
+			wantCycle: "a → b → c → a",
+		},
+		{
+			name: "cycle reached through a non-cycle service",
+			services: map[string]Service{
+				"api":   {Image: "busybox", DependsOn: DependsOnServices("cache")},
+				"cache": {Image: "busybox", DependsOn: DependsOnServices("db")},
+				"db":    {Image: "busybox", DependsOn: DependsOnServices("cache")},
+			},
+			wantCycle: "cache → db → cache",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateNoDependencyCycles(tt.services)
+			if tt.wantCycle == "" {
+				if err != nil {
+					t.Errorf("expected no error, got: %v", err)
+				}
+				return
+			}
+
+			orkErr, ok := err.(*utils.OrkError)
+			if !ok {
+				t.Fatalf("expected an OrkError, got: %v", err)
+			}
+			want := "Dependency cycle: " + tt.wantCycle
+			if len(orkErr.Details) == 0 || orkErr.Details[0] != want {
+				t.Errorf("expected details %q, got: %v", want, orkErr.Details)
+			}
+		})
+	}
+}
+
+// TestValidate_DependencyCycle tests that Validate fails fast on a two-hop cycle
+func TestValidate_DependencyCycle(t *testing.T) {
+	cfg := &Config{
+		Version: "1.0",
+		Project: "test-project",
+		Services: map[string]Service{
+			"api": {Image: "node:18", DependsOn: DependsOnServices("web")},
+			"web": {Image: "nginx", DependsOn: DependsOnServices("api")},
+		},
+	}
+
+	err := cfg.Validate()
+	if err == nil {
+		t.Fatal("expected error for circular dependency, got nil")
+	}
+	if !strings.Contains(err.Error(), "Circular dependency detected") {
+		t.Errorf("expected circular dependency error, got: %v", err)
+	}
+}
+
 // TestValidateDependencies_NoDependencies tests empty dependencies pass
 func TestValidateDependencies_NoDependencies(t *testing.T) {
 	allServices := map[string]Service{
 		"api": {Image: "node:18"},
 	}
 
-	err := validateDependencies("api", []string{}, allServices)
+	err := validateDependencies("api", DependsOn{}, allServices)
 	if err != nil {
 		t.Errorf("expected no error for no dependencies, got: %v", err)
 	}
@@ -381,6 +669,18 @@ func TestValidatePorts_ValidFormats(t *testing.T) {
 			name:  "multiple ports",
 			ports: []string{"8080:8080", "3000:3000"},
 		},
+		{
+			name:  "protocols",
+			ports: []string{"53:53/udp", "8080:80/tcp"},
+		},
+		{
+			name:  "range",
+			ports: []string{"3000-3002:4000-4002"},
+		},
+		{
+			name:  "highest port",
+			ports: []string{"65535:65535"},
+		},
 	}
 
 	for _, tt := range tests {
@@ -393,6 +693,39 @@ func TestValidatePorts_ValidFormats(t *testing.T) {
 	}
 }
 
+// TestValidatePorts_InvalidMappings tests port numbers, ranges, and protocols are checked
+func TestValidatePorts_InvalidMappings(t *testing.T) {
+	tests := []struct {
+		name    string
+		port    string
+		wantErr string
+	}{
+		{name: "host ip", port: "127.0.0.1:8080:80", wantErr: "expected 'host:container'"},
+		{name: "non-numeric host port", port: "http:80", wantErr: "invalid hostPort"},
+		{name: "non-numeric container port", port: "8080:web", wantErr: "invalid containerPort"},
+		{name: "port out of range", port: "70000:80", wantErr: "invalid hostPort"},
+		{name: "port zero", port: "0:80", wantErr: "between 1 and 65535"},
+		{name: "empty container port", port: "8080:", wantErr: "invalid port mapping"},
+		{name: "unknown protocol", port: "8080:80/http", wantErr: "invalid proto"},
+		{name: "sctp", port: "8080:80/sctp", wantErr: "unsupported protocol 'sctp'"},
+		{name: "reversed range", port: "3002-3000:3002-3000", wantErr: "invalid"},
+		{name: "mismatched ranges", port: "3000-3002:4000-4001", wantErr: "invalid ranges"},
+		{name: "host range to single port", port: "3000-3002:80", wantErr: "same size"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validatePorts([]string{tt.port})
+			if err == nil {
+				t.Fatalf("expected error for port %q, got nil", tt.port)
+			}
+			if !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("expected error containing %q, got: %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
 // TestValidatePorts_EmptyList tests empty port list passes
 func TestValidatePorts_EmptyList(t *testing.T) {
 	err := validatePorts([]string{})
@@ -400,3 +733,565 @@ func TestValidatePorts_EmptyList(t *testing.T) {
 		t.Errorf("expected no error for empty ports, got: %v", err)
 	}
 }
+
+// TestValidateHostPortConflicts_NoConflicts tests distinct host ports pass, even with shared container ports
+func TestValidateHostPortConflicts_NoConflicts(t *testing.T) {
+	services := map[string]Service{
+		"api":      {Image: "node:18", Ports: []string{"8080:80"}},
+		"frontend": {Image: "nginx:alpine", Ports: []string{"3000:80"}},
+		"worker":   {Image: "node:18"},
+	}
+
+	err := validateHostPortConflicts(services)
+	if err != nil {
+		t.Errorf("expected no error for distinct host ports, got: %v", err)
+	}
+}
+
+// TestValidateHostPortConflicts_TwoServices tests two services sharing a host port fail
+func TestValidateHostPortConflicts_TwoServices(t *testing.T) {
+	services := map[string]Service{
+		"api":      {Image: "node:18", Ports: []string{"8080:8080"}},
+		"frontend": {Image: "nginx:alpine", Ports: []string{"8080:80"}},
+	}
+
+	err := validateHostPortConflicts(services)
+	if err == nil {
+		t.Fatal("expected error for conflicting host ports, got nil")
+	}
+
+	if !strings.Contains(err.Error(), "host port 8080 is bound by both 'api' and 'frontend'") {
+		t.Errorf("expected conflict error naming both services, got: %v", err)
+	}
+
+	if !utils.IsKind(err, utils.ErrorValidation) {
+		t.Errorf("expected a validation error, got: %T", err)
+	}
+}
+
+// TestValidateHostPortConflicts_SameService tests a service listing the same host port twice fails
+func TestValidateHostPortConflicts_SameService(t *testing.T) {
+	services := map[string]Service{
+		"api": {Image: "node:18", Ports: []string{"8080:80", "8080:8080"}},
+	}
+
+	err := validateHostPortConflicts(services)
+	if err == nil {
+		t.Fatal("expected error for duplicate host port in one service, got nil")
+	}
+
+	if !strings.Contains(err.Error(), "service 'api' binds host port 8080 more than once") {
+		t.Errorf("expected self-conflict error, got: %v", err)
+	}
+}
+
+// TestValidateHostPortConflicts_TCPAndUDP tests the same host port may be bound once per protocol
+func TestValidateHostPortConflicts_TCPAndUDP(t *testing.T) {
+	services := map[string]Service{
+		"dns": {Image: "coredns/coredns", Ports: []string{"53:53/tcp", "53:53/udp"}},
+	}
+
+	if err := validateHostPortConflicts(services); err != nil {
+		t.Errorf("expected no error for tcp and udp on the same host port, got: %v", err)
+	}
+}
+
+// TestValidateHostPortConflicts_Ranges tests conflicts are found inside expanded port ranges
+func TestValidateHostPortConflicts_Ranges(t *testing.T) {
+	tests := []struct {
+		name     string
+		services map[string]Service
+		wantErr  string
+	}{
+		{
+			name: "range overlaps single port",
+			services: map[string]Service{
+				"api":    {Image: "node:18", Ports: []string{"3000-3005:3000-3005"}},
+				"worker": {Image: "node:18", Ports: []string{"3003:80"}},
+			},
+			wantErr: "host port 3003 is bound by both 'api' and 'worker'",
+		},
+		{
+			name: "overlapping ranges in one service",
+			services: map[string]Service{
+				"api": {Image: "node:18", Ports: []string{"3000-3002:3000-3002", "3002-3004:4000-4002"}},
+			},
+			wantErr: "service 'api' binds host port 3002 more than once",
+		},
+		{
+			name: "adjacent ranges",
+			services: map[string]Service{
+				"api":    {Image: "node:18", Ports: []string{"3000-3002:3000-3002"}},
+				"worker": {Image: "node:18", Ports: []string{"3003-3005:3000-3002"}},
+			},
+		},
+		{
+			name: "udp range beside tcp port",
+			services: map[string]Service{
+				"dns": {Image: "coredns/coredns", Ports: []string{"53-54:53-54/udp", "53:53"}},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateHostPortConflicts(tt.services)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("expected no error, got: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("expected error containing %q, got: %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+// TestValidate_HostPortConflict tests that Validate reports cross-service port conflicts
+func TestValidate_HostPortConflict(t *testing.T) {
+	cfg := &Config{
+		Version: "1.0",
+		Project: "test-project",
+		Services: map[string]Service{
+			"api": {Image: "node:18", Ports: []string{"5432:5432"}},
+			"db":  {Image: "postgres:15", Ports: []string{"5432:5432"}},
+		},
+	}
+
+	err := cfg.Validate()
+	if err == nil {
+		t.Fatal("expected error for conflicting host ports, got nil")
+	}
+
+	if !strings.Contains(err.Error(), "host port 5432") {
+		t.Errorf("expected host port conflict error, got: %v", err)
+	}
+}
+
+// TestValidateHealthCheck tests health check type precedence validation
+func TestValidateHealthCheck(t *testing.T) {
+	tests := []struct {
+		name    string
+		health  *HealthCheck
+		wantErr bool
+	}{
+		{name: "no health check", health: nil},
+		{name: "endpoint only", health: &HealthCheck{Endpoint: "/health"}},
+		{name: "command only", health: &HealthCheck{Command: []string{"pg_isready"}}},
+		{
+			name:    "endpoint and command",
+			health:  &HealthCheck{Endpoint: "/health", Command: []string{"pg_isready"}},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateHealthCheck(tt.health)
+			if tt.wantErr && err == nil {
+				t.Fatal("expected error, got nil")
+			}
+			if !tt.wantErr && err != nil {
+				t.Errorf("expected no error, got: %v", err)
+			}
+			if tt.wantErr && !strings.Contains(err.Error(), "only specify one of: endpoint or command") {
+				t.Errorf("expected precedence error, got: %v", err)
+			}
+		})
+	}
+}
+
+// TestValidateHealthCheck_Type tests health check type validation
+func TestValidateHealthCheck_Type(t *testing.T) {
+	tests := []struct {
+		name    string
+		health  *HealthCheck
+		wantErr string
+	}{
+		{name: "explicit http", health: &HealthCheck{Type: "http", Endpoint: "/health"}},
+		{name: "tcp", health: &HealthCheck{Type: "tcp"}},
+		{name: "unknown type", health: &HealthCheck{Type: "udp"}, wantErr: "invalid health check type 'udp'"},
+		{name: "tcp with endpoint", health: &HealthCheck{Type: "tcp", Endpoint: "/health"}, wantErr: "tcp health check cannot specify"},
+		{name: "tcp with command", health: &HealthCheck{Type: "tcp", Command: []string{"true"}}, wantErr: "tcp health check cannot specify"},
+		{name: "http without endpoint", health: &HealthCheck{Type: "http"}, wantErr: "http health check requires an endpoint"},
+		{name: "disabled", health: &HealthCheck{Disable: true}},
+		{name: "disabled with endpoint", health: &HealthCheck{Disable: true, Endpoint: "/health"}, wantErr: "disabled health check cannot specify"},
+		{name: "disabled with type", health: &HealthCheck{Disable: true, Type: "tcp"}, wantErr: "disabled health check cannot specify"},
+		{name: "http with method and headers", health: &HealthCheck{Endpoint: "/health", Method: "POST", Headers: map[string]string{"Authorization": "Bearer dev"}}},
+		{name: "method without endpoint", health: &HealthCheck{Method: "POST"}, wantErr: "method and headers require an http endpoint"},
+		{name: "tcp with headers", health: &HealthCheck{Type: "tcp", Headers: map[string]string{"X-Probe": "1"}}, wantErr: "method and headers require an http endpoint"},
+		{name: "command with method", health: &HealthCheck{Command: []string{"true"}, Method: "HEAD"}, wantErr: "method and headers require an http endpoint"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateHealthCheck(tt.health)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("expected no error, got: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("expected error containing %q, got: %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+// TestValidateHealthDurations tests that health check durations must parse
+func TestValidateHealthDurations(t *testing.T) {
+	tests := []struct {
+		name    string
+		health  *HealthCheck
+		wantErr string
+	}{
+		{name: "no health check", health: nil},
+		{name: "empty durations", health: &HealthCheck{Endpoint: "/health"}},
+		{name: "seconds", health: &HealthCheck{Interval: "5s", Timeout: "3s"}},
+		{name: "milliseconds", health: &HealthCheck{Interval: "500ms", Timeout: "250ms"}},
+		{name: "start period", health: &HealthCheck{StartPeriod: "2m"}},
+		{name: "spelled out interval", health: &HealthCheck{Interval: "5 seconds"}, wantErr: "services.api.health.interval"},
+		{name: "garbage timeout", health: &HealthCheck{Interval: "5s", Timeout: "abc"}, wantErr: "services.api.health.timeout"},
+		{name: "missing unit", health: &HealthCheck{Timeout: "3"}, wantErr: "services.api.health.timeout"},
+		{name: "negative interval", health: &HealthCheck{Interval: "-5s"}, wantErr: "services.api.health.interval"},
+		{name: "bad start period", health: &HealthCheck{StartPeriod: "soon"}, wantErr: "services.api.health.start_period"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateHealthDurations("api", tt.health)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("expected no error, got: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("expected error containing %q, got: %v", tt.wantErr, err)
+			}
+			if !utils.IsKind(err, utils.ErrorValidation) {
+				t.Errorf("expected validation error, got: %v", err)
+			}
+		})
+	}
+}
+
+// TestValidate_InvalidHealthInterval tests that a bad duration fails the whole config
+func TestValidate_InvalidHealthInterval(t *testing.T) {
+	cfg := &Config{
+		Version: "1.0",
+		Project: "test-project",
+		Services: map[string]Service{
+			"api": {Image: "node:18", Health: &HealthCheck{Endpoint: "/health", Interval: "5 seconds"}},
+		},
+	}
+
+	err := cfg.Validate()
+	if err == nil {
+		t.Fatal("expected error for invalid interval, got nil")
+	}
+	if !strings.Contains(err.Error(), "services.api.health.interval") {
+		t.Errorf("expected error naming the service and field, got: %v", err)
+	}
+}
+
+// TestHealthCheck_Kind tests which check runs for a given configuration
+func TestHealthCheck_Kind(t *testing.T) {
+	tests := []struct {
+		name   string
+		health *HealthCheck
+		want   string
+	}{
+		{name: "nil", health: nil, want: HealthCheckNone},
+		{name: "timing only", health: &HealthCheck{Interval: "5s"}, want: HealthCheckNone},
+		{name: "endpoint defaults to http", health: &HealthCheck{Endpoint: "/health"}, want: HealthCheckHTTP},
+		{name: "explicit tcp", health: &HealthCheck{Type: "tcp"}, want: HealthCheckTCP},
+		{name: "command", health: &HealthCheck{Command: []string{"pg_isready"}}, want: HealthCheckCommand},
+		{name: "disabled", health: &HealthCheck{Disable: true, Interval: "5s"}, want: HealthCheckNone},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.health.Kind(); got != tt.want {
+				t.Errorf("expected kind %q, got %q", tt.want, got)
+			}
+		})
+	}
+}
+
+// TestValidateService_DisabledHealthWithNativeWait tests that native waiting conflicts with disabling health
+func TestValidateService_DisabledHealthWithNativeWait(t *testing.T) {
+	service := Service{
+		Image:               "postgres:15",
+		Health:              &HealthCheck{Disable: true},
+		WaitForNativeHealth: true,
+	}
+
+	err := validateService("db", service, map[string]Service{"db": service})
+	if err == nil || !strings.Contains(err.Error(), "health checks are disabled") {
+		t.Errorf("expected disabled health conflict error, got: %v", err)
+	}
+}
+
+// TestValidateHealthPorts tests that http and tcp health checks need a published port
+func TestValidateHealthPorts(t *testing.T) {
+	tests := []struct {
+		name    string
+		service Service
+		wantErr string
+	}{
+		{name: "http with port", service: Service{Ports: []string{"8080:80"}, Health: &HealthCheck{Endpoint: "/health"}}},
+		{name: "tcp with port", service: Service{Ports: []string{"6379:6379"}, Health: &HealthCheck{Type: "tcp"}}},
+		{name: "command without ports", service: Service{Health: &HealthCheck{Command: []string{"pg_isready"}}}},
+		{name: "no health check", service: Service{}},
+		{name: "http without ports", service: Service{Health: &HealthCheck{Endpoint: "/health"}}, wantErr: "http health check requires a published port"},
+		{name: "tcp without ports", service: Service{Health: &HealthCheck{Type: "tcp"}}, wantErr: "tcp health check requires a published port"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateHealthPorts(tt.service)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("expected no error, got: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("expected error containing %q, got: %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+// TestHealthCheck_Disabled tests the nil-safe disabled check
+func TestHealthCheck_Disabled(t *testing.T) {
+	var none *HealthCheck
+	if none.Disabled() {
+		t.Error("expected nil health check to not be disabled")
+	}
+	if (&HealthCheck{Endpoint: "/health"}).Disabled() {
+		t.Error("expected endpoint health check to not be disabled")
+	}
+	if !(&HealthCheck{Disable: true}).Disabled() {
+		t.Error("expected disable: true to be disabled")
+	}
+}
+
+// TestValidate_MaxParallel tests that a negative max_parallel is rejected
+func TestValidate_MaxParallel(t *testing.T) {
+	cfg := &Config{
+		Version:     "1.0",
+		Project:     "shop",
+		Services:    map[string]Service{"api": {Image: "node:18"}},
+		MaxParallel: -1,
+	}
+
+	err := cfg.Validate()
+	if err == nil || !strings.Contains(err.Error(), "max_parallel") {
+		t.Errorf("expected max_parallel error, got: %v", err)
+	}
+
+	cfg.MaxParallel = 2
+	if err := cfg.Validate(); err != nil {
+		t.Errorf("expected no error, got: %v", err)
+	}
+}
+
+// TestValidate_RestartPolicy tests that invalid restart policies are rejected
+func TestValidate_RestartPolicy(t *testing.T) {
+	tests := []struct {
+		restart string
+		wantErr string
+	}{
+		{restart: ""},
+		{restart: "no"},
+		{restart: "always"},
+		{restart: "unless-stopped"},
+		{restart: "on-failure"},
+		{restart: "on-failure:5"},
+		{restart: "sometimes", wantErr: "invalid restart policy 'sometimes'"},
+		{restart: "on-failure:many", wantErr: "invalid max retries 'many'"},
+		{restart: "on-failure:-1", wantErr: "invalid max retries '-1'"},
+		{restart: "always:3", wantErr: "does not take a retry count"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.restart, func(t *testing.T) {
+			cfg := &Config{
+				Version:  "1.0",
+				Project:  "shop",
+				Services: map[string]Service{"api": {Image: "node:18", Restart: tt.restart}},
+			}
+
+			err := cfg.Validate()
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("expected no error, got: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("expected error containing %q, got: %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+// TestParseRestartPolicy_MaxRetries tests the on-failure:<n> form
+func TestParseRestartPolicy_MaxRetries(t *testing.T) {
+	policy, err := ParseRestartPolicy("on-failure:3")
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if policy.Name != RestartOnFailure || policy.MaxRetries != 3 {
+		t.Errorf("expected on-failure with 3 retries, got: %+v", policy)
+	}
+
+	policy, _ = ParseRestartPolicy("")
+	if policy.Name != RestartNo {
+		t.Errorf("expected empty policy to default to 'no', got: %+v", policy)
+	}
+}
+
+// TestValidateLabels tests custom labels are accepted unless they use the reserved ork. prefix
+func TestValidateLabels(t *testing.T) {
+	tests := []struct {
+		name    string
+		labels  map[string]string
+		wantErr string
+	}{
+		{name: "none", labels: nil},
+		{name: "custom", labels: map[string]string{"traefik.enable": "true", "com.example.team": "payments"}},
+		{name: "reserved prefix", labels: map[string]string{"ork.project": "other"}, wantErr: "ork.project"},
+		{name: "empty key", labels: map[string]string{"": "value"}, wantErr: "empty"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateLabels(tt.labels)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("expected no error, got: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("expected error containing %q, got: %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+// TestValidate_ReservedLabel tests Validate rejects a service that overrides an ork.* label
+func TestValidate_ReservedLabel(t *testing.T) {
+	cfg := &Config{
+		Version: "1.0",
+		Project: "test-project",
+		Services: map[string]Service{
+			"api": {Image: "nginx", Labels: map[string]string{"ork.managed": "false"}},
+		},
+	}
+
+	err := cfg.Validate()
+	if err == nil {
+		t.Fatal("expected error for reserved label, got nil")
+	}
+	if !strings.Contains(err.Error(), "service 'api'") {
+		t.Errorf("expected error to name the service, got: %v", err)
+	}
+}
+
+// TestValidateAll_ReportsEveryError tests that independent problems are all reported, not just the first
+func TestValidateAll_ReportsEveryError(t *testing.T) {
+	cfg := &Config{
+		Project: "test-project",
+		Services: map[string]Service{
+			"web": {
+				Image: "nginx:alpine",
+				Ports: []string{"not-a-port"},
+			},
+			"api": {
+				Image:     "node:18",
+				Ports:     []string{"3000:3000"},
+				DependsOn: DependsOnServices("missing"),
+				Health:    &HealthCheck{Endpoint: "/health", Interval: "5 seconds"},
+			},
+			"worker": {},
+		},
+	}
+
+	errs := cfg.ValidateAll()
+
+	want := []string{
+		"version is required",
+		"service 'api': depends_on references unknown service 'missing'",
+		"services.api.health.interval",
+		"service 'web':",
+		"service 'worker':",
+	}
+	if len(errs) != len(want) {
+		t.Fatalf("expected %d errors, got %d: %v", len(want), len(errs), errs)
+	}
+	for i, substr := range want {
+		if !strings.Contains(errs[i].Error(), substr) {
+			t.Errorf("error %d: expected %q, got: %v", i, substr, errs[i])
+		}
+	}
+
+	// The fail-fast path still stops at the first problem
+	if err := cfg.Validate(); err == nil || !strings.Contains(err.Error(), "version is required") {
+		t.Errorf("expected Validate to report only the missing version, got: %v", err)
+	}
+}
+
+// TestValidateAll_Valid tests that a valid config yields no errors
+func TestValidateAll_Valid(t *testing.T) {
+	cfg := &Config{
+		Version: "1.0",
+		Project: "test-project",
+		Services: map[string]Service{
+			"web": {Image: "nginx:alpine", Ports: []string{"80:80"}},
+		},
+	}
+
+	if errs := cfg.ValidateAll(); len(errs) != 0 {
+		t.Errorf("expected no errors, got: %v", errs)
+	}
+}
+
+// TestValidateAll_NamesServiceOnce tests that errors already naming their field aren't prefixed again,
+// while other structured errors gain the service name and keep their hint
+func TestValidateAll_NamesServiceOnce(t *testing.T) {
+	cfg := &Config{
+		Version: "1.0",
+		Project: "test-project",
+		Services: map[string]Service{
+			"api": {Image: "node:18", Health: &HealthCheck{Interval: "5x"}},
+			"web": {Image: "nginx", Labels: map[string]string{"ork.managed": "false"}},
+		},
+	}
+
+	errs := cfg.ValidateAll()
+	if len(errs) != 2 {
+		t.Fatalf("expected 2 errors, got %d: %v", len(errs), errs)
+	}
+
+	if msg := errs[0].Error(); strings.Contains(msg, "service 'api'") {
+		t.Errorf("expected the field path to name the service once, got: %v", msg)
+	}
+
+	var orkErr *utils.OrkError
+	if !errors.As(errs[1], &orkErr) {
+		t.Fatalf("expected an OrkError, got: %T", errs[1])
+	}
+	if !strings.HasPrefix(orkErr.Message, "service 'web': ") {
+		t.Errorf("expected the message to name the service, got: %v", orkErr.Message)
+	}
+	if orkErr.Hint == "" {
+		t.Error("expected the hint to be kept")
+	}
+}
@@ -0,0 +1,135 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+// ============================================================================
+// Type Definitions
+// ============================================================================
+
+// VolumeMount represents a parsed volume spec (host:container[:ro])
+type VolumeMount struct {
+	Source   string // Host path or named volume
+	Target   string // Absolute path inside the container
+	ReadOnly bool   // Mount read-only
+}
+
+// namedVolumePattern matches Docker named volumes (e.g., "pgdata")
+var namedVolumePattern = regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9_.-]*$`)
+
+// ============================================================================
+// Public API
+// ============================================================================
+
+// ParseVolume parses a volume spec in the form host:container[:ro|rw]
+func ParseVolume(spec string) (VolumeMount, error) {
+	parts := strings.Split(spec, ":")
+	if len(parts) < 2 || len(parts) > 3 {
+		return VolumeMount{}, fmt.Errorf("expected format host:container[:ro]")
+	}
+
+	mount := VolumeMount{Source: parts[0], Target: parts[1]}
+
+	if mount.Source == "" {
+		return VolumeMount{}, fmt.Errorf("host path or volume name cannot be empty")
+	}
+	if !strings.HasPrefix(mount.Target, "/") {
+		return VolumeMount{}, fmt.Errorf("container path must be absolute")
+	}
+
+	if len(parts) == 3 {
+		switch parts[2] {
+		case "ro":
+			mount.ReadOnly = true
+		case "rw":
+		default:
+			return VolumeMount{}, fmt.Errorf("mode must be 'ro' or 'rw', got '%s'", parts[2])
+		}
+	}
+
+	if mount.IsNamedVolume() && !namedVolumePattern.MatchString(mount.Source) {
+		return VolumeMount{}, fmt.Errorf("invalid volume name '%s'", mount.Source)
+	}
+
+	return mount, nil
+}
+
+// IsNamedVolume reports whether the source is a Docker named volume rather than a host path
+// Named volumes have no slash and don't start with "." or "~"
+func (v VolumeMount) IsNamedVolume() bool {
+	return !strings.Contains(v.Source, "/") &&
+		!strings.HasPrefix(v.Source, ".") &&
+		!strings.HasPrefix(v.Source, "~")
+}
+
+// NamedVolumes returns the named volumes mounted by the service, in declaration order
+// Host path mounts and malformed specs are skipped
+func (s Service) NamedVolumes() []string {
+	var names []string
+	for _, spec := range s.Volumes {
+		mount, err := ParseVolume(spec)
+		if err != nil || !mount.IsNamedVolume() {
+			continue
+		}
+		names = append(names, mount.Source)
+	}
+	return names
+}
+
+// String formats the mount as a Docker bind spec
+func (v VolumeMount) String() string {
+	if v.ReadOnly {
+		return fmt.Sprintf("%s:%s:ro", v.Source, v.Target)
+	}
+	return fmt.Sprintf("%s:%s", v.Source, v.Target)
+}
+
+// ============================================================================
+// Private Helpers
+// ============================================================================
+
+// resolveVolumePaths expands ~ and relative host paths in every service's volumes
+// Relative paths are resolved against baseDir (the ork.yml directory)
+// Malformed specs are left untouched so validation can report them
+func (c *Config) resolveVolumePaths(baseDir string) {
+	for name, service := range c.Services {
+		if len(service.Volumes) == 0 {
+			continue
+		}
+
+		resolved := make([]string, len(service.Volumes))
+		for i, spec := range service.Volumes {
+			resolved[i] = resolveVolumeSpec(spec, baseDir)
+		}
+		service.Volumes = resolved
+		c.Services[name] = service
+	}
+}
+
+// resolveVolumeSpec expands the host path of a single volume spec
+func resolveVolumeSpec(spec, baseDir string) string {
+	mount, err := ParseVolume(spec)
+	if err != nil || mount.IsNamedVolume() {
+		return spec
+	}
+
+	source := mount.Source
+	if source == "~" || strings.HasPrefix(source, "~/") {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return spec
+		}
+		source = filepath.Join(home, strings.TrimPrefix(source, "~"))
+	}
+	if !filepath.IsAbs(source) {
+		source = filepath.Join(baseDir, source)
+	}
+
+	mount.Source = source
+	return mount.String()
+}
@@ -0,0 +1,176 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// TestParseVolume tests parsing of volume specs
+func TestParseVolume(t *testing.T) {
+	tests := []struct {
+		name    string
+		spec    string
+		want    VolumeMount
+		wantErr string
+	}{
+		{
+			name: "bind mount",
+			spec: "./data:/var/lib/data",
+			want: VolumeMount{Source: "./data", Target: "/var/lib/data"},
+		},
+		{
+			name: "read-only bind mount",
+			spec: "/etc/app:/config:ro",
+			want: VolumeMount{Source: "/etc/app", Target: "/config", ReadOnly: true},
+		},
+		{
+			name: "explicit read-write",
+			spec: "pgdata:/var/lib/postgresql/data:rw",
+			want: VolumeMount{Source: "pgdata", Target: "/var/lib/postgresql/data"},
+		},
+		{name: "missing container path", spec: "./data", wantErr: "expected format"},
+		{name: "too many parts", spec: "a:/b:ro:extra", wantErr: "expected format"},
+		{name: "empty host path", spec: ":/data", wantErr: "cannot be empty"},
+		{name: "relative container path", spec: "./data:data", wantErr: "must be absolute"},
+		{name: "invalid mode", spec: "./data:/data:rx", wantErr: "mode must be"},
+		{name: "invalid volume name", spec: "bad$name:/data", wantErr: "invalid volume name"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseVolume(tt.spec)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("expected error containing '%s', got: %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("expected no error, got: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("expected %+v, got %+v", tt.want, got)
+			}
+		})
+	}
+}
+
+// TestVolumeMount_IsNamedVolume tests named volume vs bind mount detection
+func TestVolumeMount_IsNamedVolume(t *testing.T) {
+	tests := []struct {
+		source string
+		want   bool
+	}{
+		{source: "pgdata", want: true},
+		{source: "my_volume.v2", want: true},
+		{source: "./data", want: false},
+		{source: "../shared", want: false},
+		{source: "/var/data", want: false},
+		{source: "~/data", want: false},
+		{source: "data/sub", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.source, func(t *testing.T) {
+			mount := VolumeMount{Source: tt.source, Target: "/data"}
+			if got := mount.IsNamedVolume(); got != tt.want {
+				t.Errorf("IsNamedVolume(%q) = %v, want %v", tt.source, got, tt.want)
+			}
+		})
+	}
+}
+
+// TestService_NamedVolumes tests that only named volumes are returned
+func TestService_NamedVolumes(t *testing.T) {
+	service := Service{Volumes: []string{
+		"pgdata:/var/lib/postgresql/data",
+		"./init:/docker-entrypoint-initdb.d:ro",
+		"cache:/cache",
+		"bad$name:/data",
+	}}
+
+	got := service.NamedVolumes()
+	want := []string{"pgdata", "cache"}
+	if strings.Join(got, ",") != strings.Join(want, ",") {
+		t.Errorf("NamedVolumes() = %v, want %v", got, want)
+	}
+}
+
+// TestResolveVolumeSpec tests host path expansion against the config directory
+func TestResolveVolumeSpec(t *testing.T) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		t.Skipf("no home directory: %v", err)
+	}
+
+	tests := []struct {
+		name string
+		spec string
+		want string
+	}{
+		{name: "relative path", spec: "./data:/data", want: "/project/data:/data"},
+		{name: "parent path keeps read-only", spec: "../shared:/shared:ro", want: "/shared:/shared:ro"},
+		{name: "home path", spec: "~/cache:/cache", want: filepath.Join(home, "cache") + ":/cache"},
+		{name: "absolute path unchanged", spec: "/var/data:/data", want: "/var/data:/data"},
+		{name: "named volume unchanged", spec: "pgdata:/var/lib/postgresql/data", want: "pgdata:/var/lib/postgresql/data"},
+		{name: "malformed spec unchanged", spec: "./data", want: "./data"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := resolveVolumeSpec(tt.spec, "/project"); got != tt.want {
+				t.Errorf("expected %q, got %q", tt.want, got)
+			}
+		})
+	}
+}
+
+// TestLoad_VolumesResolvedAgainstConfigDir tests that Load expands relative volume paths
+func TestLoad_VolumesResolvedAgainstConfigDir(t *testing.T) {
+	tempDir := t.TempDir()
+
+	configContent := `
+version: "1.0"
+project: test-project
+services:
+  postgres:
+    image: postgres:15-alpine
+    volumes:
+      - ./pgdata:/var/lib/postgresql/data
+      - cache:/cache
+`
+	if err := os.WriteFile(filepath.Join(tempDir, "ork.yml"), []byte(configContent), 0644); err != nil {
+		t.Fatalf("failed to create test config file: %v", err)
+	}
+	t.Chdir(tempDir)
+
+	cfg, err := Load()
+	if err != nil {
+		t.Fatalf("expected no error loading config, got: %v", err)
+	}
+
+	cwd, err := os.Getwd() // May differ from tempDir when it sits behind a symlink
+	if err != nil {
+		t.Fatalf("failed to get working directory: %v", err)
+	}
+
+	volumes := cfg.Services["postgres"].Volumes
+	want := []string{filepath.Join(cwd, "pgdata") + ":/var/lib/postgresql/data", "cache:/cache"}
+	if strings.Join(volumes, ",") != strings.Join(want, ",") {
+		t.Errorf("expected volumes %v, got %v", want, volumes)
+	}
+}
+
+// TestValidateVolumes_Malformed tests that malformed volume specs fail validation
+func TestValidateVolumes_Malformed(t *testing.T) {
+	err := validateVolumes([]string{"./data:/data", "./logs"})
+	if err == nil {
+		t.Fatal("expected error for malformed volume, got nil")
+	}
+
+	if !strings.Contains(err.Error(), "invalid volume './logs'") {
+		t.Errorf("expected error naming the bad spec, got: %v", err)
+	}
+}
@@ -0,0 +1,67 @@
+package config
+
+import (
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ============================================================================
+// Type Definitions
+// ============================================================================
+
+// DefaultWaitForTimeout is how long a service waits for its wait_for hosts before failing to start
+const DefaultWaitForTimeout = 60 * time.Second
+
+// ============================================================================
+// Public API
+// ============================================================================
+
+// ParseWaitForTimeout parses a wait_for timeout like "30s" or "2m"
+// An empty value means the default
+func ParseWaitForTimeout(value string) (time.Duration, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return DefaultWaitForTimeout, nil
+	}
+
+	timeout, err := time.ParseDuration(value)
+	if err != nil || timeout <= 0 {
+		return 0, fmt.Errorf("invalid wait_for_timeout '%s', expected a positive duration like \"30s\" or \"2m\"", value)
+	}
+	return timeout, nil
+}
+
+// ResolveWaitForTimeout returns how long the service waits for its wait_for hosts
+// An invalid wait_for_timeout (rejected by validation) falls back to the default
+func ResolveWaitForTimeout(service Service) time.Duration {
+	timeout, err := ParseWaitForTimeout(service.WaitForTimeout)
+	if err != nil {
+		return DefaultWaitForTimeout
+	}
+	return timeout
+}
+
+// ============================================================================
+// Private Helpers
+// ============================================================================
+
+// validateWaitFor checks each wait_for entry is a host:port address and the timeout is valid
+func validateWaitFor(service Service) error {
+	for _, address := range service.WaitFor {
+		host, port, err := net.SplitHostPort(address)
+		if err != nil || host == "" {
+			return fmt.Errorf("invalid wait_for address '%s', expected host:port (e.g., db.internal:5432)", address)
+		}
+		if number, err := strconv.Atoi(port); err != nil || number < 1 || number > 65535 {
+			return fmt.Errorf("invalid wait_for port in '%s', expected a number from 1 to 65535", address)
+		}
+	}
+
+	if _, err := ParseWaitForTimeout(service.WaitForTimeout); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,60 @@
+package config
+
+import (
+	"testing"
+	"time"
+)
+
+// TestResolveWaitForTimeout tests wait_for_timeout wins over the default
+func TestResolveWaitForTimeout(t *testing.T) {
+	tests := []struct {
+		name    string
+		service Service
+		want    time.Duration
+	}{
+		{name: "default", service: Service{}, want: DefaultWaitForTimeout},
+		{name: "service config", service: Service{WaitForTimeout: "2m"}, want: 2 * time.Minute},
+		{name: "invalid service config falls back to default", service: Service{WaitForTimeout: "soon"}, want: DefaultWaitForTimeout},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ResolveWaitForTimeout(tt.service); got != tt.want {
+				t.Errorf("ResolveWaitForTimeout() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestValidate_WaitFor tests Validate accepts host:port wait_for entries and rejects malformed ones
+func TestValidate_WaitFor(t *testing.T) {
+	tests := []struct {
+		name    string
+		service Service
+		wantErr bool
+	}{
+		{name: "hostname", service: Service{Image: "node:18", WaitFor: []string{"db.internal:5432"}}},
+		{name: "ipv6", service: Service{Image: "node:18", WaitFor: []string{"[::1]:6379"}}},
+		{name: "with timeout", service: Service{Image: "node:18", WaitFor: []string{"db:5432"}, WaitForTimeout: "90s"}},
+		{name: "missing port", service: Service{Image: "node:18", WaitFor: []string{"db.internal"}}, wantErr: true},
+		{name: "missing host", service: Service{Image: "node:18", WaitFor: []string{":5432"}}, wantErr: true},
+		{name: "port out of range", service: Service{Image: "node:18", WaitFor: []string{"db:70000"}}, wantErr: true},
+		{name: "named port", service: Service{Image: "node:18", WaitFor: []string{"db:postgres"}}, wantErr: true},
+		{name: "invalid timeout", service: Service{Image: "node:18", WaitFor: []string{"db:5432"}, WaitForTimeout: "soon"}, wantErr: true},
+		{name: "zero timeout", service: Service{Image: "node:18", WaitFor: []string{"db:5432"}, WaitForTimeout: "0s"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := &Config{Version: "1.0", Project: "test-project", Services: map[string]Service{"api": tt.service}}
+
+			err := cfg.Validate()
+			if tt.wantErr && err == nil {
+				t.Error("expected error, got nil")
+			}
+			if !tt.wantErr && err != nil {
+				t.Errorf("expected no error, got: %v", err)
+			}
+		})
+	}
+}
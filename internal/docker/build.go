@@ -0,0 +1,451 @@
+package docker
+
+import (
+	"archive/tar"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/distribution/reference"
+	"github.com/docker/docker/api/types/build"
+	"github.com/docker/docker/pkg/jsonmessage"
+	"github.com/moby/patternmatcher"
+	"github.com/moby/patternmatcher/ignorefile"
+	"github.com/moby/term"
+)
+
+// ============================================================================
+// Type Definitions
+// ============================================================================
+
+// ProgressMode controls how build output is rendered
+type ProgressMode string
+
+const (
+	ProgressAuto  ProgressMode = "auto"  // Condensed output when attached to a terminal
+	ProgressPlain ProgressMode = "plain" // Full, line-by-line output (useful in CI)
+)
+
+// BuildOptions contains configuration for building an image from source
+type BuildOptions struct {
+	Context    string            // Build context directory
+	Dockerfile string            // Dockerfile path relative to the context (default: Dockerfile)
+	Tag        string            // Tag to apply to the built image
+	Args       map[string]string // Build arguments
+	Target     string            // Multi-stage build target (empty builds the final stage)
+	CacheFrom  []string          // Images to use as build cache sources
+	NoCache    bool              // Don't use the build cache
+	Pull       bool              // Always attempt to pull newer base images
+	BuildKit   bool              // Use BuildKit instead of the legacy builder
+	Progress   ProgressMode      // How build output is rendered (default: auto)
+	HashTag    bool              // Also tag the image <repository>:<hash of the build inputs>, and reuse it while they're unchanged
+	Rebuild    bool              // Build even when HashTag finds an image for the current inputs
+}
+
+// ============================================================================
+// Public API
+// ============================================================================
+
+// ParseProgressMode parses a --progress flag value
+// An empty value defaults to auto
+func ParseProgressMode(value string) (ProgressMode, error) {
+	switch mode := ProgressMode(strings.ToLower(strings.TrimSpace(value))); mode {
+	case "":
+		return ProgressAuto, nil
+	case ProgressAuto, ProgressPlain:
+		return mode, nil
+	default:
+		return "", fmt.Errorf("invalid progress mode '%s' (expected 'auto' or 'plain')", value)
+	}
+}
+
+// ImageRepository returns the repository Ork uses for a service's locally built images
+// Names that can't appear in an image reference (uppercase, spaces, ...) are normalized
+func ImageRepository(projectName, serviceName string) string {
+	repository := fmt.Sprintf("ork-%s-%s", projectName, serviceName)
+	if _, err := reference.ParseNormalizedNamed(repository); err == nil {
+		return repository
+	}
+	return strings.TrimRight(fmt.Sprintf("ork-%s-%s", referenceComponent(projectName), referenceComponent(serviceName)), "-")
+}
+
+// ImageTag returns the tag Ork uses for a service's locally built image
+func ImageTag(projectName, serviceName string) string {
+	return ImageRepository(projectName, serviceName) + ":latest"
+}
+
+// Build builds an image from a local build context and streams the build output
+// With HashTag, an image already tagged with the current inputs' hash is re-tagged instead of rebuilt,
+// unless Rebuild, NoCache, or Pull asks for a fresh build
+// Returns the tag of the built image
+func (c *Client) Build(ctx context.Context, opts BuildOptions) (string, error) {
+	if opts.Context == "" {
+		return "", fmt.Errorf("build context cannot be empty")
+	}
+	if opts.Tag == "" {
+		return "", fmt.Errorf("build tag cannot be empty")
+	}
+
+	// Offline mode never reaches out to a registry for base images
+	if c.offline {
+		opts.Pull = false
+	}
+
+	buildOpts := buildImageBuildOptions(opts)
+	if opts.HashTag {
+		tag, err := hashTag(opts)
+		if err != nil {
+			return "", err
+		}
+
+		// The same inputs were built before: point the main tag at that image
+		reusable := !opts.Rebuild && !opts.NoCache && !opts.Pull
+		if exists, err := c.ImageExists(ctx, tag); reusable && err == nil && exists {
+			if err := c.cli.ImageTag(ctx, tag, opts.Tag); err != nil {
+				return "", fmt.Errorf("failed to tag image %s as %s: %w", tag, opts.Tag, err)
+			}
+			fmt.Printf("Reusing %s (build inputs unchanged)\n", tag)
+			return opts.Tag, nil
+		}
+		buildOpts.Tags = append(buildOpts.Tags, tag)
+	}
+
+	// Package the build context
+	buildContext, err := createBuildContext(opts.Context, opts.Dockerfile)
+	if err != nil {
+		return "", err
+	}
+	defer func() {
+		_ = buildContext.Close()
+	}()
+
+	resp, err := c.cli.ImageBuild(ctx, buildContext, buildOpts)
+	if err != nil {
+		return "", fmt.Errorf("failed to build image %s: %w\n💡 Check that Docker is running and the Dockerfile exists", opts.Tag, err)
+	}
+	defer func() {
+		if closeErr := resp.Body.Close(); closeErr != nil {
+			fmt.Printf("⚠️  Warning: failed to close build output reader: %v\n", closeErr)
+		}
+	}()
+
+	// Stream build output - build failures are reported in the stream, not by ImageBuild
+	fd, isTerminal := term.GetFdInfo(os.Stdout)
+	if opts.Progress == ProgressPlain {
+		isTerminal = false
+	}
+	// BuildKit reports its steps as aux trace messages rather than plain stream lines
+	progress := newBuildKitProgress(os.Stdout)
+	if err := jsonmessage.DisplayJSONMessagesStream(resp.Body, os.Stdout, fd, isTerminal, progress.handleAux); err != nil {
+		return "", fmt.Errorf("failed to build image %s: %w", opts.Tag, err)
+	}
+
+	return opts.Tag, nil
+}
+
+// BuildKitAvailable reports whether builds should use BuildKit
+// Uses BuildKit when the daemon advertises it, unless DOCKER_BUILDKIT=0 opts out.
+// An unreachable daemon is returned as an error (see IsDaemonUnavailable); any other
+// ping failure falls back to the legacy builder
+func (c *Client) BuildKitAvailable(ctx context.Context) (bool, error) {
+	if os.Getenv("DOCKER_BUILDKIT") == "0" {
+		return false, nil
+	}
+
+	ping, err := pingDaemon(ctx, c.cli)
+	if IsDaemonUnavailable(err) {
+		return false, err
+	}
+	if err != nil {
+		return false, nil
+	}
+	return ping.BuilderVersion == build.BuilderBuildKit, nil
+}
+
+// ============================================================================
+// Private Helpers - Build-related
+// ============================================================================
+
+// selectBuilderVersion picks BuildKit when available, falling back to the legacy builder
+func selectBuilderVersion(buildKit bool) build.BuilderVersion {
+	if buildKit {
+		return build.BuilderBuildKit
+	}
+	return build.BuilderV1
+}
+
+// buildImageBuildOptions converts BuildOptions to the Docker API build options
+func buildImageBuildOptions(opts BuildOptions) build.ImageBuildOptions {
+	buildOpts := build.ImageBuildOptions{
+		Dockerfile: opts.Dockerfile,
+		BuildArgs:  convertBuildArgs(opts.Args),
+		Target:     opts.Target,
+		CacheFrom:  opts.CacheFrom,
+		NoCache:    opts.NoCache,
+		PullParent: opts.Pull,
+		Version:    selectBuilderVersion(opts.BuildKit),
+		Remove:     true, // Clean up intermediate containers
+	}
+
+	if opts.Tag != "" {
+		buildOpts.Tags = []string{opts.Tag}
+	}
+
+	return buildOpts
+}
+
+// hashTag returns the build tag's repository tagged with a hash of the build inputs
+// e.g., "ork-shop-api:latest" becomes "ork-shop-api:3f2a9c1b0d4e"
+func hashTag(opts BuildOptions) (string, error) {
+	named, err := reference.ParseNormalizedNamed(opts.Tag)
+	if err != nil {
+		return "", fmt.Errorf("invalid build tag %s: %w", opts.Tag, err)
+	}
+
+	hash, err := buildInputsHash(opts)
+	if err != nil {
+		return "", err
+	}
+	return reference.FamiliarName(named) + ":" + hash, nil
+}
+
+// buildInputsHash hashes what determines a build's result: every file sent in the context (path,
+// mode, link target, and contents - not timestamps), the Dockerfile, target, and build args
+// Files excluded by .dockerignore don't count, since the daemon never sees them
+// Returns the first 12 hex characters of the SHA-256
+func buildInputsHash(opts BuildOptions) (string, error) {
+	hash := sha256.New()
+
+	matcher, err := buildContextMatcher(opts.Context, opts.Dockerfile)
+	if err != nil {
+		return "", err
+	}
+	err = walkBuildContext(opts.Context, matcher, func(path, name string, entry fs.DirEntry) error {
+		return hashBuildContextEntry(hash, path, name, entry)
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to hash build context %s: %w", opts.Context, err)
+	}
+
+	_, _ = fmt.Fprintf(hash, "dockerfile=%s\x00target=%s\x00", opts.Dockerfile, opts.Target)
+	keys := make([]string, 0, len(opts.Args))
+	for key := range opts.Args {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		_, _ = fmt.Fprintf(hash, "arg=%s=%s\x00", key, opts.Args[key])
+	}
+
+	return hex.EncodeToString(hash.Sum(nil))[:12], nil
+}
+
+// hashBuildContextEntry writes a single file, directory, or symlink of the build context to the hash
+func hashBuildContextEntry(hash io.Writer, path, name string, entry fs.DirEntry) error {
+	info, err := entry.Info()
+	if err != nil {
+		return err
+	}
+	_, _ = fmt.Fprintf(hash, "%s\x00%s\x00", name, info.Mode())
+
+	switch {
+	case info.Mode()&fs.ModeSymlink != 0:
+		link, err := os.Readlink(path)
+		if err != nil {
+			return err
+		}
+		_, _ = fmt.Fprintf(hash, "%s\x00", link)
+	case info.Mode().IsRegular():
+		_, _ = fmt.Fprintf(hash, "%d\x00", info.Size())
+		file, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer func() {
+			_ = file.Close()
+		}()
+		if _, err := io.Copy(hash, file); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// referenceComponent lowercases a name and replaces anything but letters and digits with '-'
+func referenceComponent(name string) string {
+	var b strings.Builder
+	for _, r := range strings.ToLower(name) {
+		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') {
+			b.WriteRune(r)
+		} else {
+			b.WriteByte('-')
+		}
+	}
+	return b.String()
+}
+
+// convertBuildArgs converts build args to the pointer map the Docker API expects
+func convertBuildArgs(args map[string]string) map[string]*string {
+	if len(args) == 0 {
+		return nil
+	}
+
+	result := make(map[string]*string, len(args))
+	for key, value := range args {
+		v := value
+		result[key] = &v
+	}
+	return result
+}
+
+// dockerignoreFile lists the build context paths that aren't sent to the daemon
+const dockerignoreFile = ".dockerignore"
+
+// createBuildContext streams a directory as a tar archive for the Docker API, leaving out
+// whatever its .dockerignore excludes; the archive is written as the daemon reads it
+// The caller must close the reader, which stops the archiving if the build ends early
+func createBuildContext(dir, dockerfile string) (io.ReadCloser, error) {
+	info, err := os.Stat(dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read build context %s: %w", dir, err)
+	}
+	if !info.IsDir() {
+		return nil, fmt.Errorf("build context %s is not a directory", dir)
+	}
+
+	matcher, err := buildContextMatcher(dir, dockerfile)
+	if err != nil {
+		return nil, err
+	}
+
+	reader, writer := io.Pipe()
+	go func() {
+		tw := tar.NewWriter(writer)
+		err := walkBuildContext(dir, matcher, func(path, name string, entry fs.DirEntry) error {
+			return addToBuildContext(tw, path, name, entry)
+		})
+		if err == nil {
+			err = tw.Close()
+		}
+		if err != nil {
+			err = fmt.Errorf("failed to package build context %s: %w", dir, err)
+		}
+		_ = writer.CloseWithError(err)
+	}()
+
+	return reader, nil
+}
+
+// buildContextMatcher reads the context's .dockerignore (if any) into a matcher
+// Like the docker CLI, the Dockerfile and .dockerignore are always sent, even when ignored
+func buildContextMatcher(dir, dockerfile string) (*patternmatcher.PatternMatcher, error) {
+	file, err := os.Open(filepath.Join(dir, dockerignoreFile))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", dockerignoreFile, err)
+	}
+	defer func() {
+		_ = file.Close()
+	}()
+
+	patterns, err := ignorefile.ReadAll(file)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", dockerignoreFile, err)
+	}
+	if len(patterns) == 0 {
+		return nil, nil
+	}
+
+	if dockerfile == "" {
+		dockerfile = "Dockerfile"
+	}
+	patterns = append(patterns, "!"+filepath.ToSlash(filepath.Clean(dockerfile)), "!"+dockerignoreFile)
+
+	matcher, err := patternmatcher.New(patterns)
+	if err != nil {
+		return nil, fmt.Errorf("invalid pattern in %s: %w", dockerignoreFile, err)
+	}
+	return matcher, nil
+}
+
+// walkBuildContext calls fn for every entry of the build context the matcher doesn't exclude, in lexical order
+// name is the entry's slash-separated path relative to dir; a nil matcher includes everything
+func walkBuildContext(dir string, matcher *patternmatcher.PatternMatcher, fn func(path, name string, entry fs.DirEntry) error) error {
+	return filepath.WalkDir(dir, func(path string, entry fs.DirEntry, walkErr error) error {
+		if walkErr != nil {
+			return walkErr
+		}
+
+		relPath, err := filepath.Rel(dir, path)
+		if err != nil || relPath == "." {
+			return err
+		}
+		name := filepath.ToSlash(relPath)
+
+		if matcher != nil {
+			excluded, err := matcher.MatchesOrParentMatches(name)
+			if err != nil {
+				return err
+			}
+			if excluded {
+				// Skip an excluded directory outright, unless a "!" pattern could re-include something inside it
+				if entry.IsDir() && !matcher.Exclusions() {
+					return filepath.SkipDir
+				}
+				return nil
+			}
+		}
+
+		return fn(path, name, entry)
+	})
+}
+
+// addToBuildContext writes a single file, directory, or symlink to the tar archive
+func addToBuildContext(tw *tar.Writer, path, name string, entry fs.DirEntry) error {
+	info, err := entry.Info()
+	if err != nil {
+		return err
+	}
+
+	link := ""
+	if info.Mode()&fs.ModeSymlink != 0 {
+		if link, err = os.Readlink(path); err != nil {
+			return err
+		}
+	}
+
+	header, err := tar.FileInfoHeader(info, link)
+	if err != nil {
+		return err
+	}
+	header.Name = name
+
+	if err := tw.WriteHeader(header); err != nil {
+		return err
+	}
+
+	// Only regular files have content
+	if !info.Mode().IsRegular() {
+		return nil
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = file.Close()
+	}()
+
+	_, err = io.Copy(tw, file)
+	return err
+}
@@ -0,0 +1,62 @@
+package docker_test
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ork-cli/ork/internal/docker"
+	"github.com/ork-cli/ork/internal/docker/dockertest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// ============================================================================
+// Hash Tag Reuse Tests
+// ============================================================================
+
+// newHashTagBuild returns build options for a minimal context, with every image already present
+func newHashTagBuild(t *testing.T) (*dockertest.Server, *docker.Client, docker.BuildOptions) {
+	t.Helper()
+
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "Dockerfile"), []byte("FROM alpine\n"), 0o644))
+
+	fake, client := dockertest.NewServer(t)
+	fake.Handle(http.MethodPost, "/build", func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{"stream":"done\n"}` + "\n"))
+	})
+
+	return fake, client, docker.BuildOptions{Context: dir, Tag: "ork-shop-api:latest", HashTag: true, Progress: docker.ProgressPlain}
+}
+
+func TestBuild_HashTagReusesUnchangedInputs(t *testing.T) {
+	fake, client, opts := newHashTagBuild(t)
+
+	_, err := client.Build(context.Background(), opts)
+
+	require.NoError(t, err)
+	assert.False(t, fake.HasRequest("POST /build"))
+}
+
+func TestBuild_HashTagRebuildSkipsReuse(t *testing.T) {
+	fake, client, opts := newHashTagBuild(t)
+	opts.Rebuild = true
+
+	_, err := client.Build(context.Background(), opts)
+
+	require.NoError(t, err)
+	assert.True(t, fake.HasRequest("POST /build"))
+}
+
+func TestBuild_HashTagPullSkipsReuse(t *testing.T) {
+	fake, client, opts := newHashTagBuild(t)
+	opts.Pull = true
+
+	_, err := client.Build(context.Background(), opts)
+
+	require.NoError(t, err)
+	assert.True(t, fake.HasRequest("POST /build"))
+}
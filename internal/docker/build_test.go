@@ -0,0 +1,316 @@
+package docker
+
+import (
+	"archive/tar"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/docker/docker/api/types/build"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// ============================================================================
+// Helper Function Tests - Build Options
+// ============================================================================
+
+func TestBuildImageBuildOptions_Target(t *testing.T) {
+	opts := buildImageBuildOptions(BuildOptions{
+		Context: "./api",
+		Tag:     "ork-webapp-api:latest",
+		Target:  "builder",
+	})
+
+	assert.Equal(t, "builder", opts.Target)
+	assert.Equal(t, []string{"ork-webapp-api:latest"}, opts.Tags)
+}
+
+func TestBuildImageBuildOptions_NoTarget(t *testing.T) {
+	opts := buildImageBuildOptions(BuildOptions{Context: "./api"})
+
+	assert.Empty(t, opts.Target)
+	assert.Empty(t, opts.Tags)
+}
+
+func TestBuildImageBuildOptions_CacheFrom(t *testing.T) {
+	opts := buildImageBuildOptions(BuildOptions{
+		Context:   "./api",
+		CacheFrom: []string{"ghcr.io/org/api:latest", "ghcr.io/org/api:builder"},
+	})
+
+	assert.Equal(t, []string{"ghcr.io/org/api:latest", "ghcr.io/org/api:builder"}, opts.CacheFrom)
+}
+
+func TestBuildImageBuildOptions_DockerfileAndArgs(t *testing.T) {
+	opts := buildImageBuildOptions(BuildOptions{
+		Context:    "./api",
+		Dockerfile: "docker/Dockerfile.dev",
+		Args:       map[string]string{"NODE_ENV": "development"},
+	})
+
+	assert.Equal(t, "docker/Dockerfile.dev", opts.Dockerfile)
+	require.Contains(t, opts.BuildArgs, "NODE_ENV")
+	assert.Equal(t, "development", *opts.BuildArgs["NODE_ENV"])
+}
+
+// ============================================================================
+// Helper Function Tests - Progress and Builder Selection
+// ============================================================================
+
+func TestParseProgressMode(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   string
+		want    ProgressMode
+		wantErr bool
+	}{
+		{name: "empty defaults to auto", value: "", want: ProgressAuto},
+		{name: "auto", value: "auto", want: ProgressAuto},
+		{name: "plain", value: "plain", want: ProgressPlain},
+		{name: "case insensitive", value: "PLAIN", want: ProgressPlain},
+		{name: "unknown mode", value: "tty", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseProgressMode(tt.value)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestSelectBuilderVersion(t *testing.T) {
+	assert.Equal(t, build.BuilderBuildKit, selectBuilderVersion(true))
+	assert.Equal(t, build.BuilderV1, selectBuilderVersion(false))
+}
+
+func TestBuildImageBuildOptions_BuilderVersion(t *testing.T) {
+	assert.Equal(t, build.BuilderBuildKit, buildImageBuildOptions(BuildOptions{BuildKit: true}).Version)
+	assert.Equal(t, build.BuilderV1, buildImageBuildOptions(BuildOptions{}).Version)
+}
+
+// ============================================================================
+// Helper Function Tests - Build Context
+// ============================================================================
+
+func TestBuildImageBuildOptions_NoCacheAndPull(t *testing.T) {
+	opts := buildImageBuildOptions(BuildOptions{NoCache: true, Pull: true})
+
+	assert.True(t, opts.NoCache)
+	assert.True(t, opts.PullParent)
+}
+
+func TestCreateBuildContext(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "Dockerfile"), []byte("FROM alpine\n"), 0o644))
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "src"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "src", "main.go"), []byte("package main\n"), 0o644))
+
+	contents := readBuildContext(t, dir, "")
+
+	assert.Equal(t, "FROM alpine\n", contents["Dockerfile"])
+	assert.Equal(t, "package main\n", contents["src/main.go"])
+	assert.Contains(t, contents, "src")
+}
+
+func TestCreateBuildContext_Dockerignore(t *testing.T) {
+	dir := t.TempDir()
+	files := map[string]string{
+		"Dockerfile.dev":            "FROM alpine\n",
+		".dockerignore":             "node_modules\n.git\n*.log\nDockerfile.dev\nlogs/\n!logs/keep.log\n",
+		"src/main.go":               "package main\n",
+		"node_modules/pkg/index.js": "module.exports = {}\n",
+		".git/HEAD":                 "ref: refs/heads/main\n",
+		"debug.log":                 "noise\n",
+		"logs/keep.log":             "kept\n",
+		"logs/other.txt":            "dropped\n",
+	}
+	for name, data := range files {
+		require.NoError(t, os.MkdirAll(filepath.Dir(filepath.Join(dir, name)), 0o755))
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(data), 0o644))
+	}
+
+	contents := readBuildContext(t, dir, "Dockerfile.dev")
+
+	assert.Contains(t, contents, "src/main.go")
+	assert.Contains(t, contents, "Dockerfile.dev", "the Dockerfile is sent even when ignored")
+	assert.Contains(t, contents, ".dockerignore")
+	assert.Contains(t, contents, "logs/keep.log", "a ! pattern re-includes a file")
+	for name := range contents {
+		assert.False(t, strings.HasPrefix(name, "node_modules"), "unexpected %s", name)
+		assert.False(t, strings.HasPrefix(name, ".git/"), "unexpected %s", name)
+	}
+	assert.NotContains(t, contents, "debug.log")
+	assert.NotContains(t, contents, "logs/other.txt")
+}
+
+// readBuildContext packages dir and returns the archive's entries (name -> contents)
+func readBuildContext(t *testing.T, dir, dockerfile string) map[string]string {
+	t.Helper()
+
+	reader, err := createBuildContext(dir, dockerfile)
+	require.NoError(t, err)
+	defer func() {
+		_ = reader.Close()
+	}()
+
+	contents := make(map[string]string)
+	tr := tar.NewReader(reader)
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+
+		data, err := io.ReadAll(tr)
+		require.NoError(t, err)
+		contents[header.Name] = string(data)
+	}
+	return contents
+}
+
+func TestCreateBuildContext_MissingDir(t *testing.T) {
+	_, err := createBuildContext(filepath.Join(t.TempDir(), "missing"), "")
+
+	assert.Error(t, err)
+}
+
+func TestCreateBuildContext_NotADirectory(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "Dockerfile")
+	require.NoError(t, os.WriteFile(file, []byte("FROM alpine\n"), 0o644))
+
+	_, err := createBuildContext(file, "")
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "not a directory")
+}
+
+func TestConvertBuildArgs(t *testing.T) {
+	args := convertBuildArgs(map[string]string{"VERSION": "1.2.3", "EMPTY": ""})
+
+	require.Len(t, args, 2)
+	assert.Equal(t, "1.2.3", *args["VERSION"])
+	assert.Equal(t, "", *args["EMPTY"], "empty values are passed, not dropped")
+	assert.NotSame(t, args["VERSION"], args["EMPTY"])
+}
+
+func TestConvertBuildArgs_Empty(t *testing.T) {
+	assert.Nil(t, convertBuildArgs(nil))
+}
+
+func TestImageTag(t *testing.T) {
+	tests := []struct {
+		project  string
+		service  string
+		expected string
+	}{
+		{project: "shop", service: "api", expected: "ork-shop-api:latest"},
+		{project: "shop", service: "order_worker", expected: "ork-shop-order_worker:latest"},
+		{project: "shop.v2", service: "api-gw", expected: "ork-shop.v2-api-gw:latest"},
+		{project: "MyShop", service: "API", expected: "ork-myshop-api:latest"},
+		{project: "my shop", service: "web app", expected: "ork-my-shop-web-app:latest"},
+		{project: "shop", service: "api__v2__", expected: "ork-shop-api--v2:latest"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.project+"/"+tt.service, func(t *testing.T) {
+			assert.Equal(t, tt.expected, ImageTag(tt.project, tt.service))
+			assert.Equal(t, strings.TrimSuffix(tt.expected, ":latest"), ImageRepository(tt.project, tt.service))
+		})
+	}
+}
+
+// ============================================================================
+// Helper Function Tests - Build Inputs Hash
+// ============================================================================
+
+// writeBuildContext creates a build context directory with a Dockerfile and one source file
+func writeBuildContext(t *testing.T) string {
+	t.Helper()
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "Dockerfile"), []byte("FROM alpine\nCOPY . /app\n"), 0o644))
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "src"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "src", "main.go"), []byte("package main\n"), 0o644))
+	return dir
+}
+
+func TestHashTag(t *testing.T) {
+	dir := writeBuildContext(t)
+
+	tag, err := hashTag(BuildOptions{Context: dir, Tag: "ork-shop-api:latest"})
+	require.NoError(t, err)
+
+	assert.Regexp(t, `^ork-shop-api:[0-9a-f]{12}$`, tag)
+}
+
+func TestBuildInputsHash_Deterministic(t *testing.T) {
+	dir := writeBuildContext(t)
+	opts := BuildOptions{Context: dir, Args: map[string]string{"A": "1", "B": "2", "C": "3"}}
+
+	first, err := buildInputsHash(opts)
+	require.NoError(t, err)
+
+	// Touching a file changes its timestamp but not the build inputs
+	later := time.Now().Add(time.Hour)
+	require.NoError(t, os.Chtimes(filepath.Join(dir, "src", "main.go"), later, later))
+
+	second, err := buildInputsHash(opts)
+	require.NoError(t, err)
+	assert.Equal(t, first, second)
+}
+
+func TestBuildInputsHash_ChangesWithInputs(t *testing.T) {
+	dir := writeBuildContext(t)
+	base := BuildOptions{Context: dir, Args: map[string]string{"VERSION": "1.0"}}
+	baseHash, err := buildInputsHash(base)
+	require.NoError(t, err)
+
+	changes := map[string]func(opts *BuildOptions){
+		"build arg":  func(opts *BuildOptions) { opts.Args = map[string]string{"VERSION": "2.0"} },
+		"target":     func(opts *BuildOptions) { opts.Target = "dev" },
+		"dockerfile": func(opts *BuildOptions) { opts.Dockerfile = "Dockerfile.dev" },
+	}
+	for name, change := range changes {
+		t.Run(name, func(t *testing.T) {
+			opts := base
+			change(&opts)
+
+			hash, err := buildInputsHash(opts)
+			require.NoError(t, err)
+			assert.NotEqual(t, baseHash, hash)
+		})
+	}
+
+	t.Run("file contents", func(t *testing.T) {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "src", "main.go"), []byte("package main // changed\n"), 0o644))
+
+		hash, err := buildInputsHash(base)
+		require.NoError(t, err)
+		assert.NotEqual(t, baseHash, hash)
+	})
+}
+
+func TestBuildInputsHash_IgnoresDockerignoredFiles(t *testing.T) {
+	dir := writeBuildContext(t)
+	require.NoError(t, os.WriteFile(filepath.Join(dir, ".dockerignore"), []byte("*.log\n"), 0o644))
+	opts := BuildOptions{Context: dir}
+
+	before, err := buildInputsHash(opts)
+	require.NoError(t, err)
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "debug.log"), []byte("noise\n"), 0o644))
+
+	after, err := buildInputsHash(opts)
+	require.NoError(t, err)
+	assert.Equal(t, before, after, "an ignored file never reaches the daemon, so it can't change the build")
+}
@@ -0,0 +1,257 @@
+package docker
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/docker/docker/pkg/jsonmessage"
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+// ============================================================================
+// Type Definitions
+// ============================================================================
+
+// buildKitTraceID is the ID of the aux messages BuildKit reports build progress in
+const buildKitTraceID = "moby.buildkit.trace"
+
+// buildKitProgress renders BuildKit progress like 'docker build --progress=plain' ("#3 [2/4] RUN make")
+type buildKitProgress struct {
+	out       io.Writer
+	steps     map[string]int  // Vertex digest -> step number, in the order vertexes first appear
+	started   map[string]bool // Vertexes whose name has been printed
+	completed map[string]bool // Vertexes whose outcome has been printed
+}
+
+// buildKitVertex is one step of a BuildKit build (the fields of the StatusResponse Vertex message we render)
+type buildKitVertex struct {
+	digest    string
+	name      string
+	cached    bool
+	started   bool
+	completed bool
+	err       string
+}
+
+// buildKitLog is output a step wrote (the fields of the StatusResponse VertexLog message we render)
+type buildKitLog struct {
+	vertex string
+	msg    []byte
+}
+
+// ============================================================================
+// Constructor
+// ============================================================================
+
+// newBuildKitProgress creates a renderer writing to out
+func newBuildKitProgress(out io.Writer) *buildKitProgress {
+	return &buildKitProgress{
+		out:       out,
+		steps:     make(map[string]int),
+		started:   make(map[string]bool),
+		completed: make(map[string]bool),
+	}
+}
+
+// ============================================================================
+// Rendering
+// ============================================================================
+
+// handleAux renders a BuildKit trace message; other aux messages (e.g., the image ID) are ignored
+// Used as the aux callback of jsonmessage.DisplayJSONMessagesStream
+func (p *buildKitProgress) handleAux(msg jsonmessage.JSONMessage) {
+	if msg.ID != buildKitTraceID || msg.Aux == nil {
+		return
+	}
+
+	// The trace is a protobuf StatusResponse, base64-encoded as a JSON string
+	var data []byte
+	if err := json.Unmarshal(*msg.Aux, &data); err != nil {
+		return
+	}
+	vertexes, logs, err := decodeBuildKitStatus(data)
+	if err != nil {
+		return
+	}
+
+	// A step's logs belong between its name and its outcome, even when all three arrive in one message
+	logged := make(map[string]bool, len(logs))
+	for _, log := range logs {
+		logged[log.vertex] = true
+	}
+	for _, vertex := range vertexes {
+		p.renderStart(vertex)
+		if !logged[vertex.digest] {
+			p.renderOutcome(vertex)
+		}
+	}
+	for _, log := range logs {
+		p.renderLog(log)
+	}
+	for _, vertex := range vertexes {
+		p.renderOutcome(vertex)
+	}
+}
+
+// renderStart prints a step's name the first time it starts
+func (p *buildKitProgress) renderStart(vertex buildKitVertex) {
+	if !vertex.started && !vertex.cached && !vertex.completed || p.started[vertex.digest] {
+		return
+	}
+	p.started[vertex.digest] = true
+	_, _ = fmt.Fprintf(p.out, "#%d %s\n", p.step(vertex.digest), vertex.name)
+}
+
+// renderOutcome prints a step's outcome once it finishes
+func (p *buildKitProgress) renderOutcome(vertex buildKitVertex) {
+	if p.completed[vertex.digest] {
+		return
+	}
+	step := p.step(vertex.digest)
+
+	switch {
+	case vertex.err != "":
+		p.completed[vertex.digest] = true
+		_, _ = fmt.Fprintf(p.out, "#%d ERROR: %s\n", step, vertex.err)
+	case vertex.cached:
+		p.completed[vertex.digest] = true
+		_, _ = fmt.Fprintf(p.out, "#%d CACHED\n", step)
+	case vertex.completed:
+		p.completed[vertex.digest] = true
+		_, _ = fmt.Fprintf(p.out, "#%d DONE\n", step)
+	}
+}
+
+// renderLog prints each line a step wrote, prefixed with its step number
+func (p *buildKitProgress) renderLog(log buildKitLog) {
+	step := p.step(log.vertex)
+	for _, line := range strings.Split(strings.TrimRight(string(log.msg), "\n"), "\n") {
+		_, _ = fmt.Fprintf(p.out, "#%d %s\n", step, strings.TrimRight(line, "\r"))
+	}
+}
+
+// step returns the number of the step with the given digest, numbering new steps as they appear
+func (p *buildKitProgress) step(digest string) int {
+	if step, ok := p.steps[digest]; ok {
+		return step
+	}
+	p.steps[digest] = len(p.steps) + 1
+	return p.steps[digest]
+}
+
+// ============================================================================
+// Private Helpers - Decoding
+// ============================================================================
+
+// Field numbers from BuildKit's control.proto
+const (
+	statusVertexesField = 1 // StatusResponse.vertexes
+	statusLogsField     = 3 // StatusResponse.logs
+
+	vertexDigestField    = 1 // Vertex.digest
+	vertexNameField      = 3 // Vertex.name
+	vertexCachedField    = 4 // Vertex.cached
+	vertexStartedField   = 5 // Vertex.started
+	vertexCompletedField = 6 // Vertex.completed
+	vertexErrorField     = 7 // Vertex.error
+
+	logVertexField = 1 // VertexLog.vertex
+	logMsgField    = 4 // VertexLog.msg
+)
+
+// decodeBuildKitStatus decodes the vertexes and logs of a StatusResponse, skipping every other field
+func decodeBuildKitStatus(data []byte) ([]buildKitVertex, []buildKitLog, error) {
+	var vertexes []buildKitVertex
+	var logs []buildKitLog
+
+	err := forEachProtoField(data, func(num protowire.Number, value []byte, varint uint64) error {
+		switch num {
+		case statusVertexesField:
+			vertex, err := decodeBuildKitVertex(value)
+			if err != nil {
+				return err
+			}
+			vertexes = append(vertexes, vertex)
+		case statusLogsField:
+			log, err := decodeBuildKitLog(value)
+			if err != nil {
+				return err
+			}
+			logs = append(logs, log)
+		}
+		return nil
+	})
+	return vertexes, logs, err
+}
+
+// decodeBuildKitVertex decodes a Vertex message
+func decodeBuildKitVertex(data []byte) (buildKitVertex, error) {
+	var vertex buildKitVertex
+	err := forEachProtoField(data, func(num protowire.Number, value []byte, varint uint64) error {
+		switch num {
+		case vertexDigestField:
+			vertex.digest = string(value)
+		case vertexNameField:
+			vertex.name = string(value)
+		case vertexCachedField:
+			vertex.cached = varint != 0
+		case vertexStartedField:
+			vertex.started = true
+		case vertexCompletedField:
+			vertex.completed = true
+		case vertexErrorField:
+			vertex.err = string(value)
+		}
+		return nil
+	})
+	return vertex, err
+}
+
+// decodeBuildKitLog decodes a VertexLog message
+func decodeBuildKitLog(data []byte) (buildKitLog, error) {
+	var log buildKitLog
+	err := forEachProtoField(data, func(num protowire.Number, value []byte, varint uint64) error {
+		switch num {
+		case logVertexField:
+			log.vertex = string(value)
+		case logMsgField:
+			log.msg = value
+		}
+		return nil
+	})
+	return log, err
+}
+
+// forEachProtoField calls fn for every field of a protobuf message
+// value holds the bytes of length-delimited fields, varint the value of varint fields
+func forEachProtoField(data []byte, fn func(num protowire.Number, value []byte, varint uint64) error) error {
+	for len(data) > 0 {
+		num, typ, n := protowire.ConsumeTag(data)
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		data = data[n:]
+
+		var value []byte
+		var varint uint64
+		switch typ {
+		case protowire.BytesType:
+			value, n = protowire.ConsumeBytes(data)
+		case protowire.VarintType:
+			varint, n = protowire.ConsumeVarint(data)
+		default:
+			n = protowire.ConsumeFieldValue(num, typ, data)
+		}
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		data = data[n:]
+
+		if err := fn(num, value, varint); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,113 @@
+package docker
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"testing"
+
+	"github.com/docker/docker/pkg/jsonmessage"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+// ============================================================================
+// Test Helpers
+// ============================================================================
+
+// protoBytes appends a length-delimited field
+func protoBytes(b []byte, num protowire.Number, value []byte) []byte {
+	b = protowire.AppendTag(b, num, protowire.BytesType)
+	return protowire.AppendBytes(b, value)
+}
+
+// protoVarint appends a varint field
+func protoVarint(b []byte, num protowire.Number, value uint64) []byte {
+	b = protowire.AppendTag(b, num, protowire.VarintType)
+	return protowire.AppendVarint(b, value)
+}
+
+// encodeVertex encodes a Vertex message; a timestamp is stood in by an empty message
+func encodeVertex(v buildKitVertex) []byte {
+	var b []byte
+	b = protoBytes(b, vertexDigestField, []byte(v.digest))
+	b = protoBytes(b, 2, []byte("sha256:input")) // inputs, ignored
+	b = protoBytes(b, vertexNameField, []byte(v.name))
+	if v.cached {
+		b = protoVarint(b, vertexCachedField, 1)
+	}
+	if v.started {
+		b = protoBytes(b, vertexStartedField, nil)
+	}
+	if v.completed {
+		b = protoBytes(b, vertexCompletedField, nil)
+	}
+	if v.err != "" {
+		b = protoBytes(b, vertexErrorField, []byte(v.err))
+	}
+	return b
+}
+
+// traceMessage wraps a StatusResponse the way the daemon streams it
+func traceMessage(t *testing.T, status []byte) jsonmessage.JSONMessage {
+	t.Helper()
+	aux, err := json.Marshal(base64.StdEncoding.EncodeToString(status))
+	assert.NoError(t, err)
+	raw := json.RawMessage(aux)
+	return jsonmessage.JSONMessage{ID: buildKitTraceID, Aux: &raw}
+}
+
+// ============================================================================
+// BuildKit Progress Tests
+// ============================================================================
+
+func TestBuildKitProgress_RendersSteps(t *testing.T) {
+	var out bytes.Buffer
+	progress := newBuildKitProgress(&out)
+
+	var status []byte
+	status = protoBytes(status, statusVertexesField, encodeVertex(buildKitVertex{digest: "a", name: "[1/2] FROM alpine", cached: true}))
+	status = protoBytes(status, statusVertexesField, encodeVertex(buildKitVertex{digest: "b", name: "[2/2] RUN make", started: true}))
+	status = protoBytes(status, 2, []byte{0x0a, 0x01, 'x'}) // statuses, ignored
+	progress.handleAux(traceMessage(t, status))
+
+	var log []byte
+	log = protoBytes(log, logVertexField, []byte("b"))
+	log = protoVarint(log, 3, 1) // stream, ignored
+	log = protoBytes(log, logMsgField, []byte("compiling\nlinking\n"))
+	status = protoBytes(nil, statusLogsField, log)
+	status = protoBytes(status, statusVertexesField, encodeVertex(buildKitVertex{digest: "b", name: "[2/2] RUN make", started: true, completed: true}))
+	progress.handleAux(traceMessage(t, status))
+
+	// Repeated updates for a finished step print nothing new
+	progress.handleAux(traceMessage(t, status[:0:0]))
+	progress.handleAux(traceMessage(t, protoBytes(nil, statusVertexesField, encodeVertex(buildKitVertex{digest: "b", name: "[2/2] RUN make", started: true, completed: true}))))
+
+	assert.Equal(t, "#1 [1/2] FROM alpine\n#1 CACHED\n#2 [2/2] RUN make\n#2 compiling\n#2 linking\n#2 DONE\n", out.String())
+}
+
+func TestBuildKitProgress_RendersError(t *testing.T) {
+	var out bytes.Buffer
+	progress := newBuildKitProgress(&out)
+
+	status := protoBytes(nil, statusVertexesField, encodeVertex(buildKitVertex{
+		digest: "a", name: "[1/1] RUN false", started: true, completed: true, err: "exit code: 1",
+	}))
+	progress.handleAux(traceMessage(t, status))
+
+	assert.Equal(t, "#1 [1/1] RUN false\n#1 ERROR: exit code: 1\n", out.String())
+}
+
+func TestBuildKitProgress_IgnoresOtherMessages(t *testing.T) {
+	var out bytes.Buffer
+	progress := newBuildKitProgress(&out)
+
+	raw := json.RawMessage(`{"ID":"sha256:abc"}`)
+	progress.handleAux(jsonmessage.JSONMessage{Aux: &raw})
+	progress.handleAux(jsonmessage.JSONMessage{ID: buildKitTraceID})
+
+	// Malformed payloads are dropped rather than failing the build
+	progress.handleAux(traceMessage(t, []byte{0x0a, 0xff}))
+
+	assert.Empty(t, out.String())
+}
@@ -3,13 +3,25 @@ package docker
 import (
 	"context"
 	"fmt"
+	"os"
+	"sync"
 
+	"github.com/docker/docker/api/types"
 	"github.com/docker/docker/client"
+	"github.com/docker/docker/errdefs"
 )
 
+// OfflineEnvVar enables offline mode when set to "1" (also set by the global --no-pull flag)
+// In offline mode images are never pulled from a registry
+const OfflineEnvVar = "ORK_OFFLINE"
+
 // Client wraps the Docker SDK client with Ork-specific functionality
 type Client struct {
-	cli *client.Client
+	cli     *client.Client
+	offline bool // Never pull images (see OfflineEnvVar)
+
+	closeOnce sync.Once // Close releases cli only once, so it's safe to call again (e.g., from a defer)
+	closeErr  error     // Result of the first Close
 }
 
 // NewClient creates a new Docker client and verifies Docker is running
@@ -21,19 +33,69 @@ func NewClient() (*Client, error) {
 	}
 
 	// Verify Docker daemon is reachable
-	ctx := context.Background()
-	_, err = cli.Ping(ctx)
+	ping, err := pingDaemon(context.Background(), cli)
 	if err != nil {
-		return nil, fmt.Errorf("failed to connect to Docker daemon: %w\n💡 Is Docker running? Try 'docker ps' or start Docker Desktop", err)
+		_ = cli.Close()
+		return nil, err
 	}
 
-	return &Client{cli: cli}, nil
+	// Negotiate the API version up front, so concurrent first requests don't race to do it
+	cli.NegotiateAPIVersionPing(ping)
+
+	return &Client{cli: cli, offline: os.Getenv(OfflineEnvVar) == "1"}, nil
 }
 
 // Close releases resources used by the Docker client
+// Safe to call more than once: later calls return the first call's result
 func (c *Client) Close() error {
-	if c.cli != nil {
-		return c.cli.Close()
+	c.closeOnce.Do(func() {
+		if c.cli != nil {
+			c.closeErr = c.cli.Close()
+		}
+	})
+	return c.closeErr
+}
+
+// Ping checks that the Docker daemon is still reachable
+// An unreachable daemon is reported with IsDaemonUnavailable, so callers can tell it apart from other failures
+func (c *Client) Ping(ctx context.Context) error {
+	_, err := pingDaemon(ctx, c.cli)
+	return err
+}
+
+// ServerVersion pings the Docker daemon and returns its version (e.g., "27.3.1")
+func (c *Client) ServerVersion(ctx context.Context) (string, error) {
+	version, err := c.cli.ServerVersion(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to get Docker version: %w", err)
+	}
+	return version.Version, nil
+}
+
+// IsOffline reports whether images may not be pulled (see OfflineEnvVar)
+func (c *Client) IsOffline() bool {
+	return c.offline
+}
+
+// IsDaemonUnavailable reports whether err (or an error it wraps) means the Docker daemon couldn't be reached
+func IsDaemonUnavailable(err error) bool {
+	return client.IsErrConnectionFailed(err)
+}
+
+// IsNotFound reports whether err (or an error it wraps) means the container, image, or network doesn't exist
+func IsNotFound(err error) bool {
+	return errdefs.IsNotFound(err)
+}
+
+// pingDaemon pings the Docker daemon, describing an unreachable daemon separately from other failures
+func pingDaemon(ctx context.Context, cli *client.Client) (types.Ping, error) {
+	ping, err := cli.Ping(ctx)
+	switch {
+	case err == nil:
+		return ping, nil
+	case client.IsErrConnectionFailed(err):
+		return ping, fmt.Errorf("failed to connect to Docker daemon: %w\n💡 Is Docker running? Try 'docker ps' or start Docker Desktop", err)
+	default:
+		return ping, fmt.Errorf("failed to ping Docker daemon: %w\n💡 Check its status with 'docker info'", err)
 	}
-	return nil
 }
@@ -0,0 +1,148 @@
+package docker_test
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"testing"
+
+	"github.com/ork-cli/ork/internal/docker"
+	"github.com/ork-cli/ork/internal/docker/dockertest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// ============================================================================
+// Daemon and Image Query Tests
+// ============================================================================
+
+func TestServerVersion(t *testing.T) {
+	_, client := dockertest.NewServer(t)
+
+	version, err := client.ServerVersion(context.Background())
+
+	require.NoError(t, err)
+	assert.Equal(t, "27.0.0-fake", version)
+}
+
+func TestImageExists(t *testing.T) {
+	fake, client := dockertest.NewServer(t)
+	fake.RemoveImage("postgres:15")
+
+	present, err := client.ImageExists(context.Background(), "redis:7")
+	require.NoError(t, err)
+	assert.True(t, present)
+
+	present, err = client.ImageExists(context.Background(), "postgres:15")
+	require.NoError(t, err)
+	assert.False(t, present, "a missing image is not an error")
+
+	assert.False(t, fake.HasRequest("POST /images/create"), "checking must never pull")
+}
+
+func TestImageExists_DaemonError(t *testing.T) {
+	fake, client := dockertest.NewServer(t)
+	fake.Handle(http.MethodGet, "/images/", func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+		_, _ = w.Write([]byte(`{"message":"storage driver error"}`))
+	})
+
+	_, err := client.ImageExists(context.Background(), "redis:7")
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "redis:7")
+}
+
+func TestIsOffline(t *testing.T) {
+	t.Setenv(docker.OfflineEnvVar, "1")
+	_, client := dockertest.NewServer(t)
+
+	assert.True(t, client.IsOffline())
+}
+
+// ============================================================================
+// Connection Tests
+// ============================================================================
+
+func TestClose_Idempotent(t *testing.T) {
+	_, client := dockertest.NewServer(t)
+
+	require.NoError(t, client.Close())
+	assert.NoError(t, client.Close(), "a second close is a no-op")
+}
+
+func TestPing(t *testing.T) {
+	fake, client := dockertest.NewServer(t)
+
+	before := len(fake.Requests())
+
+	require.NoError(t, client.Ping(context.Background()))
+	assert.Greater(t, len(fake.Requests()), before, "each ping reaches the daemon")
+}
+
+func TestPing_DaemonError(t *testing.T) {
+	fake, client := dockertest.NewServer(t)
+	failPing := func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}
+	fake.Handle(http.MethodHead, "/_ping", failPing)
+	fake.Handle(http.MethodGet, "/_ping", failPing)
+
+	err := client.Ping(context.Background())
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to ping Docker daemon")
+	assert.False(t, docker.IsDaemonUnavailable(err), "a daemon that answers is reachable")
+}
+
+func TestBuildKitAvailable(t *testing.T) {
+	fake, client := dockertest.NewServer(t)
+	advertise := func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Builder-Version", "2")
+		_, _ = w.Write([]byte("OK"))
+	}
+	fake.Handle(http.MethodHead, "/_ping", advertise)
+	fake.Handle(http.MethodGet, "/_ping", advertise)
+
+	buildKit, err := client.BuildKitAvailable(context.Background())
+	require.NoError(t, err)
+	assert.True(t, buildKit)
+
+	t.Setenv("DOCKER_BUILDKIT", "0")
+	buildKit, err = client.BuildKitAvailable(context.Background())
+	require.NoError(t, err)
+	assert.False(t, buildKit, "DOCKER_BUILDKIT=0 opts out")
+}
+
+func TestBuildKitAvailable_PingErrorFallsBack(t *testing.T) {
+	fake, client := dockertest.NewServer(t)
+	failPing := func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}
+	fake.Handle(http.MethodHead, "/_ping", failPing)
+	fake.Handle(http.MethodGet, "/_ping", failPing)
+
+	buildKit, err := client.BuildKitAvailable(context.Background())
+
+	require.NoError(t, err, "a daemon that answers can still build with the legacy builder")
+	assert.False(t, buildKit)
+}
+
+func TestNewClient_DaemonUnavailable(t *testing.T) {
+	// Reserve a port and release it, so nothing is listening there
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	addr := listener.Addr().String()
+	require.NoError(t, listener.Close())
+
+	t.Setenv("DOCKER_HOST", "tcp://"+addr)
+	t.Setenv("DOCKER_TLS_VERIFY", "")
+	t.Setenv("DOCKER_CERT_PATH", "")
+
+	client, err := docker.NewClient()
+
+	assert.Nil(t, client)
+	require.Error(t, err)
+	assert.True(t, docker.IsDaemonUnavailable(err))
+	assert.Contains(t, err.Error(), "Is Docker running?")
+}
@@ -6,12 +6,19 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
 
 	"github.com/docker/docker/api/types/container"
 	"github.com/docker/docker/api/types/filters"
 	"github.com/docker/docker/api/types/image"
+	"github.com/docker/docker/errdefs"
 	"github.com/docker/docker/pkg/stdcopy"
 	"github.com/docker/go-connections/nat"
+	"github.com/moby/term"
+	"github.com/ork-cli/ork/pkg/utils"
 )
 
 // ============================================================================
@@ -30,11 +37,24 @@ const (
 type RunOptions struct {
 	Name       string            // Container name
 	Image      string            // Docker image (e.g., "nginx:alpine")
-	Ports      map[string]string // Port mappings (e.g., "8080": "80")
+	Ports      map[string]string // Port mappings (e.g., "8080": "80", "5353/udp": "53/udp")
 	Env        map[string]string // Environment variables
 	Labels     map[string]string // Container labels
 	Command    []string          // Override command
 	Entrypoint []string          // Override entrypoint
+	Volumes    []string          // Volume mounts in Docker bind format (e.g., "/host/data:/data:ro")
+
+	DisableHealthcheck bool // Turn off the image's built-in HEALTHCHECK
+
+	RestartPolicy     string // Docker restart policy ("no", "always", "on-failure", "unless-stopped"; empty means "no")
+	RestartMaxRetries int    // Max restart attempts for "on-failure" (0 means unlimited)
+
+	NanoCPUs    int64 // CPU limit in units of 1e-9 CPUs (0 means unlimited)
+	MemoryBytes int64 // Memory limit in bytes (0 means unlimited)
+
+	NetworkMode string // Docker network mode ("host", "none"; empty means Docker's default bridge)
+
+	PullPolicy PullPolicy // When to pull the image (empty means PullMissing)
 }
 
 // ContainerInfo represents information about a running container
@@ -47,12 +67,73 @@ type ContainerInfo struct {
 	Labels map[string]string // Container labels
 }
 
+// ContainerDetails represents the detailed state of a single container (from inspect)
+type ContainerDetails struct {
+	ID         string            // Full container ID
+	Name       string            // Container name (without the leading slash)
+	Image      string            // Image the container was created from
+	State      string            // Container state (e.g., "running", "exited")
+	Running    bool              // Whether the container is running
+	Health     string            // Native HEALTHCHECK status ("starting", "healthy", "unhealthy"), empty if none
+	ExitCode   int               // Exit code of the last run (only meaningful once exited)
+	StartedAt  time.Time         // When the container was last started (zero if never)
+	FinishedAt time.Time         // When the container last exited (zero if never)
+	Labels     map[string]string // Container labels
+
+	Created      time.Time // When the container was created
+	RestartCount int       // Times Docker has restarted the container (via its restart policy)
+	Ports        []string  // All container ports, published ("8080:80/tcp") or exposed only ("9090/tcp")
+}
+
+// ExecOptions contains configuration for running an attached command in a container
+type ExecOptions struct {
+	Stdin  io.Reader // Input stream (nil to not attach stdin)
+	Stdout io.Writer // Output stream (defaults to os.Stdout)
+	Stderr io.Writer // Error stream (defaults to os.Stderr, unused with TTY)
+	TTY    bool      // Allocate a pseudo-terminal (puts a terminal stdin into raw mode)
+}
+
+// ExecExitError reports that a command run with Exec exited with a non-zero code
+type ExecExitError struct {
+	Code int // The command's exit code
+}
+
+// Error implements the error interface
+func (e *ExecExitError) Error() string {
+	return fmt.Sprintf("command exited with code %d", e.Code)
+}
+
+// RunTimings records how long each phase of Run took
+type RunTimings struct {
+	Pull   time.Duration // Checking for (and pulling, if missing) the image
+	Create time.Duration // Creating the container
+	Start  time.Duration // Starting the container
+}
+
 // LogsOptions contains configuration for retrieving container logs
 type LogsOptions struct {
 	Follow     bool                // Stream logs continuously (like tail -f)
 	Tail       string              // Number of lines to show from the end ("all" or "100")
 	Timestamps bool                // Show timestamps in log output
+	Since      string              // Only show logs since this time (Unix seconds or RFC3339, as Docker accepts), empty for all
 	Formatter  func(string) string // Optional: format each log line before output
+	Filter     func(string) bool   // Optional: drop lines for which this returns false
+	Prefix     func(string) string // Optional (LogsMulti only): tag for each line, given the container's name
+	Output     io.Writer           // Optional (LogsMulti only): where lines are written (defaults to os.Stdout)
+
+	OnReconnect func() // Optional (Logs with Follow only): called before re-attaching to a restarted container
+}
+
+// StopOptions controls how a container is stopped
+type StopOptions struct {
+	Timeout     time.Duration // Time between the stop signal and SIGKILL
+	GracePeriod time.Duration // Time to wait for an exit after the first stop signal, before Timeout starts (0 skips it)
+}
+
+// ContainerRef identifies a container to stream logs from, along with its display name
+type ContainerRef struct {
+	ID   string // Container ID
+	Name string // Name shown in the line prefix (usually the service name)
 }
 
 // ============================================================================
@@ -62,41 +143,59 @@ type LogsOptions struct {
 // Run creates and starts a Docker container
 // This orchestrates the full container lifecycle but delegates to specialized functions
 func (c *Client) Run(ctx context.Context, opts RunOptions) (containerID string, err error) {
+	containerID, _, err = c.RunWithTimings(ctx, opts)
+	return containerID, err
+}
+
+// RunWithTimings behaves like Run and also reports how long each phase took
+func (c *Client) RunWithTimings(ctx context.Context, opts RunOptions) (containerID string, timings RunTimings, err error) {
 	// Ensure the image is available locally
-	if err := c.pullImageIfNeeded(ctx, opts.Image); err != nil {
-		return "", err
+	pullStart := time.Now()
+	if err := c.pullImageIfNeeded(ctx, opts.Image, opts.PullPolicy); err != nil {
+		return "", timings, err
 	}
+	timings.Pull = time.Since(pullStart)
 
 	// Build container configuration
 	config, err := buildContainerConfig(opts)
 	if err != nil {
-		return "", err
+		return "", timings, err
 	}
 
 	// Build host configuration
 	hostConfig := buildHostConfig(opts)
 
 	// Create and start the container
-	containerID, err = c.createAndStartContainer(ctx, config, hostConfig, opts.Name)
+	containerID, err = c.createAndStartContainer(ctx, config, hostConfig, opts.Name, &timings)
 	if err != nil {
-		return "", err
+		return "", timings, err
 	}
 
-	return containerID, nil
+	return containerID, timings, nil
 }
 
 // Stop stops a running Docker container
-func (c *Client) Stop(ctx context.Context, containerID string) error {
+// The shutdown runs in two phases: with a grace period, the container is sent its stop signal and given that
+// long to exit on its own; if it is still running (or there is no grace period), it is stopped with
+// opts.Timeout between the stop signal and SIGKILL (rounded up to whole seconds)
+func (c *Client) Stop(ctx context.Context, containerID string, opts StopOptions) error {
 	if containerID == "" {
 		return fmt.Errorf(errContainerIDEmpty)
 	}
 
-	// Stop the container (with a 10-second timeout for graceful shutdown)
-	timeout := 10
-	stopOptions := container.StopOptions{
-		Timeout: &timeout,
+	if opts.GracePeriod > 0 {
+		exited, err := c.waitForGracefulExit(ctx, containerID, opts.GracePeriod)
+		if err != nil {
+			return err
+		}
+		if exited {
+			return nil
+		}
 	}
 
+	stopOptions := container.StopOptions{
+		Timeout: stopTimeoutSeconds(opts.Timeout),
+	}
 	if err := c.cli.ContainerStop(ctx, containerID, stopOptions); err != nil {
 		return fmt.Errorf("failed to stop container %s: %w", containerID, err)
 	}
@@ -111,20 +210,84 @@ func (c *Client) Remove(ctx context.Context, containerID string) error {
 	}
 
 	removeOptions := container.RemoveOptions{
-		Force: false, // Don't force-remove running containers
+		Force:         false, // Don't force-remove running containers
+		RemoveVolumes: false, // Keep volumes, so a recreated container mounts the same data
 	}
 
-	if err := c.cli.ContainerRemove(ctx, containerID, removeOptions); err != nil {
+	// Mounts can stay busy for a moment after a container stops, so retry that specific failure
+	err := utils.Retry(ctx, removeRetryOptions, func() error {
+		return c.cli.ContainerRemove(ctx, containerID, removeOptions)
+	})
+	if err != nil {
 		return fmt.Errorf("failed to remove container %s: %w", containerID, err)
 	}
 
 	return nil
 }
 
+// removeRetryOptions retries container removal while the daemon reports a busy device
+var removeRetryOptions = utils.RetryOptions{
+	Attempts:    3,
+	Delay:       250 * time.Millisecond,
+	ShouldRetry: isDeviceBusy,
+}
+
+// isDeviceBusy reports whether an error is the transient "device or resource busy" removal failure
+func isDeviceBusy(err error) bool {
+	return err != nil && strings.Contains(err.Error(), "device or resource busy")
+}
+
+// waitForGracefulExit sends the container's stop signal and waits up to grace for it to stop running
+// Returns false (without an error) when the container is still running once grace has passed
+func (c *Client) waitForGracefulExit(ctx context.Context, containerID string, grace time.Duration) (bool, error) {
+	if err := c.cli.ContainerKill(ctx, containerID, c.stopSignal(ctx, containerID)); err != nil {
+		// The container has already stopped
+		if errdefs.IsConflict(err) {
+			return true, nil
+		}
+		return false, fmt.Errorf("failed to signal container %s: %w", containerID, err)
+	}
+
+	waitCtx, cancel := context.WithTimeout(ctx, grace)
+	defer cancel()
+
+	statusCh, errCh := c.cli.ContainerWait(waitCtx, containerID, container.WaitConditionNotRunning)
+	select {
+	case <-statusCh:
+		return true, nil
+	case err := <-errCh:
+		// Out of grace (but not cancelled by the caller): fall back to a regular stop
+		if ctx.Err() == nil && waitCtx.Err() != nil {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed waiting for container %s to exit: %w", containerID, err)
+	}
+}
+
+// stopSignal returns the signal that asks a container to stop: its image's or config's STOPSIGNAL,
+// or SIGTERM (Docker's default) when none is set or the container can't be inspected
+func (c *Client) stopSignal(ctx context.Context, containerID string) string {
+	resp, err := c.cli.ContainerInspect(ctx, containerID)
+	if err != nil || resp.Config == nil || resp.Config.StopSignal == "" {
+		return "SIGTERM"
+	}
+	return resp.Config.StopSignal
+}
+
+// stopTimeoutSeconds converts a stop timeout to the whole seconds the Docker API expects
+// Partial seconds round up, so a short timeout never becomes an immediate kill
+func stopTimeoutSeconds(timeout time.Duration) *int {
+	seconds := int((timeout + time.Second - 1) / time.Second)
+	if seconds < 0 {
+		seconds = 0
+	}
+	return &seconds
+}
+
 // StopAndRemove stops and removes a Docker container
-func (c *Client) StopAndRemove(ctx context.Context, containerID string) error {
+func (c *Client) StopAndRemove(ctx context.Context, containerID string, opts StopOptions) error {
 	// Stop first
-	if err := c.Stop(ctx, containerID); err != nil {
+	if err := c.Stop(ctx, containerID, opts); err != nil {
 		return err
 	}
 
@@ -143,19 +306,36 @@ func (c *Client) StopAndRemove(ctx context.Context, containerID string) error {
 // List returns a list of containers managed by Ork
 func (c *Client) List(ctx context.Context, projectName string) ([]ContainerInfo, error) {
 	// Build filters to only show Ork-managed containers
-	filterArgs := buildOrkFilters(projectName)
+	return c.listWithFilters(ctx, buildOrkFilters(projectName))
+}
 
-	// List containers
-	containers, err := c.cli.ContainerList(ctx, container.ListOptions{
-		All:     true, // Include stopped containers
-		Filters: filterArgs,
-	})
+// ListByService returns the Ork-managed containers for a single service of a project
+// The daemon applies the service filter, so only matching containers are transferred
+func (c *Client) ListByService(ctx context.Context, projectName, serviceName string) ([]ContainerInfo, error) {
+	return c.listWithFilters(ctx, buildServiceFilters(projectName, serviceName))
+}
+
+// Inspect returns detailed state for a single container, including native health status
+func (c *Client) Inspect(ctx context.Context, containerID string) (*ContainerDetails, error) {
+	if containerID == "" {
+		return nil, fmt.Errorf(errContainerIDEmpty)
+	}
+
+	resp, err := c.cli.ContainerInspect(ctx, containerID)
 	if err != nil {
-		return nil, fmt.Errorf("failed to list containers: %w", err)
+		return nil, fmt.Errorf("failed to inspect container %s: %w", containerID, err)
 	}
 
-	// Convert to our ContainerInfo format
-	return convertToContainerInfo(containers), nil
+	return convertToContainerDetails(resp), nil
+}
+
+// PullImage pulls the latest version of an image, even if it already exists locally
+// Used to refresh tags like ":latest" that move upstream; fails in offline mode
+func (c *Client) PullImage(ctx context.Context, imageName string) error {
+	if c.offline {
+		return errOfflinePull(imageName)
+	}
+	return c.pullImage(ctx, imageName)
 }
 
 // ============================================================================
@@ -164,11 +344,344 @@ func (c *Client) List(ctx context.Context, projectName string) ([]ContainerInfo,
 
 // Logs retrieves and streams container logs to stdout
 // This is useful for debugging and monitoring container output
+// When following, a stream that ends because the container restarted is re-attached
+// once the container is running again (see waitForLogsReconnect), including a container
+// recreated for the same Ork service (e.g., by 'ork restart')
 func (c *Client) Logs(ctx context.Context, containerID string, opts LogsOptions) error {
-	// Validate input
+	var project, service string
+	if opts.Follow {
+		if resp, err := c.cli.ContainerInspect(ctx, containerID); err == nil && resp.Config != nil {
+			project, service = resp.Config.Labels["ork.project"], resp.Config.Labels["ork.service"]
+		}
+	}
+
+	open := func(opts LogsOptions) (io.ReadCloser, error) {
+		return c.openLogs(ctx, containerID, opts)
+	}
+	reconnect := func() bool {
+		id, ok := c.waitForLogsReconnect(ctx, containerID, project, service)
+		if ok {
+			containerID = id
+		}
+		return ok
+	}
+	return followLogs(opts, open, printLogs, reconnect)
+}
+
+// LogsMulti streams logs from several containers at once, interleaved line by line
+// Each line is prefixed with the container's name (see LogsOptions.Prefix) and written through
+// a shared writer, so lines from different containers never interleave mid-line
+func (c *Client) LogsMulti(ctx context.Context, refs []ContainerRef, opts LogsOptions) error {
+	if len(refs) == 0 {
+		return fmt.Errorf("no containers to stream logs from")
+	}
+
+	output := opts.Output
+	if output == nil {
+		output = os.Stdout
+	}
+	writer := newLineWriter(output)
+
+	prefix := opts.Prefix
+	if prefix == nil {
+		prefix = func(name string) string { return "[" + name + "] " }
+	}
+
+	// Fan out one reader per container
+	var wg sync.WaitGroup
+	errChan := make(chan error, len(refs))
+
+	for _, ref := range refs {
+		wg.Add(1)
+		go func(ref ContainerRef) {
+			defer wg.Done()
+
+			reader, err := c.openLogs(ctx, ref.ID, opts)
+			if err != nil {
+				errChan <- err
+				return
+			}
+			defer closeLogsReader(reader)
+
+			tag := prefix(ref.Name)
+			err = scanLogLines(reader, func(line string) {
+				if formatted, keep := applyLogOptions(line, opts); keep {
+					_ = writer.WriteLine(tag + formatted)
+				}
+			})
+			if err != nil {
+				errChan <- fmt.Errorf("%s: %w", ref.Name, err)
+			}
+		}(ref)
+	}
+
+	// Wait for every stream to end
+	wg.Wait()
+	close(errChan)
+
+	// Collect errors
+	var errors []error
+	for err := range errChan {
+		errors = append(errors, err)
+	}
+	if len(errors) > 0 {
+		return fmt.Errorf("failed to stream logs for some containers: %v", errors)
+	}
+
+	return nil
+}
+
+// ============================================================================
+// Public Methods - Container Exec
+// ============================================================================
+
+// execPollInterval is how often ExecExitCode checks whether the command has finished
+const execPollInterval = 100 * time.Millisecond
+
+// ExecExitCode runs a command inside a running container without attaching to it
+// Returns the command's exit code - a non-zero exit code is not treated as an error
+func (c *Client) ExecExitCode(ctx context.Context, containerID string, cmd []string) (int, error) {
+	if containerID == "" {
+		return 0, fmt.Errorf(errContainerIDEmpty)
+	}
+	if len(cmd) == 0 {
+		return 0, fmt.Errorf("exec command cannot be empty")
+	}
+
+	// Create the exec instance
+	created, err := c.cli.ContainerExecCreate(ctx, containerID, container.ExecOptions{Cmd: cmd})
+	if err != nil {
+		return 0, fmt.Errorf("failed to create exec in container %s: %w", containerID, err)
+	}
+
+	// Start it detached - we only care about the exit code, not the output
+	if err := c.cli.ContainerExecStart(ctx, created.ID, container.ExecStartOptions{Detach: true}); err != nil {
+		return 0, fmt.Errorf("failed to start exec in container %s: %w", containerID, err)
+	}
+
+	// Poll until the command exits
+	ticker := time.NewTicker(execPollInterval)
+	defer ticker.Stop()
+
+	for {
+		inspect, err := c.cli.ContainerExecInspect(ctx, created.ID)
+		if err != nil {
+			return 0, fmt.Errorf("failed to inspect exec in container %s: %w", containerID, err)
+		}
+		if !inspect.Running {
+			return inspect.ExitCode, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return 0, ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// Exec runs a command inside a running container with its streams attached
+// Returns an *ExecExitError if the command exited with a non-zero code, or another error if it could not be run
+func (c *Client) Exec(ctx context.Context, containerID string, cmd []string, opts ExecOptions) error {
 	if containerID == "" {
 		return fmt.Errorf(errContainerIDEmpty)
 	}
+	if len(cmd) == 0 {
+		return fmt.Errorf("exec command cannot be empty")
+	}
+	if opts.Stdout == nil {
+		opts.Stdout = os.Stdout
+	}
+	if opts.Stderr == nil {
+		opts.Stderr = os.Stderr
+	}
+
+	// Create the exec instance with the requested streams
+	created, err := c.cli.ContainerExecCreate(ctx, containerID, container.ExecOptions{
+		Cmd:          cmd,
+		Tty:          opts.TTY,
+		AttachStdin:  opts.Stdin != nil,
+		AttachStdout: true,
+		AttachStderr: true,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create exec in container %s: %w", containerID, err)
+	}
+
+	// Attaching also starts the command
+	resp, err := c.cli.ContainerExecAttach(ctx, created.ID, container.ExecAttachOptions{Tty: opts.TTY})
+	if err != nil {
+		return fmt.Errorf("failed to attach to exec in container %s: %w", containerID, err)
+	}
+	defer resp.Close()
+
+	// Put the local terminal into raw mode so keystrokes pass straight through
+	if opts.TTY && opts.Stdin != nil {
+		if fd, isTerminal := term.GetFdInfo(opts.Stdin); isTerminal {
+			state, err := term.SetRawTerminal(fd)
+			if err == nil {
+				defer func() {
+					_ = term.RestoreTerminal(fd, state)
+				}()
+			}
+		}
+	}
+
+	// Forward stdin until it closes, then signal EOF to the command
+	if opts.Stdin != nil {
+		go func() {
+			_, _ = io.Copy(resp.Conn, opts.Stdin)
+			_ = resp.CloseWrite()
+		}()
+	}
+
+	// Copy output - TTY output is a raw stream, otherwise it's multiplexed
+	if opts.TTY {
+		_, err = io.Copy(opts.Stdout, resp.Reader)
+	} else {
+		_, err = stdcopy.StdCopy(opts.Stdout, opts.Stderr, resp.Reader)
+	}
+	if err != nil && err != io.EOF {
+		return fmt.Errorf("failed to stream exec output: %w", err)
+	}
+
+	// Report the command's exit status
+	inspect, err := c.cli.ContainerExecInspect(ctx, created.ID)
+	if err != nil {
+		return fmt.Errorf("failed to inspect exec in container %s: %w", containerID, err)
+	}
+	if inspect.ExitCode != 0 {
+		return &ExecExitError{Code: inspect.ExitCode}
+	}
+
+	return nil
+}
+
+// ============================================================================
+// Private Helpers - Logs-related
+// ============================================================================
+
+// maxLogLineLength is the longest log line the scanner accepts (default is 64KB, set to 1MB)
+const maxLogLineLength = 1024 * 1024
+
+// logsReconnectBackoff is how long follow mode waits before each check for a restarted container
+// Once every wait has passed without the container running again, streaming stops
+var logsReconnectBackoff = []time.Duration{
+	250 * time.Millisecond,
+	500 * time.Millisecond,
+	1 * time.Second,
+	2 * time.Second,
+	4 * time.Second,
+}
+
+// followLogs streams one log stream with stream, and in follow mode keeps re-opening it
+// for as long as reconnect reports the container came back
+// Re-opened streams only start from when the previous one ended, so no lines are repeated
+func followLogs(opts LogsOptions, open func(LogsOptions) (io.ReadCloser, error), stream func(io.Reader, LogsOptions) error, reconnect func() bool) error {
+	for {
+		reader, err := open(opts)
+		if err != nil {
+			return err
+		}
+		err = stream(reader, opts)
+		closeLogsReader(reader)
+		if err != nil {
+			return err
+		}
+
+		// A followed stream only ends when the container stops (or restarts)
+		if !opts.Follow {
+			return nil
+		}
+		endedAt := time.Now()
+		if !reconnect() {
+			return nil
+		}
+
+		if opts.OnReconnect != nil {
+			opts.OnReconnect()
+		}
+		opts.Since = formatUnixTimestamp(endedAt)
+		opts.Tail = ""
+	}
+}
+
+// printLogs writes one log stream to stdout, formatted and filtered per opts
+func printLogs(reader io.Reader, opts LogsOptions) error {
+	// If no formatter or filter is provided, just demultiplex and copy to stdout (legacy behavior)
+	if opts.Formatter == nil && opts.Filter == nil {
+		_, err := stdcopy.StdCopy(os.Stdout, os.Stderr, reader)
+		if err != nil && err != io.EOF {
+			return fmt.Errorf("failed to stream logs: %w", err)
+		}
+		return nil
+	}
+
+	// With formatter or filter: demultiplex streams and process line by line
+	return scanLogLines(reader, func(line string) {
+		if formatted, keep := applyLogOptions(line, opts); keep {
+			fmt.Println(formatted)
+		}
+	})
+}
+
+// waitForLogsReconnect waits, with backoff, for a container whose log stream ended to be running again
+// For an Ork container (project and service set), each attempt looks the service's container up again by
+// its labels, so a container recreated under a new ID is followed too. Returns the ID to stream from next.
+// Returns false once the container is removed, the waits run out, or ctx is cancelled.
+// While Docker reports the container as restarting, it keeps waiting without using up the backoff
+func (c *Client) waitForLogsReconnect(ctx context.Context, containerID, project, service string) (string, bool) {
+	for attempt := 0; attempt < len(logsReconnectBackoff); {
+		select {
+		case <-ctx.Done():
+			return "", false
+		case <-time.After(logsReconnectBackoff[attempt]):
+		}
+
+		id := containerID
+		if project != "" && service != "" {
+			containers, err := c.ListByService(ctx, project, service)
+			if err == nil {
+				if len(containers) == 0 {
+					return "", false // The service's container was removed
+				}
+				id = pickLogsContainer(containers)
+			}
+		}
+
+		resp, err := c.cli.ContainerInspect(ctx, id)
+		if errdefs.IsNotFound(err) {
+			return "", false
+		}
+		if err == nil && resp.State != nil {
+			if resp.State.Running {
+				return resp.ID, true
+			}
+			if resp.State.Restarting {
+				continue
+			}
+		}
+		attempt++
+	}
+	return "", false
+}
+
+// pickLogsContainer picks the container to follow from a service's containers, preferring a running one
+func pickLogsContainer(containers []ContainerInfo) string {
+	for _, info := range containers {
+		if strings.HasPrefix(info.Status, "Up") {
+			return info.ID
+		}
+	}
+	return containers[0].ID
+}
+
+// openLogs requests a container's log stream from Docker
+func (c *Client) openLogs(ctx context.Context, containerID string, opts LogsOptions) (io.ReadCloser, error) {
+	// Validate input
+	if containerID == "" {
+		return nil, fmt.Errorf(errContainerIDEmpty)
+	}
 
 	// Build Docker API log options
 	logOptions := container.LogsOptions{
@@ -177,29 +690,31 @@ func (c *Client) Logs(ctx context.Context, containerID string, opts LogsOptions)
 		Follow:     opts.Follow,     // Stream continuously if requested
 		Timestamps: opts.Timestamps, // Show timestamps if requested
 		Tail:       opts.Tail,       // Limit output if specified
+		Since:      opts.Since,      // Start of the time window, if any
 	}
 
 	// Get log reader from Docker
 	reader, err := c.cli.ContainerLogs(ctx, containerID, logOptions)
 	if err != nil {
-		return fmt.Errorf("failed to get logs for container %s: %w\n💡 Check if container exists with 'ork ps'", containerID, err)
+		return nil, fmt.Errorf("failed to get logs for container %s: %w\n💡 Check if container exists with 'ork ps'", containerID, err)
 	}
-	defer func() {
-		if closeErr := reader.Close(); closeErr != nil {
-			fmt.Printf("⚠️  Warning: failed to close logs reader: %v\n", closeErr)
-		}
-	}()
+	return reader, nil
+}
 
-	// If no formatter is provided, just demultiplex and copy to stdout (legacy behavior)
-	if opts.Formatter == nil {
-		_, err = stdcopy.StdCopy(os.Stdout, os.Stderr, reader)
-		if err != nil && err != io.EOF {
-			return fmt.Errorf("failed to stream logs: %w", err)
-		}
-		return nil
+// formatUnixTimestamp renders a time as "seconds.nanoseconds", the form Docker uses for log windows
+func formatUnixTimestamp(t time.Time) string {
+	return fmt.Sprintf("%d.%09d", t.Unix(), t.Nanosecond())
+}
+
+// closeLogsReader closes a log stream, warning on failure
+func closeLogsReader(reader io.ReadCloser) {
+	if closeErr := reader.Close(); closeErr != nil {
+		fmt.Printf("⚠️  Warning: failed to close logs reader: %v\n", closeErr)
 	}
+}
 
-	// With formatter: demultiplex streams and process line by line
+// scanLogLines demultiplexes a Docker log stream and calls handle for every line
+func scanLogLines(reader io.Reader, handle func(line string)) error {
 	// Create a pipe to capture the demultiplexed output
 	pr, pw := io.Pipe()
 
@@ -216,17 +731,11 @@ func (c *Client) Logs(ctx context.Context, containerID string, opts LogsOptions)
 
 	// Process demultiplexed output line by line
 	scanner := bufio.NewScanner(pr)
-	// Increase the buffer size for long log lines (default is 64KB, set to 1MB)
-	const maxLogLineLength = 1024 * 1024
 	buf := make([]byte, maxLogLineLength)
 	scanner.Buffer(buf, maxLogLineLength)
 
 	for scanner.Scan() {
-		line := scanner.Text()
-
-		// Apply formatter and print
-		formattedLine := opts.Formatter(line)
-		fmt.Println(formattedLine)
+		handle(scanner.Text())
 	}
 
 	// Check for scanner errors
@@ -242,6 +751,39 @@ func (c *Client) Logs(ctx context.Context, containerID string, opts LogsOptions)
 	return nil
 }
 
+// applyLogOptions filters and formats a single log line
+// Returns false if the line should be dropped
+func applyLogOptions(line string, opts LogsOptions) (string, bool) {
+	// Drop filtered lines before they are formatted
+	if opts.Filter != nil && !opts.Filter(line) {
+		return "", false
+	}
+
+	if opts.Formatter != nil {
+		line = opts.Formatter(line)
+	}
+	return line, true
+}
+
+// lineWriter serializes whole-line writes from concurrent log streams
+type lineWriter struct {
+	mu sync.Mutex
+	w  io.Writer
+}
+
+// newLineWriter wraps w so that concurrent WriteLine calls never interleave
+func newLineWriter(w io.Writer) *lineWriter {
+	return &lineWriter{w: w}
+}
+
+// WriteLine writes a single line followed by a newline as one atomic write
+func (lw *lineWriter) WriteLine(line string) error {
+	lw.mu.Lock()
+	defer lw.mu.Unlock()
+	_, err := io.WriteString(lw.w, line+"\n")
+	return err
+}
+
 // ============================================================================
 // Private Helpers - Run-related
 // ============================================================================
@@ -262,6 +804,11 @@ func buildContainerConfig(opts RunOptions) (*container.Config, error) {
 		config.Entrypoint = opts.Entrypoint
 	}
 
+	// "NONE" disables any HEALTHCHECK inherited from the image
+	if opts.DisableHealthcheck {
+		config.Healthcheck = &container.HealthConfig{Test: []string{"NONE"}}
+	}
+
 	// Add exposed ports
 	if len(opts.Ports) > 0 {
 		exposedPorts, err := createExposedPorts(opts.Ports)
@@ -277,17 +824,39 @@ func buildContainerConfig(opts RunOptions) (*container.Config, error) {
 // buildHostConfig creates the host configuration from options
 func buildHostConfig(opts RunOptions) *container.HostConfig {
 	return &container.HostConfig{
-		PortBindings: convertPortsToBindings(opts.Ports),
-		AutoRemove:   false, // Keep containers for debugging
+		PortBindings:  convertPortsToBindings(opts.Ports),
+		Binds:         opts.Volumes,
+		AutoRemove:    false, // Keep containers for debugging
+		RestartPolicy: buildRestartPolicy(opts),
+		NetworkMode:   container.NetworkMode(opts.NetworkMode),
+		Resources: container.Resources{
+			NanoCPUs: opts.NanoCPUs,
+			Memory:   opts.MemoryBytes,
+		},
 	}
 }
 
+// buildRestartPolicy converts the run options' restart policy to Docker's format
+// The retry count only applies to on-failure, since Docker rejects it for other policies
+func buildRestartPolicy(opts RunOptions) container.RestartPolicy {
+	if opts.RestartPolicy == "" {
+		return container.RestartPolicy{Name: container.RestartPolicyDisabled}
+	}
+
+	policy := container.RestartPolicy{Name: container.RestartPolicyMode(opts.RestartPolicy)}
+	if policy.Name == container.RestartPolicyOnFailure {
+		policy.MaximumRetryCount = opts.RestartMaxRetries
+	}
+	return policy
+}
+
 // createExposedPorts converts a port map to Docker's exposed ports format
 func createExposedPorts(ports map[string]string) (nat.PortSet, error) {
 	exposedPorts := make(nat.PortSet)
 
 	for _, containerPort := range ports {
-		port, err := nat.NewPort("tcp", containerPort)
+		portNumber, protocol := splitPortProtocol(containerPort)
+		port, err := nat.NewPort(protocol, portNumber)
 		if err != nil {
 			return nil, fmt.Errorf("invalid port %s: %w", containerPort, err)
 		}
@@ -297,32 +866,71 @@ func createExposedPorts(ports map[string]string) (nat.PortSet, error) {
 	return exposedPorts, nil
 }
 
-// createAndStartContainer creates and starts a Docker container
-func (c *Client) createAndStartContainer(ctx context.Context, config *container.Config, hostConfig *container.HostConfig, name string) (string, error) {
+// createAndStartContainer creates and starts a Docker container, recording both phases in timings
+func (c *Client) createAndStartContainer(ctx context.Context, config *container.Config, hostConfig *container.HostConfig, name string, timings *RunTimings) (string, error) {
 	// Create the container
+	createStart := time.Now()
 	resp, err := c.cli.ContainerCreate(ctx, config, hostConfig, nil, nil, name)
 	if err != nil {
 		return "", fmt.Errorf("failed to create container: %w\n💡 Check if port is already in use", err)
 	}
+	timings.Create = time.Since(createStart)
 
 	// Start the container
+	startStart := time.Now()
 	if err := c.cli.ContainerStart(ctx, resp.ID, container.StartOptions{}); err != nil {
+		// Don't leave the created container behind - not even when ctx was cancelled (e.g., Ctrl+C)
+		_ = c.cli.ContainerRemove(context.WithoutCancel(ctx), resp.ID, container.RemoveOptions{Force: true})
 		return "", fmt.Errorf("failed to start container %s: %w", resp.ID, err)
 	}
+	timings.Start = time.Since(startStart)
 
 	return resp.ID, nil
 }
 
-// pullImageIfNeeded pulls an image if it doesn't exist locally
-func (c *Client) pullImageIfNeeded(ctx context.Context, imageName string) error {
+// ImageExists reports whether an image is present locally, without pulling it
+func (c *Client) ImageExists(ctx context.Context, imageName string) (bool, error) {
+	if _, err := c.cli.ImageInspect(ctx, imageName); err != nil {
+		if errdefs.IsNotFound(err) {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to inspect image %s: %w", imageName, err)
+	}
+	return true, nil
+}
+
+// pullImageIfNeeded pulls an image when the pull policy calls for it
+// Digest-pinned images are inspected by digest, so a present digest is never re-pulled
+func (c *Client) pullImageIfNeeded(ctx context.Context, imageName string, policy PullPolicy) error {
 	// Check if the image exists locally
 	_, err := c.cli.ImageInspect(ctx, imageName)
-	if err == nil {
-		// Image exists locally, no need to pull
+	present := err == nil
+
+	// Offline mode never reaches out to a registry
+	if c.offline {
+		policy = PullNever
+	}
+
+	if shouldPull(policy, isDigestPinned(imageName), present) {
+		return c.pullImage(ctx, imageName)
+	}
+	if present {
 		return nil
 	}
 
-	// Image doesn't exist, pull it
+	// Missing, and the policy forbids pulling it
+	orkErr := utils.ErrImageNotFound(imageName)
+	orkErr.Err = err
+	if c.offline {
+		orkErr.Details = append(orkErr.Details, "Offline mode is enabled (--no-pull or ORK_OFFLINE=1), so images are not pulled")
+	} else {
+		orkErr.Details = append(orkErr.Details, "The pull policy is 'never', so images are not pulled")
+	}
+	return orkErr
+}
+
+// pullImage pulls an image from its registry, streaming progress to stdout
+func (c *Client) pullImage(ctx context.Context, imageName string) error {
 	fmt.Printf("📥 Pulling image %s...\n", imageName)
 
 	reader, err := c.cli.ImagePull(ctx, imageName, image.PullOptions{})
@@ -349,6 +957,20 @@ func (c *Client) pullImageIfNeeded(ctx context.Context, imageName string) error
 // Private Helpers - List-related
 // ============================================================================
 
+// listWithFilters lists containers (including stopped ones) matching the given filters
+func (c *Client) listWithFilters(ctx context.Context, filterArgs filters.Args) ([]ContainerInfo, error) {
+	containers, err := c.cli.ContainerList(ctx, container.ListOptions{
+		All:     true, // Include stopped containers
+		Filters: filterArgs,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list containers: %w", err)
+	}
+
+	// Convert to our ContainerInfo format
+	return convertToContainerInfo(containers), nil
+}
+
 // buildOrkFilters creates filters to find Ork-managed containers
 func buildOrkFilters(projectName string) filters.Args {
 	filterArgs := filters.NewArgs()
@@ -364,6 +986,13 @@ func buildOrkFilters(projectName string) filters.Args {
 	return filterArgs
 }
 
+// buildServiceFilters creates filters to find the containers of a single project service
+func buildServiceFilters(projectName, serviceName string) filters.Args {
+	filterArgs := buildOrkFilters(projectName)
+	filterArgs.Add("label", fmt.Sprintf("ork.service=%s", serviceName))
+	return filterArgs
+}
+
 // convertToContainerInfo converts Docker API containers to our format
 func convertToContainerInfo(containers []container.Summary) []ContainerInfo {
 	result := make([]ContainerInfo, 0, len(containers))
@@ -393,6 +1022,50 @@ func convertToContainerInfo(containers []container.Summary) []ContainerInfo {
 	return result
 }
 
+// convertToContainerDetails converts a Docker inspect response to our format
+func convertToContainerDetails(resp container.InspectResponse) *ContainerDetails {
+	details := &ContainerDetails{}
+
+	if resp.ContainerJSONBase != nil {
+		details.ID = resp.ID
+		details.Name = strings.TrimPrefix(resp.Name, "/")
+		details.Image = resp.Image
+		details.Created = parseDockerTime(resp.Created)
+		details.RestartCount = resp.RestartCount
+
+		if state := resp.State; state != nil {
+			details.State = string(state.Status)
+			details.Running = state.Running
+			details.ExitCode = state.ExitCode
+			details.StartedAt = parseDockerTime(state.StartedAt)
+			details.FinishedAt = parseDockerTime(state.FinishedAt)
+			if state.Health != nil {
+				details.Health = string(state.Health.Status)
+			}
+		}
+	}
+
+	if resp.Config != nil {
+		details.Labels = resp.Config.Labels
+	}
+
+	if resp.NetworkSettings != nil {
+		details.Ports = formatPortMap(resp.NetworkSettings.Ports)
+	}
+
+	return details
+}
+
+// parseDockerTime parses a Docker API timestamp, returning the zero time if unset or invalid
+// Docker reports "0001-01-01T00:00:00Z" for containers that never started or finished
+func parseDockerTime(value string) time.Time {
+	t, err := time.Parse(time.RFC3339Nano, value)
+	if err != nil || t.Year() <= 1 {
+		return time.Time{}
+	}
+	return t
+}
+
 // formatPorts converts Docker port bindings to human-readable strings
 func formatPorts(ports []container.Port) []string {
 	if len(ports) == 0 {
@@ -410,6 +1083,34 @@ func formatPorts(ports []container.Port) []string {
 	return result
 }
 
+// formatPortMap converts inspect port data to sorted, human-readable strings
+// Unlike formatPorts, exposed ports without a host binding are included
+func formatPortMap(ports nat.PortMap) []string {
+	if len(ports) == 0 {
+		return nil
+	}
+
+	result := make([]string, 0, len(ports))
+	for port, bindings := range ports {
+		if len(bindings) == 0 {
+			result = append(result, string(port))
+			continue
+		}
+		seen := make(map[string]bool, len(bindings))
+		for _, binding := range bindings {
+			// Docker reports IPv4 and IPv6 bindings separately for the same host port
+			portStr := fmt.Sprintf("%s:%s", binding.HostPort, port)
+			if !seen[portStr] {
+				seen[portStr] = true
+				result = append(result, portStr)
+			}
+		}
+	}
+
+	sort.Strings(result)
+	return result
+}
+
 // ============================================================================
 // Utility Converters
 // ============================================================================
@@ -428,16 +1129,28 @@ func convertEnvMapToSlice(envMap map[string]string) []string {
 	return env
 }
 
+// splitPortProtocol splits "53/udp" into "53" and "udp", defaulting to tcp
+func splitPortProtocol(spec string) (port, protocol string) {
+	port, protocol, found := strings.Cut(spec, "/")
+	if !found {
+		return spec, "tcp"
+	}
+	return port, protocol
+}
+
 // convertPortsToBindings converts a port map to Docker port bindings
-// Input: {"8080": "80"} means host:8080 -> container:80
+// Input: {"8080": "80"} means host:8080 -> container:80 (tcp)
+// Input: {"5353/udp": "53/udp"} means host:5353 -> container:53 (udp)
 func convertPortsToBindings(ports map[string]string) nat.PortMap {
 	if ports == nil {
 		return nil
 	}
 
 	bindings := make(nat.PortMap)
-	for hostPort, containerPort := range ports {
-		port, err := nat.NewPort("tcp", containerPort)
+	for hostKey, containerPort := range ports {
+		hostPort, _ := splitPortProtocol(hostKey)
+		portNumber, protocol := splitPortProtocol(containerPort)
+		port, err := nat.NewPort(protocol, portNumber)
 		if err != nil {
 			continue // Skip invalid ports
 		}
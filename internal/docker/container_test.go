@@ -0,0 +1,349 @@
+package docker
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/go-connections/nat"
+	"github.com/stretchr/testify/assert"
+)
+
+// ============================================================================
+// Helper Function Tests - Host Config
+// ============================================================================
+
+func TestBuildHostConfig_Volumes(t *testing.T) {
+	hostConfig := buildHostConfig(RunOptions{
+		Volumes: []string{"/srv/data:/data:ro", "pgdata:/var/lib/postgresql/data"},
+	})
+
+	assert.Equal(t, []string{"/srv/data:/data:ro", "pgdata:/var/lib/postgresql/data"}, hostConfig.Binds)
+}
+
+func TestBuildHostConfig_NoVolumes(t *testing.T) {
+	hostConfig := buildHostConfig(RunOptions{})
+
+	assert.Empty(t, hostConfig.Binds)
+}
+
+func TestBuildHostConfig_Resources(t *testing.T) {
+	hostConfig := buildHostConfig(RunOptions{NanoCPUs: 1_500_000_000, MemoryBytes: 512 * 1024 * 1024})
+
+	assert.Equal(t, int64(1_500_000_000), hostConfig.NanoCPUs)
+	assert.Equal(t, int64(512*1024*1024), hostConfig.Memory)
+}
+
+func TestBuildHostConfig_NoResourceLimits(t *testing.T) {
+	hostConfig := buildHostConfig(RunOptions{})
+
+	assert.Zero(t, hostConfig.NanoCPUs)
+	assert.Zero(t, hostConfig.Memory)
+}
+
+func TestBuildHostConfig_RestartPolicy(t *testing.T) {
+	tests := []struct {
+		name     string
+		opts     RunOptions
+		expected container.RestartPolicy
+	}{
+		{"default is no", RunOptions{}, container.RestartPolicy{Name: container.RestartPolicyDisabled}},
+		{"always", RunOptions{RestartPolicy: "always"}, container.RestartPolicy{Name: container.RestartPolicyAlways}},
+		{"unless-stopped", RunOptions{RestartPolicy: "unless-stopped"}, container.RestartPolicy{Name: container.RestartPolicyUnlessStopped}},
+		{"on-failure unlimited", RunOptions{RestartPolicy: "on-failure"}, container.RestartPolicy{Name: container.RestartPolicyOnFailure}},
+		{
+			"on-failure with max retries",
+			RunOptions{RestartPolicy: "on-failure", RestartMaxRetries: 5},
+			container.RestartPolicy{Name: container.RestartPolicyOnFailure, MaximumRetryCount: 5},
+		},
+		{
+			"retries ignored for always",
+			RunOptions{RestartPolicy: "always", RestartMaxRetries: 5},
+			container.RestartPolicy{Name: container.RestartPolicyAlways},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, buildHostConfig(tt.opts).RestartPolicy)
+		})
+	}
+}
+
+func TestBuildHostConfig_NetworkMode(t *testing.T) {
+	tests := []struct {
+		name     string
+		mode     string
+		expected container.NetworkMode
+	}{
+		{"default bridge", "", ""},
+		{"host", "host", "host"},
+		{"none", "none", "none"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, buildHostConfig(RunOptions{NetworkMode: tt.mode}).NetworkMode)
+		})
+	}
+}
+
+// ============================================================================
+// Helper Function Tests - Container Config
+// ============================================================================
+
+func TestBuildContainerConfig_DisableHealthcheck(t *testing.T) {
+	config, err := buildContainerConfig(RunOptions{Image: "postgres:15", DisableHealthcheck: true})
+
+	assert.NoError(t, err)
+	if assert.NotNil(t, config.Healthcheck) {
+		assert.Equal(t, []string{"NONE"}, config.Healthcheck.Test)
+	}
+}
+
+func TestBuildContainerConfig_KeepsImageHealthcheck(t *testing.T) {
+	config, err := buildContainerConfig(RunOptions{Image: "postgres:15"})
+
+	assert.NoError(t, err)
+	assert.Nil(t, config.Healthcheck, "the image's HEALTHCHECK should be inherited by default")
+}
+
+// ============================================================================
+// Helper Function Tests - Ports
+// ============================================================================
+
+func TestConvertPortsToBindings_Protocols(t *testing.T) {
+	bindings := convertPortsToBindings(map[string]string{
+		"8080":     "80",
+		"5353/udp": "53/udp",
+	})
+
+	assert.Len(t, bindings, 2)
+	assert.Equal(t, "8080", bindings[nat.Port("80/tcp")][0].HostPort)
+	assert.Equal(t, "5353", bindings[nat.Port("53/udp")][0].HostPort)
+}
+
+func TestConvertPortsToBindings_SameHostPortBothProtocols(t *testing.T) {
+	bindings := convertPortsToBindings(map[string]string{
+		"53":     "53",
+		"53/udp": "53/udp",
+	})
+
+	assert.Contains(t, bindings, nat.Port("53/tcp"))
+	assert.Contains(t, bindings, nat.Port("53/udp"))
+}
+
+func TestCreateExposedPorts_Protocols(t *testing.T) {
+	exposed, err := createExposedPorts(map[string]string{
+		"8080":     "80",
+		"5353/udp": "53/udp",
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, nat.PortSet{"80/tcp": {}, "53/udp": {}}, exposed)
+}
+
+func TestFormatPortMap_IncludesUnpublished(t *testing.T) {
+	ports := formatPortMap(nat.PortMap{
+		"80/tcp": {
+			{HostIP: "0.0.0.0", HostPort: "8080"},
+			{HostIP: "::", HostPort: "8080"},
+		},
+		"53/udp":   {{HostIP: "0.0.0.0", HostPort: "5353"}},
+		"9090/tcp": nil,
+	})
+
+	assert.Equal(t, []string{"5353:53/udp", "8080:80/tcp", "9090/tcp"}, ports)
+}
+
+func TestStopTimeoutSeconds(t *testing.T) {
+	tests := []struct {
+		timeout  time.Duration
+		expected int
+	}{
+		{timeout: 0, expected: 0},
+		{timeout: 10 * time.Second, expected: 10},
+		{timeout: 1500 * time.Millisecond, expected: 2},
+		{timeout: 100 * time.Millisecond, expected: 1},
+		{timeout: -time.Second, expected: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.timeout.String(), func(t *testing.T) {
+			assert.Equal(t, tt.expected, *stopTimeoutSeconds(tt.timeout))
+		})
+	}
+}
+
+func TestConvertToContainerDetails_WideFields(t *testing.T) {
+	details := convertToContainerDetails(container.InspectResponse{
+		ContainerJSONBase: &container.ContainerJSONBase{
+			ID:           "abc123def456abc123def456",
+			Name:         "/ork-shop-api",
+			Created:      "2026-03-01T10:00:00.5Z",
+			RestartCount: 3,
+		},
+		NetworkSettings: &container.NetworkSettings{
+			NetworkSettingsBase: container.NetworkSettingsBase{
+				Ports: nat.PortMap{"3000/tcp": {{HostPort: "3000"}}},
+			},
+		},
+	})
+
+	assert.Equal(t, "ork-shop-api", details.Name)
+	assert.Equal(t, 3, details.RestartCount)
+	assert.Equal(t, []string{"3000:3000/tcp"}, details.Ports)
+	assert.Equal(t, 2026, details.Created.Year())
+}
+
+// ============================================================================
+// Helper Function Tests - Filters
+// ============================================================================
+
+func TestBuildServiceFilters(t *testing.T) {
+	filterArgs := buildServiceFilters("shop", "api")
+
+	assert.ElementsMatch(t, []string{"ork.managed=true", "ork.project=shop", "ork.service=api"}, filterArgs.Get("label"))
+}
+
+func TestBuildOrkFilters_NoServiceFilter(t *testing.T) {
+	filterArgs := buildOrkFilters("shop")
+
+	assert.ElementsMatch(t, []string{"ork.managed=true", "ork.project=shop"}, filterArgs.Get("label"))
+}
+
+// ============================================================================
+// Helper Function Tests - Line Writer
+// ============================================================================
+
+func TestLineWriter_ConcurrentWritesKeepLinesIntact(t *testing.T) {
+	var out bytes.Buffer
+	writer := newLineWriter(&out)
+
+	const writers, linesPerWriter = 8, 200
+	var wg sync.WaitGroup
+	for i := 0; i < writers; i++ {
+		wg.Add(1)
+		go func(id int) {
+			defer wg.Done()
+			line := strings.Repeat(fmt.Sprintf("%d", id), 50)
+			for j := 0; j < linesPerWriter; j++ {
+				_ = writer.WriteLine(line)
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	lines := strings.Split(strings.TrimSuffix(out.String(), "\n"), "\n")
+	assert.Len(t, lines, writers*linesPerWriter)
+	for _, line := range lines {
+		// Every line must consist of a single writer's digit - no mid-line interleaving
+		assert.Equal(t, strings.Repeat(line[:1], 50), line)
+	}
+}
+
+// ============================================================================
+// Helper Function Tests - Follow Reconnect
+// ============================================================================
+
+// eofThenDataReader returns io.EOF on its first read and data afterwards,
+// like a log stream that ends when the container stops and resumes once it's back
+type eofThenDataReader struct {
+	reads int
+	data  *strings.Reader
+}
+
+func (r *eofThenDataReader) Read(p []byte) (int, error) {
+	r.reads++
+	if r.reads == 1 {
+		return 0, io.EOF
+	}
+	return r.data.Read(p)
+}
+
+func (r *eofThenDataReader) Close() error { return nil }
+
+// collectLogs returns a stream func for followLogs that appends everything it reads to out
+func collectLogs(out *bytes.Buffer) func(io.Reader, LogsOptions) error {
+	return func(reader io.Reader, _ LogsOptions) error {
+		_, err := io.Copy(out, reader)
+		return err
+	}
+}
+
+func TestFollowLogs_ReconnectsAfterEOF(t *testing.T) {
+	reader := &eofThenDataReader{data: strings.NewReader("back online\n")}
+	var opened []LogsOptions
+	open := func(opts LogsOptions) (io.ReadCloser, error) {
+		opened = append(opened, opts)
+		return reader, nil
+	}
+	reconnects := 0
+	reconnect := func() bool {
+		reconnects++
+		return reconnects == 1 // The container comes back once, then stops for good
+	}
+	notices := 0
+
+	var out bytes.Buffer
+	err := followLogs(LogsOptions{Follow: true, Tail: "100", OnReconnect: func() { notices++ }}, open, collectLogs(&out), reconnect)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "back online\n", out.String(), "the stream after the EOF is read")
+	assert.Equal(t, 1, notices)
+	if assert.Len(t, opened, 2) {
+		assert.Equal(t, "100", opened[0].Tail)
+		assert.Empty(t, opened[1].Tail, "a re-attached stream doesn't repeat the tail")
+		assert.NotEmpty(t, opened[1].Since, "a re-attached stream starts where the last one ended")
+	}
+}
+
+func TestFollowLogs_NoReconnectWithoutFollow(t *testing.T) {
+	reader := &eofThenDataReader{data: strings.NewReader("never read\n")}
+	opens := 0
+	open := func(LogsOptions) (io.ReadCloser, error) {
+		opens++
+		return reader, nil
+	}
+	reconnect := func() bool {
+		t.Fatal("reconnect should not be checked without follow")
+		return false
+	}
+
+	var out bytes.Buffer
+	err := followLogs(LogsOptions{}, open, collectLogs(&out), reconnect)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 1, opens)
+	assert.Empty(t, out.String())
+}
+
+func TestFollowLogs_StopsWhenContainerDoesNotComeBack(t *testing.T) {
+	opens := 0
+	open := func(LogsOptions) (io.ReadCloser, error) {
+		opens++
+		return io.NopCloser(strings.NewReader("last words\n")), nil
+	}
+
+	var out bytes.Buffer
+	err := followLogs(LogsOptions{Follow: true}, open, collectLogs(&out), func() bool { return false })
+
+	assert.NoError(t, err)
+	assert.Equal(t, 1, opens)
+	assert.Equal(t, "last words\n", out.String())
+}
+
+func TestFollowLogs_ReturnsOpenError(t *testing.T) {
+	open := func(LogsOptions) (io.ReadCloser, error) {
+		return nil, fmt.Errorf("no such container")
+	}
+
+	err := followLogs(LogsOptions{Follow: true}, open, collectLogs(&bytes.Buffer{}), func() bool { return true })
+
+	assert.EqualError(t, err, "no such container")
+}
@@ -0,0 +1,502 @@
+// Package dockertest provides an in-memory fake of the Docker Engine API for tests.
+// It implements just enough of the API for Ork's docker.Client to run its lifecycle
+// operations, and records every request so tests can assert on what was (not) called.
+package dockertest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/api/types/filters"
+	"github.com/ork-cli/ork/internal/docker"
+)
+
+// ============================================================================
+// Type Definitions
+// ============================================================================
+
+// Server is a fake Docker daemon backed by an httptest server
+type Server struct {
+	mu         sync.Mutex
+	networks   map[string]string                  // Network name -> network ID
+	containers []map[string]any                   // Containers returned by the list endpoint
+	requests   []string                           // "METHOD /path" for every request (API version prefix stripped)
+	handlers   []route                            // Custom handlers registered by tests (checked first)
+	execs      [][]string                         // Commands passed to exec create, in order
+	exitCode   int                                // Exit code reported for every exec
+	missing    map[string]bool                    // Images that don't exist locally (all others do)
+	startedAt  map[string]time.Time               // Container ID -> start time reported by inspect (zero when unset)
+	stats      map[string]container.StatsResponse // Container ID -> stats sample (zero sample when unset)
+	aliases    map[string][]string                // Container ID -> network aliases it was connected with
+	binds      map[string][]string                // Container ID -> volume binds it was created with
+	volumes    map[string]map[string]string       // Volume name -> labels
+	nextID     int
+}
+
+// route is a custom handler for requests matching a method and path prefix
+type route struct {
+	method  string
+	prefix  string
+	handler http.HandlerFunc
+}
+
+// apiVersionPrefix matches the "/v1.xx" prefix the Docker SDK adds to every path
+var apiVersionPrefix = regexp.MustCompile(`^/v[0-9.]+`)
+
+// ============================================================================
+// Constructor
+// ============================================================================
+
+// NewServer starts a fake daemon and returns a docker.Client connected to it
+// Both are cleaned up automatically when the test finishes
+func NewServer(t *testing.T) (*Server, *docker.Client) {
+	t.Helper()
+
+	fake := &Server{
+		networks:  make(map[string]string),
+		missing:   make(map[string]bool),
+		startedAt: make(map[string]time.Time),
+		stats:     make(map[string]container.StatsResponse),
+		aliases:   make(map[string][]string),
+		binds:     make(map[string][]string),
+		volumes:   make(map[string]map[string]string),
+	}
+	server := httptest.NewServer(http.HandlerFunc(fake.serveHTTP))
+	t.Cleanup(server.Close)
+
+	// Point the Docker SDK at the fake server via the standard environment variables
+	t.Setenv("DOCKER_HOST", "tcp://"+strings.TrimPrefix(server.URL, "http://"))
+	t.Setenv("DOCKER_TLS_VERIFY", "")
+	t.Setenv("DOCKER_CERT_PATH", "")
+	t.Setenv("DOCKER_API_VERSION", "")
+
+	client, err := docker.NewClient()
+	if err != nil {
+		t.Fatalf("failed to connect to fake Docker daemon: %v", err)
+	}
+	t.Cleanup(func() { _ = client.Close() })
+
+	return fake, client
+}
+
+// ============================================================================
+// Public Methods - Fixtures
+// ============================================================================
+
+// AddNetwork registers an existing network
+func (s *Server) AddNetwork(name, id string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.networks[name] = id
+}
+
+// NetworkID returns the ID of a network by name (empty if it doesn't exist)
+func (s *Server) NetworkID(name string) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.networks[name]
+}
+
+// AddContainer registers an existing Ork-managed container for a project service
+func (s *Server) AddContainer(id, project, service, status string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.containers = append(s.containers, map[string]any{
+		"Id":     id,
+		"Names":  []string{fmt.Sprintf("/ork-%s-%s", project, service)},
+		"Image":  "fake:latest",
+		"Status": status,
+		"Labels": map[string]string{
+			"ork.managed": "true",
+			"ork.project": project,
+			"ork.service": service,
+		},
+	})
+}
+
+// AddVolume registers an existing named volume with its labels
+func (s *Server) AddVolume(name string, labels map[string]string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.volumes[name] = labels
+}
+
+// HasVolume reports whether a named volume exists
+func (s *Server) HasVolume(name string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	_, ok := s.volumes[name]
+	return ok
+}
+
+// VolumeLabels returns the labels of a named volume (nil if it doesn't exist)
+func (s *Server) VolumeLabels(name string) map[string]string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.volumes[name]
+}
+
+// RemoveContainer deletes a container, as if it were removed outside of Ork (e.g., 'docker rm -f')
+func (s *Server) RemoveContainer(id string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.removeContainer(id)
+}
+
+// SetStartedAt sets the start time inspect reports for a container
+func (s *Server) SetStartedAt(id string, startedAt time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.startedAt[id] = startedAt
+}
+
+// SetStats sets the resource usage sample the stats endpoint returns for a container
+func (s *Server) SetStats(id string, sample container.StatsResponse) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.stats[id] = sample
+}
+
+// SetPorts sets the published ports a container reports when listed
+func (s *Server) SetPorts(id string, ports []container.Port) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, c := range s.containers {
+		if c["Id"] == id {
+			c["Ports"] = ports
+		}
+	}
+}
+
+// RemoveImage makes an image absent locally until it is pulled
+func (s *Server) RemoveImage(name string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.missing[name] = true
+}
+
+// SetExecExitCode sets the exit code reported for exec'd commands
+func (s *Server) SetExecExitCode(code int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.exitCode = code
+}
+
+// Handle registers a custom handler for requests matching a method and path prefix
+// The path is matched without the API version prefix (e.g., "/containers/abc/exec")
+func (s *Server) Handle(method, pathPrefix string, handler http.HandlerFunc) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.handlers = append(s.handlers, route{method: method, prefix: pathPrefix, handler: handler})
+}
+
+// ============================================================================
+// Public Methods - Assertions
+// ============================================================================
+
+// NetworkAliases returns the aliases a container was connected to a network with (nil if never connected)
+func (s *Server) NetworkAliases(containerID string) []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.aliases[containerID]
+}
+
+// Binds returns the volume binds a container was created with (nil if it has none)
+// Like the Docker API, containerID may be a short ID prefix
+func (s *Server) Binds(containerID string) []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for id, binds := range s.binds {
+		if strings.HasPrefix(id, containerID) {
+			return binds
+		}
+	}
+	return nil
+}
+
+// Requests returns every request received as "METHOD /path"
+func (s *Server) Requests() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]string(nil), s.requests...)
+}
+
+// HasRequest reports whether a request matching "METHOD /path-prefix" was received
+func (s *Server) HasRequest(prefix string) bool {
+	for _, r := range s.Requests() {
+		if strings.HasPrefix(r, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// RequestCount returns how many requests matching "METHOD /path-prefix" were received
+func (s *Server) RequestCount(prefix string) int {
+	count := 0
+	for _, r := range s.Requests() {
+		if strings.HasPrefix(r, prefix) {
+			count++
+		}
+	}
+	return count
+}
+
+// Execs returns every command that was exec'd in a container
+func (s *Server) Execs() [][]string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([][]string(nil), s.execs...)
+}
+
+// Mutations returns every request that could change daemon state (anything but GET/HEAD and ping)
+func (s *Server) Mutations() []string {
+	var mutations []string
+	for _, r := range s.Requests() {
+		if strings.HasPrefix(r, http.MethodGet+" ") || strings.HasPrefix(r, http.MethodHead+" ") {
+			continue
+		}
+		if strings.HasSuffix(r, "/_ping") {
+			continue
+		}
+		mutations = append(mutations, r)
+	}
+	return mutations
+}
+
+// ============================================================================
+// Private Methods - Routing
+// ============================================================================
+
+// serveHTTP routes fake Docker API requests
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	path := apiVersionPrefix.ReplaceAllString(r.URL.Path, "")
+
+	s.mu.Lock()
+	s.requests = append(s.requests, r.Method+" "+path)
+	handlers := append([]route(nil), s.handlers...)
+	s.mu.Unlock()
+
+	w.Header().Set("Api-Version", "1.47")
+	w.Header().Set("Content-Type", "application/json")
+
+	// Custom handlers take precedence over the built-in behavior
+	for _, h := range handlers {
+		if r.Method == h.method && strings.HasPrefix(path, h.prefix) {
+			h.handler(w, r)
+			return
+		}
+	}
+
+	s.serveDefault(w, r, path)
+}
+
+// serveDefault implements the built-in fake behavior for common endpoints
+func (s *Server) serveDefault(w http.ResponseWriter, r *http.Request, path string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	switch {
+	case path == "/_ping":
+		_, _ = w.Write([]byte("OK"))
+	case r.Method == http.MethodGet && path == "/version":
+		_ = json.NewEncoder(w).Encode(map[string]any{"Version": "27.0.0-fake", "ApiVersion": "1.47"})
+	case r.Method == http.MethodGet && path == "/networks":
+		list := make([]map[string]any, 0, len(s.networks))
+		for name, id := range s.networks {
+			list = append(list, map[string]any{"Name": name, "Id": id})
+		}
+		_ = json.NewEncoder(w).Encode(list)
+	case r.Method == http.MethodPost && path == "/networks/create":
+		var body struct{ Name string }
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		id := s.newID()
+		s.networks[body.Name] = id
+		_ = json.NewEncoder(w).Encode(map[string]any{"Id": id})
+	case r.Method == http.MethodGet && path == "/containers/json":
+		_ = json.NewEncoder(w).Encode(s.listContainers(r.URL.Query().Get("filters")))
+	case r.Method == http.MethodGet && strings.HasPrefix(path, "/containers/") && strings.HasSuffix(path, "/stats"):
+		_ = json.NewEncoder(w).Encode(s.stats[containerIDFromPath(path)])
+	case r.Method == http.MethodGet && strings.HasPrefix(path, "/containers/") && strings.HasSuffix(path, "/json"):
+		container := s.findContainer(containerIDFromPath(path))
+		if container == nil {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"message":"No such container"}`))
+			return
+		}
+		running := strings.HasPrefix(fmt.Sprint(container["Status"]), "Up")
+		state := "exited"
+		if running {
+			state = "running"
+		}
+		startedAt := ""
+		if t, ok := s.startedAt[fmt.Sprint(container["Id"])]; ok {
+			startedAt = t.UTC().Format(time.RFC3339Nano)
+		}
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"Id":     container["Id"],
+			"Name":   container["Names"].([]string)[0],
+			"Image":  container["Image"],
+			"State":  map[string]any{"Status": state, "Running": running, "StartedAt": startedAt},
+			"Config": map[string]any{"Labels": container["Labels"]},
+		})
+	case r.Method == http.MethodGet && strings.HasPrefix(path, "/images/"):
+		name := strings.TrimSuffix(strings.TrimPrefix(path, "/images/"), "/json")
+		if s.missing[name] {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"message":"No such image: ` + name + `"}`))
+			return
+		}
+		_ = json.NewEncoder(w).Encode(map[string]any{"Id": "sha256:fake"})
+	case r.Method == http.MethodPost && strings.HasPrefix(path, "/images/") && strings.HasSuffix(path, "/tag"):
+		w.WriteHeader(http.StatusCreated)
+	case r.Method == http.MethodPost && path == "/images/create":
+		image := r.URL.Query().Get("fromImage")
+		if tag := r.URL.Query().Get("tag"); tag != "" {
+			image += ":" + tag
+		}
+		delete(s.missing, image)
+		_, _ = w.Write([]byte(`{"status":"Pulled"}` + "\n"))
+	case r.Method == http.MethodPost && strings.HasPrefix(path, "/containers/") && strings.HasSuffix(path, "/exec"):
+		var body struct{ Cmd []string }
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		s.execs = append(s.execs, body.Cmd)
+		_ = json.NewEncoder(w).Encode(map[string]any{"Id": s.newID()})
+	case r.Method == http.MethodPost && strings.HasPrefix(path, "/exec/") && strings.HasSuffix(path, "/start"):
+		w.WriteHeader(http.StatusOK)
+	case r.Method == http.MethodGet && strings.HasPrefix(path, "/exec/") && strings.HasSuffix(path, "/json"):
+		_ = json.NewEncoder(w).Encode(map[string]any{"Running": false, "ExitCode": s.exitCode})
+	case r.Method == http.MethodPost && path == "/containers/create":
+		var body struct {
+			Image      string
+			Labels     map[string]string
+			HostConfig struct{ Binds []string }
+		}
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		id := s.newID()
+		s.binds[id] = body.HostConfig.Binds
+		s.containers = append(s.containers, map[string]any{
+			"Id":     id,
+			"Names":  []string{"/" + r.URL.Query().Get("name")},
+			"Image":  body.Image,
+			"Status": "Created",
+			"Labels": body.Labels,
+		})
+		_ = json.NewEncoder(w).Encode(map[string]any{"Id": id})
+	case r.Method == http.MethodPost && strings.HasSuffix(path, "/start"):
+		s.setStatus(containerIDFromPath(path), "Up Less than a second")
+		w.WriteHeader(http.StatusNoContent)
+	case r.Method == http.MethodPost && strings.HasSuffix(path, "/stop"):
+		s.setStatus(containerIDFromPath(path), "Exited (0) Less than a second ago")
+		w.WriteHeader(http.StatusNoContent)
+	case r.Method == http.MethodPost && strings.HasSuffix(path, "/kill"):
+		s.setStatus(containerIDFromPath(path), "Exited (143) Less than a second ago")
+		w.WriteHeader(http.StatusNoContent)
+	case r.Method == http.MethodPost && strings.HasSuffix(path, "/wait"):
+		_ = json.NewEncoder(w).Encode(map[string]any{"StatusCode": 143})
+	case r.Method == http.MethodDelete && strings.HasPrefix(path, "/containers/"):
+		s.removeContainer(containerIDFromPath(path))
+		w.WriteHeader(http.StatusNoContent)
+	case r.Method == http.MethodPost && path == "/volumes/create":
+		var body struct {
+			Name   string
+			Labels map[string]string
+		}
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		s.volumes[body.Name] = body.Labels
+		_ = json.NewEncoder(w).Encode(map[string]any{"Name": body.Name, "Labels": body.Labels})
+	case strings.HasPrefix(path, "/volumes/"):
+		name := strings.TrimPrefix(path, "/volumes/")
+		labels, ok := s.volumes[name]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"message":"get ` + name + `: no such volume"}`))
+			return
+		}
+		if r.Method == http.MethodDelete {
+			delete(s.volumes, name)
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(map[string]any{"Name": name, "Labels": labels})
+	case r.Method == http.MethodPost && strings.HasSuffix(path, "/connect"):
+		var body struct {
+			Container      string
+			EndpointConfig struct{ Aliases []string }
+		}
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		s.aliases[body.Container] = body.EndpointConfig.Aliases
+		w.WriteHeader(http.StatusOK)
+	default:
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"message":"not implemented by fake daemon"}`))
+	}
+}
+
+// listContainers returns the containers matching the list request's label filters
+// (callers must hold the lock)
+func (s *Server) listContainers(filterJSON string) []map[string]any {
+	filterArgs, err := filters.FromJSON(filterJSON)
+	if err != nil {
+		filterArgs = filters.NewArgs()
+	}
+
+	matched := []map[string]any{}
+	for _, container := range s.containers {
+		labels, _ := container["Labels"].(map[string]string)
+		if filterArgs.MatchKVList("label", labels) {
+			matched = append(matched, container)
+		}
+	}
+	return matched
+}
+
+// setStatus updates the status of a container (callers must hold the lock)
+func (s *Server) setStatus(id, status string) {
+	for _, c := range s.containers {
+		if c["Id"] == id {
+			c["Status"] = status
+		}
+	}
+}
+
+// findContainer returns a container by ID or ID prefix (callers must hold the lock)
+func (s *Server) findContainer(id string) map[string]any {
+	for _, c := range s.containers {
+		if cid, _ := c["Id"].(string); cid != "" && strings.HasPrefix(cid, id) {
+			return c
+		}
+	}
+	return nil
+}
+
+// removeContainer deletes a container from the list (callers must hold the lock)
+func (s *Server) removeContainer(id string) {
+	kept := s.containers[:0]
+	for _, c := range s.containers {
+		if c["Id"] != id {
+			kept = append(kept, c)
+		}
+	}
+	s.containers = kept
+}
+
+// containerIDFromPath extracts the ID from a "/containers/<id>[/action]" path
+func containerIDFromPath(path string) string {
+	parts := strings.Split(strings.TrimPrefix(path, "/containers/"), "/")
+	return parts[0]
+}
+
+// newID returns a unique 64-character ID (callers must hold the lock)
+func (s *Server) newID() string {
+	s.nextID++
+	return fmt.Sprintf("%064d", s.nextID)
+}
@@ -0,0 +1,110 @@
+package docker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/docker/docker/api/types/events"
+	"github.com/docker/docker/api/types/filters"
+)
+
+// ============================================================================
+// Type Definitions
+// ============================================================================
+
+// Container event actions 'ork events' streams by default
+const (
+	EventStart        = "start"         // Container started
+	EventDie          = "die"           // Container exited (on its own or after a stop)
+	EventHealthStatus = "health_status" // Native HEALTHCHECK status changed (action is "health_status: <status>")
+)
+
+// Event is a single container event from the Docker daemon
+type Event struct {
+	Time        time.Time         // When the event happened
+	Action      string            // Event action (e.g., "start", "die", "health_status: healthy")
+	ContainerID string            // ID of the container the event is about
+	Attributes  map[string]string // Container name, image, labels, and action details (e.g., "exitCode")
+}
+
+// ============================================================================
+// Public Methods - Events
+// ============================================================================
+
+// Events streams container events matching the filters until ctx is cancelled
+// The event channel is closed when the stream ends; if it ended because of a failure,
+// the error channel (buffered) holds the error by then
+func (c *Client) Events(ctx context.Context, filterArgs filters.Args) (<-chan Event, <-chan error) {
+	out := make(chan Event)
+	errs := make(chan error, 1)
+
+	messages, sdkErrs := c.cli.Events(ctx, events.ListOptions{Filters: filterArgs})
+
+	go func() {
+		defer close(out)
+		for {
+			select {
+			case msg := <-messages:
+				select {
+				case out <- convertEvent(msg):
+				case <-ctx.Done():
+					return
+				}
+			case err := <-sdkErrs:
+				// The daemon closing the stream (EOF) or our own cancellation is a normal end
+				if err != nil && !errors.Is(err, io.EOF) && ctx.Err() == nil {
+					errs <- fmt.Errorf("event stream failed: %w", err)
+				}
+				return
+			}
+		}
+	}()
+
+	return out, errs
+}
+
+// ============================================================================
+// Public Helpers - Filters
+// ============================================================================
+
+// BuildEventFilters creates filters for container events on Ork-managed containers
+// An empty projectName matches every project; no actions means start, die, and health_status
+func BuildEventFilters(projectName string, actions ...string) filters.Args {
+	filterArgs := filters.NewArgs()
+	filterArgs.Add("type", string(events.ContainerEventType))
+	filterArgs.Add("label", "ork.managed=true")
+	if projectName != "" {
+		filterArgs.Add("label", fmt.Sprintf("ork.project=%s", projectName))
+	}
+
+	if len(actions) == 0 {
+		actions = []string{EventStart, EventDie, EventHealthStatus}
+	}
+	for _, action := range actions {
+		filterArgs.Add("event", action)
+	}
+
+	return filterArgs
+}
+
+// ============================================================================
+// Private Helpers
+// ============================================================================
+
+// convertEvent converts a Docker SDK event message to our format
+func convertEvent(msg events.Message) Event {
+	eventTime := time.Unix(msg.Time, 0)
+	if msg.TimeNano != 0 {
+		eventTime = time.Unix(0, msg.TimeNano)
+	}
+
+	return Event{
+		Time:        eventTime,
+		Action:      string(msg.Action),
+		ContainerID: msg.Actor.ID,
+		Attributes:  msg.Actor.Attributes,
+	}
+}
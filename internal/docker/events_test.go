@@ -0,0 +1,72 @@
+package docker_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/docker/docker/api/types/filters"
+	"github.com/ork-cli/ork/internal/docker"
+	"github.com/ork-cli/ork/internal/docker/dockertest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// ============================================================================
+// Event Filter Tests
+// ============================================================================
+
+func TestBuildEventFilters_Project(t *testing.T) {
+	filterArgs := docker.BuildEventFilters("shop")
+
+	assert.Equal(t, []string{"container"}, filterArgs.Get("type"))
+	assert.ElementsMatch(t, []string{"ork.managed=true", "ork.project=shop"}, filterArgs.Get("label"))
+	assert.ElementsMatch(t, []string{"start", "die", "health_status"}, filterArgs.Get("event"))
+}
+
+func TestBuildEventFilters_AllProjects(t *testing.T) {
+	filterArgs := docker.BuildEventFilters("")
+
+	assert.Equal(t, []string{"ork.managed=true"}, filterArgs.Get("label"))
+}
+
+func TestBuildEventFilters_CustomActions(t *testing.T) {
+	filterArgs := docker.BuildEventFilters("shop", docker.EventDie)
+
+	assert.Equal(t, []string{"die"}, filterArgs.Get("event"))
+}
+
+// ============================================================================
+// Event Stream Tests
+// ============================================================================
+
+func TestEvents_StreamsUntilClosed(t *testing.T) {
+	fake, client := dockertest.NewServer(t)
+
+	var received filters.Args
+	fake.Handle(http.MethodGet, "/events", func(w http.ResponseWriter, r *http.Request) {
+		var err error
+		received, err = filters.FromJSON(r.URL.Query().Get("filters"))
+		require.NoError(t, err)
+		_, _ = w.Write([]byte(`{"Type":"container","Action":"start","Actor":{"ID":"abc","Attributes":{"ork.service":"api"}},"time":1772359200}
+{"Type":"container","Action":"die","Actor":{"ID":"abc","Attributes":{"ork.service":"api","exitCode":"1"}},"time":1772359260,"timeNano":1772359260500000000}
+`))
+	})
+
+	events, errs := client.Events(context.Background(), docker.BuildEventFilters("shop"))
+
+	var got []docker.Event
+	for event := range events {
+		got = append(got, event)
+	}
+
+	require.Len(t, got, 2)
+	assert.Equal(t, "start", got[0].Action)
+	assert.Equal(t, "abc", got[0].ContainerID)
+	assert.Equal(t, time.Unix(1772359200, 0), got[0].Time)
+	assert.Equal(t, "1", got[1].Attributes["exitCode"])
+	assert.Equal(t, time.Unix(0, 1772359260500000000), got[1].Time)
+	assert.Empty(t, errs, "a stream closed by the daemon is not an error")
+	assert.Contains(t, received.Get("label"), "ork.project=shop")
+}
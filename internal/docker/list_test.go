@@ -0,0 +1,58 @@
+package docker_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/docker/docker/api/types/filters"
+	"github.com/ork-cli/ork/internal/docker/dockertest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// ============================================================================
+// Container Listing Tests
+// ============================================================================
+
+func TestListByService_SendsServiceFilter(t *testing.T) {
+	fake, client := dockertest.NewServer(t)
+
+	var received filters.Args
+	fake.Handle(http.MethodGet, "/containers/json", func(w http.ResponseWriter, r *http.Request) {
+		var err error
+		received, err = filters.FromJSON(r.URL.Query().Get("filters"))
+		require.NoError(t, err)
+		_ = json.NewEncoder(w).Encode([]any{})
+	})
+
+	_, err := client.ListByService(context.Background(), "shop", "api")
+	require.NoError(t, err)
+
+	assert.ElementsMatch(t, []string{"ork.managed=true", "ork.project=shop", "ork.service=api"}, received.Get("label"))
+}
+
+func TestListByService_OnlyReturnsService(t *testing.T) {
+	fake, client := dockertest.NewServer(t)
+	fake.AddContainer("api000000000", "shop", "api", "Up 5 minutes")
+	fake.AddContainer("web000000000", "shop", "web", "Up 5 minutes")
+	fake.AddContainer("other0000000", "blog", "api", "Up 5 minutes")
+
+	containers, err := client.ListByService(context.Background(), "shop", "api")
+	require.NoError(t, err)
+
+	require.Len(t, containers, 1)
+	assert.Equal(t, "api000000000", containers[0].ID)
+}
+
+func TestList_DoesNotFilterByService(t *testing.T) {
+	fake, client := dockertest.NewServer(t)
+	fake.AddContainer("api000000000", "shop", "api", "Up 5 minutes")
+	fake.AddContainer("web000000000", "shop", "web", "Up 5 minutes")
+
+	containers, err := client.List(context.Background(), "shop")
+	require.NoError(t, err)
+
+	assert.Len(t, containers, 2)
+}
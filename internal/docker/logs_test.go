@@ -0,0 +1,165 @@
+package docker_test
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/docker/docker/pkg/stdcopy"
+	"github.com/ork-cli/ork/internal/docker"
+	"github.com/ork-cli/ork/internal/docker/dockertest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// serveLogLines registers a fake logs endpoint that returns lines as a multiplexed stdout stream
+func serveLogLines(fake *dockertest.Server, containerID string, lines ...string) {
+	fake.Handle(http.MethodGet, "/containers/"+containerID+"/logs", func(w http.ResponseWriter, r *http.Request) {
+		stdout := stdcopy.NewStdWriter(w, stdcopy.Stdout)
+		for _, line := range lines {
+			_, _ = stdout.Write([]byte(line + "\n"))
+		}
+	})
+}
+
+// ============================================================================
+// Multi-Container Logs Tests
+// ============================================================================
+
+func TestLogsMulti_PrefixesEachLine(t *testing.T) {
+	fake, client := dockertest.NewServer(t)
+	serveLogLines(fake, "api-id", "listening on 8080", "GET /health")
+	serveLogLines(fake, "web-id", "compiled successfully")
+
+	var out bytes.Buffer
+	err := client.LogsMulti(context.Background(), []docker.ContainerRef{
+		{ID: "api-id", Name: "api"},
+		{ID: "web-id", Name: "web"},
+	}, docker.LogsOptions{Output: &out})
+	require.NoError(t, err)
+
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	assert.ElementsMatch(t, []string{
+		"[api] listening on 8080",
+		"[api] GET /health",
+		"[web] compiled successfully",
+	}, lines)
+}
+
+func TestLogsMulti_AppliesFilterFormatterAndPrefix(t *testing.T) {
+	fake, client := dockertest.NewServer(t)
+	serveLogLines(fake, "api-id", "keep me", "drop me")
+
+	var out bytes.Buffer
+	err := client.LogsMulti(context.Background(), []docker.ContainerRef{{ID: "api-id", Name: "api"}}, docker.LogsOptions{
+		Output:    &out,
+		Filter:    func(line string) bool { return !strings.HasPrefix(line, "drop") },
+		Formatter: strings.ToUpper,
+		Prefix:    func(name string) string { return name + " | " },
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, "api | KEEP ME\n", out.String())
+}
+
+func TestLogsMulti_NoContainers(t *testing.T) {
+	_, client := dockertest.NewServer(t)
+
+	err := client.LogsMulti(context.Background(), nil, docker.LogsOptions{})
+
+	require.Error(t, err)
+}
+
+func TestLogsMulti_ReportsFailingContainer(t *testing.T) {
+	fake, client := dockertest.NewServer(t)
+	serveLogLines(fake, "api-id", "ok")
+
+	var out bytes.Buffer
+	err := client.LogsMulti(context.Background(), []docker.ContainerRef{
+		{ID: "api-id", Name: "api"},
+		{ID: "gone-id", Name: "gone"},
+	}, docker.LogsOptions{Output: &out})
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "gone-id")
+	assert.Equal(t, "[api] ok\n", out.String(), "healthy streams should still be printed")
+}
+
+// ============================================================================
+// Time Window Tests
+// ============================================================================
+
+func TestLogsMulti_PassesSinceToDocker(t *testing.T) {
+	fake, client := dockertest.NewServer(t)
+	var since string
+	fake.Handle(http.MethodGet, "/containers/api-id/logs", func(w http.ResponseWriter, r *http.Request) {
+		since = r.URL.Query().Get("since")
+	})
+
+	err := client.LogsMulti(context.Background(), []docker.ContainerRef{{ID: "api-id", Name: "api"}}, docker.LogsOptions{
+		Output: io.Discard,
+		Since:  "1772362800.000000000",
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, "1772362800.000000000", since)
+}
+
+// ============================================================================
+// Follow Reconnect Tests
+// ============================================================================
+
+func TestLogs_FollowReattachesToRestartedContainer(t *testing.T) {
+	fake, client := dockertest.NewServer(t)
+	fake.AddContainer("aaaaaaaaaaaa", "shop", "api", "Up 2 seconds")
+	var sinces []string
+	fake.Handle(http.MethodGet, "/containers/aaaaaaaaaaaa/logs", func(w http.ResponseWriter, r *http.Request) {
+		sinces = append(sinces, r.URL.Query().Get("since"))
+		if len(sinces) == 2 {
+			// The container is gone after its second run, so following stops
+			fake.Handle(http.MethodGet, "/containers/aaaaaaaaaaaa/json", func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusNotFound)
+			})
+		}
+	})
+	reconnects := 0
+
+	err := client.Logs(context.Background(), "aaaaaaaaaaaa", docker.LogsOptions{
+		Follow:      true,
+		OnReconnect: func() { reconnects++ },
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, 1, reconnects)
+	require.Len(t, sinces, 2)
+	assert.Empty(t, sinces[0])
+	assert.NotEmpty(t, sinces[1], "the second stream starts where the first one ended")
+}
+
+func TestLogs_FollowReattachesToRecreatedContainer(t *testing.T) {
+	fake, client := dockertest.NewServer(t)
+	fake.AddContainer("aaaaaaaaaaaa", "shop", "api", "Up 2 seconds")
+	fake.Handle(http.MethodGet, "/containers/aaaaaaaaaaaa/logs", func(w http.ResponseWriter, r *http.Request) {
+		// 'ork restart' replaces the container with a new one for the same service
+		fake.RemoveContainer("aaaaaaaaaaaa")
+		fake.AddContainer("bbbbbbbbbbbb", "shop", "api", "Up 1 second")
+	})
+	fake.Handle(http.MethodGet, "/containers/bbbbbbbbbbbb/logs", func(w http.ResponseWriter, r *http.Request) {
+		// Taken down for good, so following stops
+		fake.RemoveContainer("bbbbbbbbbbbb")
+	})
+	reconnects := 0
+
+	err := client.Logs(context.Background(), "aaaaaaaaaaaa", docker.LogsOptions{
+		Follow:      true,
+		OnReconnect: func() { reconnects++ },
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, 1, reconnects)
+	assert.Equal(t, 1, fake.RequestCount("GET /containers/aaaaaaaaaaaa/logs"))
+	assert.Equal(t, 1, fake.RequestCount("GET /containers/bbbbbbbbbbbb/logs"))
+}
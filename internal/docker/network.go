@@ -25,6 +25,12 @@ type NetworkInfo struct {
 // All containers in the same project will be connected to this network
 // This allows services to communicate using service names (e.g., postgres:5432)
 func (c *Client) CreateNetwork(ctx context.Context, projectName string) (string, error) {
+	return c.EnsureNetwork(ctx, projectName)
+}
+
+// EnsureNetwork returns the ID of the project network, creating it if it doesn't exist
+// It is safe to call repeatedly - an existing network is reused rather than recreated
+func (c *Client) EnsureNetwork(ctx context.Context, projectName string) (string, error) {
 	networkName := buildNetworkName(projectName)
 
 	// Check if the network already exists
@@ -81,8 +87,9 @@ func (c *Client) DeleteNetwork(ctx context.Context, projectName string) error {
 }
 
 // ConnectContainer connects a container to the project network
+// Other containers on the network can reach it by any of the aliases (e.g., its service name)
 // This must be called after the container is created but can be before or after it's started
-func (c *Client) ConnectContainer(ctx context.Context, projectName, containerID string) error {
+func (c *Client) ConnectContainer(ctx context.Context, projectName, containerID string, aliases []string) error {
 	networkName := buildNetworkName(projectName)
 
 	// Get network ID
@@ -92,7 +99,7 @@ func (c *Client) ConnectContainer(ctx context.Context, projectName, containerID
 	}
 
 	// Connect container to network
-	err = c.cli.NetworkConnect(ctx, networkID, containerID, nil)
+	err = c.cli.NetworkConnect(ctx, networkID, containerID, &network.EndpointSettings{Aliases: aliases})
 	if err != nil {
 		return fmt.Errorf("failed to connect container %s to network: %w", containerID[:12], err)
 	}
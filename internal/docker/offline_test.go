@@ -0,0 +1,71 @@
+package docker_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ork-cli/ork/internal/docker"
+	"github.com/ork-cli/ork/internal/docker/dockertest"
+	"github.com/ork-cli/ork/pkg/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// ============================================================================
+// Offline Mode Tests
+// ============================================================================
+
+func TestRun_OfflineMissingImage(t *testing.T) {
+	t.Setenv(docker.OfflineEnvVar, "1")
+	fake, client := dockertest.NewServer(t)
+	fake.RemoveImage("postgres:15")
+
+	_, err := client.Run(context.Background(), docker.RunOptions{Name: "ork-shop-db", Image: "postgres:15"})
+
+	require.Error(t, err)
+	orkErr, ok := err.(*utils.OrkError)
+	require.True(t, ok, "expected an OrkError, got %T", err)
+	assert.Contains(t, orkErr.Message, "postgres:15")
+	assert.False(t, fake.HasRequest("POST /images/create"), "offline mode must not pull")
+	assert.False(t, fake.HasRequest("POST /containers/create"))
+}
+
+func TestRun_OfflineImagePresent(t *testing.T) {
+	t.Setenv(docker.OfflineEnvVar, "1")
+	fake, client := dockertest.NewServer(t)
+
+	id, err := client.Run(context.Background(), docker.RunOptions{Name: "ork-shop-db", Image: "postgres:15"})
+
+	require.NoError(t, err)
+	assert.NotEmpty(t, id)
+	assert.False(t, fake.HasRequest("POST /images/create"))
+}
+
+func TestRun_OnlinePullsMissingImage(t *testing.T) {
+	fake, client := dockertest.NewServer(t)
+	fake.RemoveImage("postgres:15")
+
+	_, err := client.Run(context.Background(), docker.RunOptions{Name: "ork-shop-db", Image: "postgres:15"})
+
+	require.NoError(t, err)
+	assert.True(t, fake.HasRequest("POST /images/create"))
+}
+
+func TestPullImage_PullsEvenWhenPresent(t *testing.T) {
+	fake, client := dockertest.NewServer(t)
+
+	require.NoError(t, client.PullImage(context.Background(), "postgres:15"))
+
+	assert.Equal(t, 1, fake.RequestCount("POST /images/create"))
+}
+
+func TestPullImage_Offline(t *testing.T) {
+	t.Setenv(docker.OfflineEnvVar, "1")
+	fake, client := dockertest.NewServer(t)
+
+	err := client.PullImage(context.Background(), "postgres:15")
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "offline mode")
+	assert.False(t, fake.HasRequest("POST /images/create"))
+}
@@ -0,0 +1,158 @@
+package docker
+
+import (
+	"bufio"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/ork-cli/ork/pkg/utils"
+)
+
+// ============================================================================
+// Public Functions - Host Port Checks
+// ============================================================================
+
+// CheckPortsAvailable makes sure every host port in a RunOptions port map can be bound
+// Returns utils.ErrPortInUse for the first port (in sorted order) that is already taken,
+// naming the process holding it when that can be resolved
+func CheckPortsAvailable(ports map[string]string, serviceName string) error {
+	hostPorts := make([]string, 0, len(ports))
+	for hostPort := range ports {
+		hostPorts = append(hostPorts, hostPort)
+	}
+	sort.Strings(hostPorts)
+
+	for _, hostPort := range hostPorts {
+		if err := PortAvailable(hostPort); err != nil {
+			return utils.ErrPortInUse(hostPort, serviceName, portOwner(hostPort))
+		}
+	}
+	return nil
+}
+
+// PortAvailable tries to bind a host port ("8080" or "5353/udp") on all interfaces, like Docker does
+func PortAvailable(hostPort string) error {
+	port, isUDP := strings.CutSuffix(hostPort, "/udp")
+
+	if isUDP {
+		conn, err := net.ListenPacket("udp", ":"+port)
+		if err != nil {
+			return err
+		}
+		return conn.Close()
+	}
+
+	listener, err := net.Listen("tcp", ":"+port)
+	if err != nil {
+		return err
+	}
+	return listener.Close()
+}
+
+// ============================================================================
+// Private Helpers - Port Owner Lookup
+// ============================================================================
+
+// portOwner names the local process bound to a host port (e.g., "nginx (pid 812)")
+// Best effort: it reads /proc, so it returns "" off Linux or when the process isn't visible to us
+func portOwner(hostPort string) string {
+	port, isUDP := strings.CutSuffix(hostPort, "/udp")
+	number, err := strconv.ParseUint(port, 10, 16)
+	if err != nil {
+		return ""
+	}
+
+	protocol := "tcp"
+	if isUDP {
+		protocol = "udp"
+	}
+
+	inodes := make(map[string]bool)
+	for _, table := range []string{protocol, protocol + "6"} {
+		socketInodes(filepath.Join("/proc/net", table), uint16(number), !isUDP, inodes)
+	}
+	if len(inodes) == 0 {
+		return ""
+	}
+
+	return socketProcess(inodes)
+}
+
+// socketInodes adds the inodes of the sockets bound to port in a /proc/net table
+// With listenOnly, only sockets in the LISTEN state count (TCP)
+func socketInodes(path string, port uint16, listenOnly bool, inodes map[string]bool) {
+	file, err := os.Open(path)
+	if err != nil {
+		return
+	}
+	defer func() { _ = file.Close() }()
+
+	// Lines look like: "0: 00000000:1F90 00000000:0000 0A ... <uid> <timeout> <inode> ..."
+	scanner := bufio.NewScanner(file)
+	scanner.Scan() // Skip the header
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 10 {
+			continue
+		}
+		if listenOnly && fields[3] != "0A" {
+			continue
+		}
+
+		_, localPort, ok := strings.Cut(fields[1], ":")
+		if !ok {
+			continue
+		}
+		if value, err := strconv.ParseUint(localPort, 16, 16); err != nil || uint16(value) != port {
+			continue
+		}
+		if fields[9] != "0" {
+			inodes[fields[9]] = true
+		}
+	}
+}
+
+// socketProcess finds the process holding one of the socket inodes and formats it as "name (pid N)"
+func socketProcess(inodes map[string]bool) string {
+	entries, err := os.ReadDir("/proc")
+	if err != nil {
+		return ""
+	}
+
+	for _, entry := range entries {
+		pid := entry.Name()
+		if _, err := strconv.Atoi(pid); err != nil {
+			continue
+		}
+
+		fdDir := filepath.Join("/proc", pid, "fd")
+		fds, err := os.ReadDir(fdDir)
+		if err != nil {
+			continue // Exited, or owned by another user
+		}
+		for _, fd := range fds {
+			link, err := os.Readlink(filepath.Join(fdDir, fd.Name()))
+			if err != nil {
+				continue
+			}
+			// Socket links look like "socket:[12345]"
+			inode, ok := strings.CutPrefix(link, "socket:[")
+			if !ok || !inodes[strings.TrimSuffix(inode, "]")] {
+				continue
+			}
+
+			name, err := os.ReadFile(filepath.Join("/proc", pid, "comm"))
+			if err != nil {
+				return fmt.Sprintf("pid %s", pid)
+			}
+			return fmt.Sprintf("%s (pid %s)", strings.TrimSpace(string(name)), pid)
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,59 @@
+package docker_test
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"runtime"
+	"strconv"
+	"testing"
+
+	"github.com/ork-cli/ork/internal/docker"
+	"github.com/ork-cli/ork/pkg/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// ============================================================================
+// Host Port Check Tests
+// ============================================================================
+
+// holdPort listens on a free TCP port for the rest of the test and returns it
+func holdPort(t *testing.T) string {
+	t.Helper()
+
+	listener, err := net.Listen("tcp", ":0")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = listener.Close() })
+	return strconv.Itoa(listener.Addr().(*net.TCPAddr).Port)
+}
+
+func TestCheckPortsAvailable_Free(t *testing.T) {
+	free, err := net.Listen("tcp", ":0")
+	require.NoError(t, err)
+	freePort := strconv.Itoa(free.Addr().(*net.TCPAddr).Port)
+	require.NoError(t, free.Close())
+
+	assert.NoError(t, docker.CheckPortsAvailable(map[string]string{freePort: "80"}, "api"))
+	assert.NoError(t, docker.CheckPortsAvailable(nil, "api"))
+}
+
+func TestCheckPortsAvailable_PortInUse(t *testing.T) {
+	port := holdPort(t)
+
+	err := docker.CheckPortsAvailable(map[string]string{port: "8080"}, "api")
+	require.Error(t, err)
+
+	var orkErr *utils.OrkError
+	require.True(t, errors.As(err, &orkErr))
+	assert.Equal(t, utils.ErrorNetwork, orkErr.Kind)
+	assert.Equal(t, fmt.Sprintf("Port %s is already in use", port), orkErr.Message)
+	assert.Contains(t, orkErr.Details, fmt.Sprintf("Port %s is required by service 'api'", port))
+
+	// On Linux the listener is found through /proc - it belongs to this test process
+	if runtime.GOOS == "linux" {
+		require.Len(t, orkErr.Details, 2)
+		assert.Contains(t, orkErr.Details[1], fmt.Sprintf("(pid %d)", os.Getpid()))
+	}
+}
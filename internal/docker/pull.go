@@ -0,0 +1,129 @@
+package docker
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/distribution/reference"
+	"github.com/docker/docker/api/types/image"
+	"github.com/docker/docker/pkg/jsonmessage"
+	"github.com/docker/go-units"
+	"github.com/ork-cli/ork/pkg/utils"
+)
+
+// ============================================================================
+// Type Definitions - Pull Policy
+// ============================================================================
+
+// PullPolicy decides when an image is pulled before a container is created
+type PullPolicy string
+
+const (
+	PullMissing PullPolicy = "missing" // Pull only when the image isn't present locally (the default)
+	PullAlways  PullPolicy = "always"  // Pull on every run, so moving tags like :latest stay current
+	PullNever   PullPolicy = "never"   // Never pull, fail if the image isn't present locally
+)
+
+// ============================================================================
+// Public Functions
+// ============================================================================
+
+// ParsePullPolicy parses a --pull value, where empty means PullMissing
+func ParsePullPolicy(value string) (PullPolicy, error) {
+	switch policy := PullPolicy(value); policy {
+	case "":
+		return PullMissing, nil
+	case PullMissing, PullAlways, PullNever:
+		return policy, nil
+	default:
+		return "", fmt.Errorf("invalid pull policy %q (use always, missing, or never)", value)
+	}
+}
+
+// ============================================================================
+// Public Methods
+// ============================================================================
+
+// PullImageWithProgress pulls an image like PullImage, but reports each progress update
+// (e.g., "Downloading 3f4a1b 12.5MB/48MB") to progress instead of printing the raw stream,
+// so several pulls can run side by side
+func (c *Client) PullImageWithProgress(ctx context.Context, imageName string, progress func(status string)) error {
+	if c.offline {
+		return errOfflinePull(imageName)
+	}
+
+	reader, err := c.cli.ImagePull(ctx, imageName, image.PullOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to pull image %s: %w\n💡 Check image name and Docker Hub access", imageName, err)
+	}
+	defer func() { _ = reader.Close() }()
+
+	decoder := json.NewDecoder(reader)
+	for {
+		var msg jsonmessage.JSONMessage
+		if err := decoder.Decode(&msg); err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return fmt.Errorf("failed to read pull output: %w", err)
+		}
+		if msg.Error != nil {
+			return fmt.Errorf("failed to pull image %s: %w", imageName, msg.Error)
+		}
+		if status := pullStatus(msg); status != "" && progress != nil {
+			progress(status)
+		}
+	}
+}
+
+// ============================================================================
+// Private Helpers
+// ============================================================================
+
+// pullStatus turns one pull stream message into a short line: status, layer, and bytes so far
+func pullStatus(msg jsonmessage.JSONMessage) string {
+	status := msg.Status
+	if msg.ID != "" {
+		status += " " + msg.ID
+	}
+	if msg.Progress != nil && msg.Progress.Total > 0 {
+		status += fmt.Sprintf(" %s/%s", units.HumanSize(float64(msg.Progress.Current)), units.HumanSize(float64(msg.Progress.Total)))
+	}
+	return status
+}
+
+// errOfflinePull is returned when an explicit pull is requested in offline mode
+func errOfflinePull(imageName string) error {
+	return utils.DockerError(
+		"docker.pull",
+		fmt.Sprintf("Cannot pull image %s in offline mode", imageName),
+		"Drop --no-pull (or unset ORK_OFFLINE) to pull images",
+		nil,
+	)
+}
+
+// shouldPull decides whether to pull an image given the policy and whether it's present locally
+// A digest-pinned image can't change, so once present it's never pulled again, even with PullAlways
+func shouldPull(policy PullPolicy, digestPinned, present bool) bool {
+	switch policy {
+	case PullNever:
+		return false
+	case PullAlways:
+		return !present || !digestPinned
+	default:
+		return !present
+	}
+}
+
+// isDigestPinned reports whether an image reference names a content digest (e.g., "nginx@sha256:...")
+func isDigestPinned(imageName string) bool {
+	named, err := reference.ParseNormalizedNamed(imageName)
+	if err != nil {
+		return false
+	}
+	_, ok := named.(reference.Digested)
+	return ok
+}
@@ -0,0 +1,131 @@
+package docker_test
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/ork-cli/ork/internal/docker"
+	"github.com/ork-cli/ork/internal/docker/dockertest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// ============================================================================
+// Pull Policy Tests
+// ============================================================================
+
+func TestRun_PullPolicyMatrix(t *testing.T) {
+	pinned := "nginx@sha256:" + strings.Repeat("a", 64)
+
+	tests := []struct {
+		name     string
+		image    string
+		policy   docker.PullPolicy
+		present  bool
+		wantPull bool
+		wantErr  bool
+	}{
+		{"missing policy, present", "nginx:latest", docker.PullMissing, true, false, false},
+		{"missing policy, absent", "nginx:latest", docker.PullMissing, false, true, false},
+		{"default policy, present", "nginx:latest", "", true, false, false},
+		{"default policy, absent", "nginx:latest", "", false, true, false},
+		{"always policy, present", "nginx:latest", docker.PullAlways, true, true, false},
+		{"always policy, absent", "nginx:latest", docker.PullAlways, false, true, false},
+		{"never policy, present", "nginx:latest", docker.PullNever, true, false, false},
+		{"never policy, absent", "nginx:latest", docker.PullNever, false, false, true},
+		{"always policy, pinned digest present", pinned, docker.PullAlways, true, false, false},
+		{"always policy, pinned digest absent", pinned, docker.PullAlways, false, true, false},
+		{"missing policy, pinned digest present", pinned, docker.PullMissing, true, false, false},
+		{"never policy, pinned digest absent", pinned, docker.PullNever, false, false, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fake, client := dockertest.NewServer(t)
+			if !tt.present {
+				fake.RemoveImage(tt.image)
+			}
+
+			_, err := client.Run(context.Background(), docker.RunOptions{Name: "ork-shop-web", Image: tt.image, PullPolicy: tt.policy})
+
+			if tt.wantErr {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.image)
+				assert.False(t, fake.HasRequest("POST /containers/create"))
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, tt.wantPull, fake.HasRequest("POST /images/create"))
+		})
+	}
+}
+
+func TestRun_OfflineOverridesPullAlways(t *testing.T) {
+	t.Setenv(docker.OfflineEnvVar, "1")
+	fake, client := dockertest.NewServer(t)
+
+	_, err := client.Run(context.Background(), docker.RunOptions{Name: "ork-shop-web", Image: "nginx:latest", PullPolicy: docker.PullAlways})
+
+	require.NoError(t, err, "a present image is used as-is offline")
+	assert.False(t, fake.HasRequest("POST /images/create"))
+}
+
+func TestParsePullPolicy(t *testing.T) {
+	for value, want := range map[string]docker.PullPolicy{
+		"":        docker.PullMissing,
+		"missing": docker.PullMissing,
+		"always":  docker.PullAlways,
+		"never":   docker.PullNever,
+	} {
+		got, err := docker.ParsePullPolicy(value)
+		require.NoError(t, err, value)
+		assert.Equal(t, want, got, value)
+	}
+
+	_, err := docker.ParsePullPolicy("sometimes")
+	assert.ErrorContains(t, err, "invalid pull policy")
+}
+
+// ============================================================================
+// Pull Progress Tests
+// ============================================================================
+
+func TestPullImageWithProgress_ReportsStatus(t *testing.T) {
+	fake, client := dockertest.NewServer(t)
+	fake.Handle(http.MethodPost, "/images/create", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"status":"Pulling from library/nginx","id":"alpine"}` + "\n"))
+		_, _ = w.Write([]byte(`{"status":"Downloading","id":"3f4a1b","progressDetail":{"current":1000000,"total":4000000}}` + "\n"))
+	})
+
+	var statuses []string
+	err := client.PullImageWithProgress(context.Background(), "nginx:alpine", func(status string) {
+		statuses = append(statuses, status)
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"Pulling from library/nginx alpine", "Downloading 3f4a1b 1MB/4MB"}, statuses)
+}
+
+func TestPullImageWithProgress_StreamError(t *testing.T) {
+	fake, client := dockertest.NewServer(t)
+	fake.Handle(http.MethodPost, "/images/create", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"errorDetail":{"message":"manifest unknown"},"error":"manifest unknown"}` + "\n"))
+	})
+
+	err := client.PullImageWithProgress(context.Background(), "nginx:nope", nil)
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "manifest unknown")
+}
+
+func TestPullImageWithProgress_Offline(t *testing.T) {
+	t.Setenv(docker.OfflineEnvVar, "1")
+	fake, client := dockertest.NewServer(t)
+
+	err := client.PullImageWithProgress(context.Background(), "nginx:alpine", nil)
+
+	require.Error(t, err)
+	assert.False(t, fake.HasRequest("POST /images/create"))
+}
@@ -0,0 +1,64 @@
+package docker_test
+
+import (
+	"context"
+	"net/http"
+	"sync/atomic"
+	"testing"
+
+	"github.com/ork-cli/ork/internal/docker/dockertest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// failingRemoveHandler fails removal with the given status and message for the first n calls
+func failingRemoveHandler(n int32, status int, message string) (http.HandlerFunc, *atomic.Int32) {
+	var calls atomic.Int32
+	return func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) <= n {
+			w.WriteHeader(status)
+			_, _ = w.Write([]byte(`{"message":"` + message + `"}`))
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}, &calls
+}
+
+// ============================================================================
+// Container Removal Retry Tests
+// ============================================================================
+
+func TestRemove_RetriesDeviceBusy(t *testing.T) {
+	fake, client := dockertest.NewServer(t)
+	handler, calls := failingRemoveHandler(1, http.StatusInternalServerError,
+		"unable to remove filesystem: unlinkat /var/lib/docker/overlay2/abc/merged: device or resource busy")
+	fake.Handle(http.MethodDelete, "/containers/api-container", handler)
+
+	err := client.Remove(context.Background(), "api-container")
+
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), calls.Load(), "expected one retry after the busy error")
+}
+
+func TestRemove_GivesUpAfterRepeatedDeviceBusy(t *testing.T) {
+	fake, client := dockertest.NewServer(t)
+	handler, calls := failingRemoveHandler(10, http.StatusInternalServerError, "device or resource busy")
+	fake.Handle(http.MethodDelete, "/containers/api-container", handler)
+
+	err := client.Remove(context.Background(), "api-container")
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "device or resource busy")
+	assert.Equal(t, int32(3), calls.Load())
+}
+
+func TestRemove_DoesNotRetryOtherErrors(t *testing.T) {
+	fake, client := dockertest.NewServer(t)
+	handler, calls := failingRemoveHandler(1, http.StatusConflict, "You cannot remove a running container")
+	fake.Handle(http.MethodDelete, "/containers/api-container", handler)
+
+	err := client.Remove(context.Background(), "api-container")
+
+	require.Error(t, err)
+	assert.Equal(t, int32(1), calls.Load())
+}
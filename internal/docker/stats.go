@@ -0,0 +1,96 @@
+package docker
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/docker/docker/api/types/container"
+)
+
+// ============================================================================
+// Type Definitions
+// ============================================================================
+
+// ContainerStats is a single resource usage sample for a container
+type ContainerStats struct {
+	CPUPercent    float64 // CPU usage as a percentage of one CPU (can exceed 100 on multi-core hosts)
+	MemoryUsage   uint64  // Memory in use, excluding the page cache (bytes)
+	MemoryLimit   uint64  // Memory the container may use (bytes; the host total when unlimited)
+	MemoryPercent float64 // MemoryUsage as a percentage of MemoryLimit
+}
+
+// ============================================================================
+// Public Methods - Stats
+// ============================================================================
+
+// Stats returns a single resource usage sample for a container
+// The daemon takes about a second to answer, since CPU usage is measured between two readings
+func (c *Client) Stats(ctx context.Context, containerID string) (ContainerStats, error) {
+	if containerID == "" {
+		return ContainerStats{}, fmt.Errorf(errContainerIDEmpty)
+	}
+
+	reader, err := c.cli.ContainerStats(ctx, containerID, false)
+	if err != nil {
+		return ContainerStats{}, fmt.Errorf("failed to get stats for container %s: %w", containerID, err)
+	}
+	defer func() { _ = reader.Body.Close() }()
+
+	var sample container.StatsResponse
+	if err := json.NewDecoder(reader.Body).Decode(&sample); err != nil {
+		return ContainerStats{}, fmt.Errorf("failed to decode stats for container %s: %w", containerID, err)
+	}
+
+	return convertStats(sample), nil
+}
+
+// ============================================================================
+// Private Helpers
+// ============================================================================
+
+// convertStats converts a Docker stats sample to our format
+func convertStats(sample container.StatsResponse) ContainerStats {
+	stats := ContainerStats{
+		CPUPercent:  calculateCPUPercent(sample.PreCPUStats, sample.CPUStats),
+		MemoryUsage: memoryUsage(sample.MemoryStats),
+		MemoryLimit: sample.MemoryStats.Limit,
+	}
+	if stats.MemoryLimit > 0 {
+		stats.MemoryPercent = float64(stats.MemoryUsage) / float64(stats.MemoryLimit) * 100
+	}
+	return stats
+}
+
+// calculateCPUPercent computes CPU usage between two readings, the same way 'docker stats' does:
+// the container's share of the host's CPU time, scaled by the number of online CPUs
+// Returns 0 when there is no earlier reading or no time has passed
+func calculateCPUPercent(previous, current container.CPUStats) float64 {
+	if previous.SystemUsage == 0 {
+		return 0
+	}
+	if current.CPUUsage.TotalUsage <= previous.CPUUsage.TotalUsage || current.SystemUsage <= previous.SystemUsage {
+		return 0
+	}
+
+	cpuDelta := float64(current.CPUUsage.TotalUsage - previous.CPUUsage.TotalUsage)
+	systemDelta := float64(current.SystemUsage - previous.SystemUsage)
+
+	onlineCPUs := float64(current.OnlineCPUs)
+	if onlineCPUs == 0 {
+		onlineCPUs = float64(len(current.CPUUsage.PercpuUsage))
+	}
+
+	return cpuDelta / systemDelta * onlineCPUs * 100
+}
+
+// memoryUsage returns memory in use minus the reclaimable page cache, like 'docker stats'
+// cgroup v1 reports the cache as "total_inactive_file", cgroup v2 as "inactive_file"
+func memoryUsage(mem container.MemoryStats) uint64 {
+	for _, key := range []string{"total_inactive_file", "inactive_file"} {
+		if cache, ok := mem.Stats[key]; ok && cache < mem.Usage {
+			return mem.Usage - cache
+		}
+	}
+	return mem.Usage
+}
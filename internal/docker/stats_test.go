@@ -0,0 +1,107 @@
+package docker
+
+import (
+	"testing"
+
+	"github.com/docker/docker/api/types/container"
+	"github.com/stretchr/testify/assert"
+)
+
+// ============================================================================
+// Helper Function Tests - CPU
+// ============================================================================
+
+func cpuSample(total, system uint64, online uint32) container.CPUStats {
+	return container.CPUStats{
+		CPUUsage:    container.CPUUsage{TotalUsage: total},
+		SystemUsage: system,
+		OnlineCPUs:  online,
+	}
+}
+
+func TestCalculateCPUPercent(t *testing.T) {
+	tests := []struct {
+		name     string
+		previous container.CPUStats
+		current  container.CPUStats
+		expected float64
+	}{
+		{
+			name:     "quarter of one CPU",
+			previous: cpuSample(1_000, 10_000, 1),
+			current:  cpuSample(3_500, 20_000, 1),
+			expected: 25,
+		},
+		{
+			name:     "scaled by online CPUs",
+			previous: cpuSample(1_000, 10_000, 4),
+			current:  cpuSample(3_500, 20_000, 4),
+			expected: 100,
+		},
+		{
+			name:     "no earlier reading",
+			previous: container.CPUStats{},
+			current:  cpuSample(3_500, 20_000, 4),
+			expected: 0,
+		},
+		{
+			name:     "idle container",
+			previous: cpuSample(1_000, 10_000, 2),
+			current:  cpuSample(1_000, 20_000, 2),
+			expected: 0,
+		},
+		{
+			name:     "no system time elapsed",
+			previous: cpuSample(1_000, 10_000, 2),
+			current:  cpuSample(2_000, 10_000, 2),
+			expected: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.InDelta(t, tt.expected, calculateCPUPercent(tt.previous, tt.current), 0.0001)
+		})
+	}
+}
+
+func TestCalculateCPUPercent_FallsBackToPerCPUCount(t *testing.T) {
+	previous := cpuSample(1_000, 10_000, 0)
+	current := cpuSample(3_500, 20_000, 0)
+	current.CPUUsage.PercpuUsage = []uint64{1, 1}
+
+	assert.InDelta(t, 50, calculateCPUPercent(previous, current), 0.0001)
+}
+
+// ============================================================================
+// Helper Function Tests - Memory
+// ============================================================================
+
+func TestConvertStats_MemoryExcludesPageCache(t *testing.T) {
+	tests := []struct {
+		name  string
+		stats map[string]uint64
+	}{
+		{"cgroup v1", map[string]uint64{"total_inactive_file": 100 << 20}},
+		{"cgroup v2", map[string]uint64{"inactive_file": 100 << 20}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stats := convertStats(container.StatsResponse{
+				MemoryStats: container.MemoryStats{Usage: 300 << 20, Limit: 1 << 30, Stats: tt.stats},
+			})
+
+			assert.Equal(t, uint64(200<<20), stats.MemoryUsage)
+			assert.Equal(t, uint64(1<<30), stats.MemoryLimit)
+			assert.InDelta(t, 19.53, stats.MemoryPercent, 0.01)
+		})
+	}
+}
+
+func TestConvertStats_NoMemoryLimit(t *testing.T) {
+	stats := convertStats(container.StatsResponse{MemoryStats: container.MemoryStats{Usage: 1024}})
+
+	assert.Equal(t, uint64(1024), stats.MemoryUsage)
+	assert.Zero(t, stats.MemoryPercent)
+}
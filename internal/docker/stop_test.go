@@ -0,0 +1,111 @@
+package docker_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/ork-cli/ork/internal/docker"
+	"github.com/ork-cli/ork/internal/docker/dockertest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// ============================================================================
+// Container Stop Tests
+// ============================================================================
+
+func TestStop_WithoutGracePeriodStopsRightAway(t *testing.T) {
+	fake, client := dockertest.NewServer(t)
+	fake.AddContainer("aaaaaaaaaaaa", "shop", "api", "Up 5 minutes")
+
+	require.NoError(t, client.Stop(context.Background(), "aaaaaaaaaaaa", docker.StopOptions{Timeout: 5 * time.Second}))
+
+	assert.Equal(t, []string{"POST /containers/aaaaaaaaaaaa/stop"}, fake.Mutations())
+}
+
+func TestStop_ExitWithinGracePeriodSkipsStop(t *testing.T) {
+	fake, client := dockertest.NewServer(t)
+	fake.AddContainer("aaaaaaaaaaaa", "shop", "api", "Up 5 minutes")
+
+	err := client.Stop(context.Background(), "aaaaaaaaaaaa", docker.StopOptions{Timeout: 5 * time.Second, GracePeriod: time.Minute})
+
+	require.NoError(t, err)
+	assert.True(t, fake.HasRequest("POST /containers/aaaaaaaaaaaa/kill"))
+	assert.True(t, fake.HasRequest("POST /containers/aaaaaaaaaaaa/wait"))
+	assert.False(t, fake.HasRequest("POST /containers/aaaaaaaaaaaa/stop"), "exited during the grace period")
+}
+
+// ignoreStopSignal makes the fake container ignore its stop signal, recording the signals it was sent
+// (a stop request is recorded as "stop:<signal>/<timeout seconds>") and when it was stopped
+// The wait only ends when the client gives up
+func ignoreStopSignal(fake *dockertest.Server) (*[]string, *time.Time) {
+	var signals []string
+	var stoppedAt time.Time
+	fake.Handle(http.MethodPost, "/containers/aaaaaaaaaaaa/kill", func(w http.ResponseWriter, r *http.Request) {
+		signals = append(signals, r.URL.Query().Get("signal"))
+		w.WriteHeader(http.StatusNoContent)
+	})
+	fake.Handle(http.MethodPost, "/containers/aaaaaaaaaaaa/wait", func(_ http.ResponseWriter, r *http.Request) {
+		<-r.Context().Done()
+	})
+	fake.Handle(http.MethodPost, "/containers/aaaaaaaaaaaa/stop", func(w http.ResponseWriter, r *http.Request) {
+		stoppedAt = time.Now()
+		signals = append(signals, "stop:"+r.URL.Query().Get("signal")+"/"+r.URL.Query().Get("t"))
+		w.WriteHeader(http.StatusNoContent)
+	})
+	return &signals, &stoppedAt
+}
+
+func TestStop_StillRunningAfterGracePeriodIsStopped(t *testing.T) {
+	fake, client := dockertest.NewServer(t)
+	fake.AddContainer("aaaaaaaaaaaa", "shop", "api", "Up 5 minutes")
+	signals, _ := ignoreStopSignal(fake)
+
+	err := client.Stop(context.Background(), "aaaaaaaaaaaa", docker.StopOptions{Timeout: 5 * time.Second, GracePeriod: 50 * time.Millisecond})
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"SIGTERM", "stop:/5"}, *signals, "after the grace period, a regular stop gets the whole stop timeout")
+}
+
+func TestStop_TimeoutStartsAfterGracePeriod(t *testing.T) {
+	fake, client := dockertest.NewServer(t)
+	fake.AddContainer("aaaaaaaaaaaa", "shop", "api", "Up 5 minutes")
+	signals, stoppedAt := ignoreStopSignal(fake)
+
+	start := time.Now()
+	err := client.Stop(context.Background(), "aaaaaaaaaaaa", docker.StopOptions{Timeout: 300 * time.Millisecond, GracePeriod: 200 * time.Millisecond})
+
+	require.NoError(t, err)
+	assert.GreaterOrEqual(t, stoppedAt.Sub(start), 200*time.Millisecond, "the stop timeout only starts once the grace period is over")
+	assert.Less(t, stoppedAt.Sub(start), 300*time.Millisecond, "a longer stop timeout doesn't extend the grace period")
+	assert.Equal(t, []string{"SIGTERM", "stop:/1"}, *signals)
+}
+
+func TestStop_GracePeriodUsesContainerStopSignal(t *testing.T) {
+	fake, client := dockertest.NewServer(t)
+	fake.Handle(http.MethodGet, "/containers/aaaaaaaaaaaa/json", func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{"Id": "aaaaaaaaaaaa", "State": {"Running": true}, "Config": {"StopSignal": "SIGQUIT"}}`))
+	})
+	signals, _ := ignoreStopSignal(fake)
+
+	err := client.Stop(context.Background(), "aaaaaaaaaaaa", docker.StopOptions{GracePeriod: 20 * time.Millisecond})
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"SIGQUIT", "stop:/0"}, *signals)
+}
+
+func TestStop_AlreadyStoppedDuringGracePeriod(t *testing.T) {
+	fake, client := dockertest.NewServer(t)
+	fake.Handle(http.MethodPost, "/containers/aaaaaaaaaaaa/kill", func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusConflict)
+		_, _ = w.Write([]byte(`{"message":"container aaaaaaaaaaaa is not running"}`))
+	})
+
+	err := client.Stop(context.Background(), "aaaaaaaaaaaa", docker.StopOptions{GracePeriod: time.Minute})
+
+	require.NoError(t, err)
+	assert.False(t, fake.HasRequest("POST /containers/aaaaaaaaaaaa/wait"))
+	assert.False(t, fake.HasRequest("POST /containers/aaaaaaaaaaaa/stop"))
+}
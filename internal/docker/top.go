@@ -0,0 +1,58 @@
+package docker
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/docker/docker/api/types/container"
+)
+
+// ============================================================================
+// Type Definitions
+// ============================================================================
+
+// TopResult is the process list of a container, as reported by 'ps' inside it
+type TopResult struct {
+	Titles    []string   `json:"titles"`    // Column titles (e.g., "UID", "PID", "CMD")
+	Processes [][]string `json:"processes"` // One row per process, one value per title
+}
+
+// ============================================================================
+// Public Methods - Top
+// ============================================================================
+
+// Top lists the processes running in a container, like 'docker top'
+// The container must be running
+func (c *Client) Top(ctx context.Context, containerID string) (TopResult, error) {
+	if containerID == "" {
+		return TopResult{}, fmt.Errorf(errContainerIDEmpty)
+	}
+
+	resp, err := c.cli.ContainerTop(ctx, containerID, nil)
+	if err != nil {
+		return TopResult{}, fmt.Errorf("failed to list processes in container %s: %w", containerID, err)
+	}
+
+	return convertTopResult(resp), nil
+}
+
+// ============================================================================
+// Private Helpers
+// ============================================================================
+
+// convertTopResult converts a Docker top response to our format
+// Every row is padded or trimmed to the number of titles, so rows always line up with the columns
+func convertTopResult(resp container.TopResponse) TopResult {
+	result := TopResult{
+		Titles:    append([]string{}, resp.Titles...),
+		Processes: make([][]string, 0, len(resp.Processes)),
+	}
+
+	for _, process := range resp.Processes {
+		row := make([]string, len(result.Titles))
+		copy(row, process)
+		result.Processes = append(result.Processes, row)
+	}
+
+	return result
+}
@@ -0,0 +1,56 @@
+package docker
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/docker/docker/api/types/container"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// ============================================================================
+// Helper Function Tests - Top
+// ============================================================================
+
+func TestConvertTopResult_ParsesTitlesAndProcesses(t *testing.T) {
+	// The shape the daemon returns for GET /containers/{id}/top
+	body := `{
+		"Titles": ["UID", "PID", "PPID", "C", "STIME", "TTY", "TIME", "CMD"],
+		"Processes": [
+			["root", "1234", "1210", "0", "09:12", "?", "00:00:01", "nginx: master process nginx -g daemon off;"],
+			["101", "1290", "1234", "0", "09:12", "?", "00:00:00", "nginx: worker process"]
+		]
+	}`
+	var resp container.TopResponse
+	require.NoError(t, json.Unmarshal([]byte(body), &resp))
+
+	result := convertTopResult(resp)
+
+	assert.Equal(t, []string{"UID", "PID", "PPID", "C", "STIME", "TTY", "TIME", "CMD"}, result.Titles)
+	require.Len(t, result.Processes, 2)
+	assert.Equal(t, "1234", result.Processes[0][1])
+	assert.Equal(t, "nginx: master process nginx -g daemon off;", result.Processes[0][7], "the command keeps its spaces")
+	assert.Equal(t, "nginx: worker process", result.Processes[1][7])
+}
+
+func TestConvertTopResult_AlignsRowsWithTitles(t *testing.T) {
+	resp := container.TopResponse{
+		Titles: []string{"PID", "USER", "COMMAND"},
+		Processes: [][]string{
+			{"1", "root"},
+			{"2", "root", "sh", "extra"},
+		},
+	}
+
+	result := convertTopResult(resp)
+
+	assert.Equal(t, [][]string{{"1", "root", ""}, {"2", "root", "sh"}}, result.Processes)
+}
+
+func TestConvertTopResult_NoProcesses(t *testing.T) {
+	result := convertTopResult(container.TopResponse{Titles: []string{"PID", "CMD"}})
+
+	assert.Equal(t, []string{"PID", "CMD"}, result.Titles)
+	assert.Empty(t, result.Processes)
+}
@@ -0,0 +1,77 @@
+package docker
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/docker/docker/api/types/volume"
+	"github.com/docker/docker/errdefs"
+)
+
+// ============================================================================
+// Public Methods - Volume Lifecycle
+// ============================================================================
+
+// EnsureVolume creates a named volume labeled with the project that owns it
+// Named volumes are host-wide, so the label is what lets 'ork down --volumes' tell this
+// project's volumes apart from another project's volume of the same name
+// An existing volume is left as it is, whoever created it
+func (c *Client) EnsureVolume(ctx context.Context, name, projectName string) error {
+	if _, err := c.cli.VolumeInspect(ctx, name); err == nil {
+		return nil
+	} else if !errdefs.IsNotFound(err) {
+		return fmt.Errorf("failed to inspect volume %s: %w", name, err)
+	}
+
+	if _, err := c.cli.VolumeCreate(ctx, volume.CreateOptions{
+		Name:   name,
+		Labels: buildVolumeLabels(projectName),
+	}); err != nil {
+		return fmt.Errorf("failed to create volume %s: %w", name, err)
+	}
+
+	return nil
+}
+
+// RemoveProjectVolume removes a named volume, but only if it was created for projectName
+// Returns false without removing anything when the volume belongs to another project or
+// wasn't created by Ork; a volume that doesn't exist is treated as already removed
+func (c *Client) RemoveProjectVolume(ctx context.Context, name, projectName string) (bool, error) {
+	vol, err := c.cli.VolumeInspect(ctx, name)
+	if errdefs.IsNotFound(err) {
+		return true, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("failed to inspect volume %s: %w", name, err)
+	}
+	if vol.Labels["ork.project"] != projectName {
+		return false, nil
+	}
+
+	return true, c.RemoveVolume(ctx, name)
+}
+
+// RemoveVolume removes a named Docker volume
+// A volume that doesn't exist is treated as already removed
+func (c *Client) RemoveVolume(ctx context.Context, name string) error {
+	if err := c.cli.VolumeRemove(ctx, name, false); err != nil {
+		if errdefs.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("failed to remove volume %s: %w", name, err)
+	}
+
+	return nil
+}
+
+// ============================================================================
+// Private Helpers
+// ============================================================================
+
+// buildVolumeLabels creates the standard Ork labels for volume tracking
+func buildVolumeLabels(projectName string) map[string]string {
+	return map[string]string{
+		"ork.managed": "true",
+		"ork.project": projectName,
+	}
+}
@@ -0,0 +1,50 @@
+package docker_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ork-cli/ork/internal/docker/dockertest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// ============================================================================
+// Volume Ownership Tests
+// ============================================================================
+
+func TestEnsureVolume_LabelsNewVolumeWithProject(t *testing.T) {
+	fake, client := dockertest.NewServer(t)
+
+	require.NoError(t, client.EnsureVolume(context.Background(), "pgdata", "shop"))
+
+	assert.Equal(t, "shop", fake.VolumeLabels("pgdata")["ork.project"])
+}
+
+func TestRemoveProjectVolume_SharedNameAcrossProjects(t *testing.T) {
+	fake, client := dockertest.NewServer(t)
+	ctx := context.Background()
+
+	// Two projects both declare "pgdata"; the first one to start creates (and owns) it
+	require.NoError(t, client.EnsureVolume(ctx, "pgdata", "shop"))
+	require.NoError(t, client.EnsureVolume(ctx, "pgdata", "blog"))
+
+	removed, err := client.RemoveProjectVolume(ctx, "pgdata", "blog")
+	require.NoError(t, err)
+	assert.False(t, removed, "blog must not delete shop's data")
+	assert.True(t, fake.HasVolume("pgdata"))
+
+	removed, err = client.RemoveProjectVolume(ctx, "pgdata", "shop")
+	require.NoError(t, err)
+	assert.True(t, removed)
+	assert.False(t, fake.HasVolume("pgdata"))
+}
+
+func TestRemoveProjectVolume_MissingIsRemoved(t *testing.T) {
+	_, client := dockertest.NewServer(t)
+
+	removed, err := client.RemoveProjectVolume(context.Background(), "pgdata", "shop")
+
+	require.NoError(t, err)
+	assert.True(t, removed)
+}
@@ -3,6 +3,7 @@ package git
 import (
 	"fmt"
 	"os"
+	"path"
 	"path/filepath"
 	"strings"
 
@@ -15,9 +16,23 @@ import (
 
 // Repository represents a discovered git repository
 type Repository struct {
-	Name string // Repository name (e.g., "frontend", "api")
-	Path string // Absolute path to the repository
-	URL  string // Git remote URL (e.g., "github.com/org/repo")
+	Name      string `json:"name"`      // Repository name (e.g., "frontend", "api")
+	Path      string `json:"path"`      // Absolute path to the repository
+	URL       string `json:"url"`       // Git remote URL (e.g., "github.com/org/repo")
+	Workspace string `json:"workspace"` // Absolute path of the workspace the repository was discovered in
+}
+
+// DiscoverOptions controls how workspaces are scanned for repositories
+type DiscoverOptions struct {
+	MaxDepth int      // Maximum directory depth to search (0 or negative uses default of 3)
+	Exclude  []string // Glob patterns (path.Match) for directories to skip, matched against the name or workspace-relative path
+}
+
+// scanner walks a single workspace looking for repositories
+type scanner struct {
+	root     string   // Workspace directory being scanned
+	maxDepth int      // Maximum directory depth to search
+	exclude  []string // Glob patterns for directories to prune
 }
 
 // ============================================================================
@@ -27,6 +42,7 @@ type Repository struct {
 // DiscoverRepositories scans workspace directories and finds git repositories.
 // It searches up to maxDepth levels deep (default: 3 if maxDepth <= 0).
 // Automatically skips hidden directories (except .ork), node_modules, vendor, dist, and build.
+// Use DiscoverRepositoriesWithOptions to skip additional directories.
 //
 // Parameters:
 //   - workspaceDirs: List of directories to scan (supports ~ for home directory)
@@ -47,12 +63,29 @@ type Repository struct {
 //	    fmt.Printf("%s: %s\n", repo.Name, repo.Path)
 //	}
 func DiscoverRepositories(workspaceDirs []string, maxDepth int) ([]Repository, error) {
+	return DiscoverRepositoriesWithOptions(workspaceDirs, DiscoverOptions{MaxDepth: maxDepth})
+}
+
+// DiscoverRepositoriesWithOptions scans workspace directories like DiscoverRepositories.
+// Directories matching opts.Exclude are pruned during the walk, so their subtrees are never read.
+// A pattern matches either a directory's name ("node_modules", "legacy-*") or its
+// slash-separated path relative to the workspace ("archive/*").
+// Each repository records the (expanded) workspace root it was found under.
+func DiscoverRepositoriesWithOptions(workspaceDirs []string, opts DiscoverOptions) ([]Repository, error) {
+	maxDepth := opts.MaxDepth
 	if maxDepth <= 0 {
 		maxDepth = 3 // Default depth
 	}
 
+	// Reject malformed patterns up front rather than silently never matching
+	for _, pattern := range opts.Exclude {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return nil, fmt.Errorf("invalid exclude pattern '%s': %w", pattern, err)
+		}
+	}
+
 	var repos []Repository
-	seen := make(map[string]bool) // Track repos we've already found
+	seen := make(map[string]int) // Track repos we've already found (path -> index in repos)
 
 	for _, workspace := range workspaceDirs {
 		expandedPath := expandHomePath(workspace)
@@ -60,10 +93,14 @@ func DiscoverRepositories(workspaceDirs []string, maxDepth int) ([]Repository, e
 			continue
 		}
 
-		found, err := scanDirectory(expandedPath, 0, maxDepth)
+		s := scanner{root: expandedPath, maxDepth: maxDepth, exclude: opts.Exclude}
+		found, err := s.scanDirectory(expandedPath, 0)
 		if err != nil {
 			return nil, fmt.Errorf("failed to scan workspace %s: %w", workspace, err)
 		}
+		for i := range found {
+			found[i].Workspace = expandedPath
+		}
 
 		repos = deduplicateRepos(repos, found, seen)
 	}
@@ -96,11 +133,17 @@ func directoryExists(path string) bool {
 }
 
 // deduplicateRepos adds new repos to the list, skipping duplicates
-func deduplicateRepos(existing, found []Repository, seen map[string]bool) []Repository {
+// A repo under nested workspaces is attributed to the innermost one, whatever order they were scanned in
+func deduplicateRepos(existing, found []Repository, seen map[string]int) []Repository {
 	for _, repo := range found {
-		if !seen[repo.Path] {
+		i, ok := seen[repo.Path]
+		if !ok {
+			seen[repo.Path] = len(existing)
 			existing = append(existing, repo)
-			seen[repo.Path] = true
+			continue
+		}
+		if len(repo.Workspace) > len(existing[i].Workspace) {
+			existing[i].Workspace = repo.Workspace
 		}
 	}
 	return existing
@@ -110,9 +153,9 @@ func deduplicateRepos(existing, found []Repository, seen map[string]bool) []Repo
 // Internal Helper Functions - Directory Scanning
 // ============================================================================
 
-// scanDirectory recursively searches for git repositories up to maxDepth
-func scanDirectory(dir string, currentDepth, maxDepth int) ([]Repository, error) {
-	if currentDepth > maxDepth {
+// scanDirectory recursively searches for git repositories up to the scanner's max depth
+func (s scanner) scanDirectory(dir string, currentDepth int) ([]Repository, error) {
+	if currentDepth > s.maxDepth {
 		return []Repository{}, nil
 	}
 
@@ -120,7 +163,7 @@ func scanDirectory(dir string, currentDepth, maxDepth int) ([]Repository, error)
 		return handleGitRepository(dir)
 	}
 
-	return scanSubdirectories(dir, currentDepth, maxDepth)
+	return s.scanSubdirectories(dir, currentDepth)
 }
 
 // handleGitRepository creates a repository entry for a git directory
@@ -133,7 +176,7 @@ func handleGitRepository(dir string) ([]Repository, error) {
 }
 
 // scanSubdirectories recursively scans subdirectories for git repositories
-func scanSubdirectories(dir string, currentDepth, maxDepth int) ([]Repository, error) {
+func (s scanner) scanSubdirectories(dir string, currentDepth int) ([]Repository, error) {
 	entries, err := os.ReadDir(dir)
 	if err != nil {
 		return []Repository{}, nil // Permission denied or other errors
@@ -146,7 +189,11 @@ func scanSubdirectories(dir string, currentDepth, maxDepth int) ([]Repository, e
 		}
 
 		subdirPath := filepath.Join(dir, entry.Name())
-		found, err := scanDirectory(subdirPath, currentDepth+1, maxDepth)
+		if s.isExcluded(subdirPath) {
+			continue // Prune the whole subtree
+		}
+
+		found, err := s.scanDirectory(subdirPath, currentDepth+1)
 		if err != nil {
 			continue
 		}
@@ -157,6 +204,30 @@ func scanSubdirectories(dir string, currentDepth, maxDepth int) ([]Repository, e
 	return repos, nil
 }
 
+// isExcluded reports whether a directory matches an exclude pattern by name or workspace-relative path
+func (s scanner) isExcluded(dir string) bool {
+	if len(s.exclude) == 0 {
+		return false
+	}
+
+	name := filepath.Base(dir)
+	relPath, err := filepath.Rel(s.root, dir)
+	if err != nil {
+		relPath = name
+	}
+	relPath = filepath.ToSlash(relPath)
+
+	for _, pattern := range s.exclude {
+		if matched, _ := path.Match(pattern, name); matched {
+			return true
+		}
+		if matched, _ := path.Match(pattern, relPath); matched {
+			return true
+		}
+	}
+	return false
+}
+
 // shouldSkipDirectory determines if a directory should be skipped during scanning
 func shouldSkipDirectory(entry os.DirEntry) bool {
 	if !entry.IsDir() {
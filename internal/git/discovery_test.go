@@ -157,6 +157,121 @@ func TestDiscoverRepositories_NonExistentWorkspace(t *testing.T) {
 	assert.Equal(t, 0, len(repos), "Should return empty list for non-existent workspace")
 }
 
+func TestDiscoverRepositoriesWithOptions_ExcludePrunesSubtree(t *testing.T) {
+	workspace := t.TempDir()
+
+	_, err := git.PlainInit(filepath.Join(workspace, "active"), false)
+	require.NoError(t, err)
+
+	// Everything below "archive" should be skipped, however deep
+	_, err = git.PlainInit(filepath.Join(workspace, "archive", "old-api"), false)
+	require.NoError(t, err)
+	_, err = git.PlainInit(filepath.Join(workspace, "archive", "2019", "old-web"), false)
+	require.NoError(t, err)
+
+	repos, err := DiscoverRepositoriesWithOptions([]string{workspace}, DiscoverOptions{MaxDepth: 3, Exclude: []string{"archive"}})
+	require.NoError(t, err)
+
+	require.Len(t, repos, 1)
+	assert.Equal(t, "active", repos[0].Name)
+}
+
+func TestDiscoverRepositoriesWithOptions_ExcludeByRepoName(t *testing.T) {
+	workspace := t.TempDir()
+
+	for _, name := range []string{"api", "legacy-billing", "group/legacy-auth"} {
+		_, err := git.PlainInit(filepath.Join(workspace, name), false)
+		require.NoError(t, err)
+	}
+
+	repos, err := DiscoverRepositoriesWithOptions([]string{workspace}, DiscoverOptions{Exclude: []string{"legacy-*"}})
+	require.NoError(t, err)
+
+	require.Len(t, repos, 1)
+	assert.Equal(t, "api", repos[0].Name)
+}
+
+func TestDiscoverRepositoriesWithOptions_ExcludeByRelativePath(t *testing.T) {
+	workspace := t.TempDir()
+
+	_, err := git.PlainInit(filepath.Join(workspace, "clients", "acme"), false)
+	require.NoError(t, err)
+	_, err = git.PlainInit(filepath.Join(workspace, "internal", "acme"), false)
+	require.NoError(t, err)
+
+	repos, err := DiscoverRepositoriesWithOptions([]string{workspace}, DiscoverOptions{Exclude: []string{"clients/*"}})
+	require.NoError(t, err)
+
+	require.Len(t, repos, 1)
+	assert.Equal(t, filepath.Join(workspace, "internal", "acme"), repos[0].Path)
+}
+
+func TestDiscoverRepositories_AttributesWorkspace(t *testing.T) {
+	code := t.TempDir()
+	projects := t.TempDir()
+
+	for _, path := range []string{filepath.Join(code, "api"), filepath.Join(code, "group", "web"), filepath.Join(projects, "blog")} {
+		_, err := git.PlainInit(path, false)
+		require.NoError(t, err)
+	}
+
+	repos, err := DiscoverRepositories([]string{code, projects}, 3)
+	require.NoError(t, err)
+	require.Len(t, repos, 3)
+
+	workspaces := make(map[string]string)
+	for _, repo := range repos {
+		workspaces[repo.Name] = repo.Workspace
+	}
+	assert.Equal(t, code, workspaces["api"])
+	assert.Equal(t, code, workspaces["web"], "nested repos belong to the workspace, not the parent directory")
+	assert.Equal(t, projects, workspaces["blog"])
+}
+
+func TestDiscoverRepositories_NestedWorkspacesUseInnermost(t *testing.T) {
+	code := t.TempDir()
+	clients := filepath.Join(code, "clients")
+
+	_, err := git.PlainInit(filepath.Join(clients, "acme"), false)
+	require.NoError(t, err)
+	_, err = git.PlainInit(filepath.Join(code, "api"), false)
+	require.NoError(t, err)
+
+	// Repos under both workspaces are reported once, under the innermost, whatever the order
+	for _, workspaces := range [][]string{{code, clients}, {clients, code}} {
+		repos, err := DiscoverRepositories(workspaces, 3)
+		require.NoError(t, err)
+		require.Len(t, repos, 2)
+
+		byName := make(map[string]string)
+		for _, repo := range repos {
+			byName[repo.Name] = repo.Workspace
+		}
+		assert.Equal(t, clients, byName["acme"], "workspaces %v", workspaces)
+		assert.Equal(t, code, byName["api"], "workspaces %v", workspaces)
+	}
+}
+
+func TestDiscoverRepositories_ExpandsWorkspaceHome(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+
+	_, err := git.PlainInit(filepath.Join(home, "code", "api"), false)
+	require.NoError(t, err)
+
+	repos, err := DiscoverRepositories([]string{"~/code"}, 3)
+	require.NoError(t, err)
+	require.Len(t, repos, 1)
+	assert.Equal(t, filepath.Join(home, "code"), repos[0].Workspace)
+}
+
+func TestDiscoverRepositoriesWithOptions_InvalidPattern(t *testing.T) {
+	_, err := DiscoverRepositoriesWithOptions([]string{t.TempDir()}, DiscoverOptions{Exclude: []string{"[unclosed"}})
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "[unclosed")
+}
+
 func TestFindRepository(t *testing.T) {
 	repos := []Repository{
 		{Name: "frontend", Path: "/home/user/code/frontend", URL: "github.com/org/frontend"},
@@ -1,12 +1,15 @@
 package git
 
 import (
+	"container/heap"
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
 
 	"github.com/go-git/go-git/v5"
 	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
 )
 
 // ============================================================================
@@ -27,6 +30,7 @@ const (
 	errNoRemoteURLs     = "remote has no URLs"
 	errDetachedHead     = "repository is in detached HEAD state"
 	errCheckUncommitted = "failed to check for uncommitted changes: %w"
+	errGetTags          = "failed to get tags: %w"
 )
 
 // State constants
@@ -34,6 +38,7 @@ const (
 	stateDetachedHead = "detached HEAD"
 	stateNoCommits    = "no commits"
 	stateClean        = "clean"
+	detachedPrefix    = "detached@"
 )
 
 // ============================================================================
@@ -108,7 +113,12 @@ func GetRepoState(path string) (*RepoState, error) {
 	branch, err := GetCurrentBranch(path)
 	if err != nil {
 		// Not a fatal error - might be in a detached HEAD state
-		state.Branch = stateDetachedHead
+		// Show a tag at HEAD or detached@<hash> when we can resolve one
+		if label, labelErr := DescribeDetachedHead(path); labelErr == nil {
+			state.Branch = label
+		} else {
+			state.Branch = stateDetachedHead
+		}
 	} else {
 		state.Branch = branch
 	}
@@ -211,6 +221,73 @@ func GetCommitHash(path string) (string, string, error) {
 	return shortHash, fullHash, nil
 }
 
+// DescribeDetachedHead returns a label for a detached HEAD: the name of a tag
+// pointing at the HEAD commit, or "detached@<shorthash>" if no tag matches.
+// When several tags match, the alphabetically first one is used.
+//
+// Example:
+//
+//	label, err := DescribeDetachedHead("/path/to/repo")
+//	if err != nil {
+//	    return err
+//	}
+//	fmt.Println(label) // "v1.2.0" or "detached@a1b2c3d"
+func DescribeDetachedHead(path string) (string, error) {
+	repo, err := openRepo(path)
+	if err != nil {
+		return "", err
+	}
+
+	head, err := getHead(repo)
+	if err != nil {
+		return "", err
+	}
+
+	tag, err := findTagAt(repo, head.Hash())
+	if err != nil {
+		return "", err
+	}
+	if tag != "" {
+		return tag, nil
+	}
+
+	return detachedPrefix + head.Hash().String()[:7], nil
+}
+
+// findTagAt returns the alphabetically first tag pointing at the given commit
+// Handles both lightweight and annotated tags. Returns "" if no tag matches.
+func findTagAt(repo *git.Repository, commitHash plumbing.Hash) (string, error) {
+	tags, err := repo.Tags()
+	if err != nil {
+		return "", fmt.Errorf(errGetTags, err)
+	}
+
+	var match string
+	err = tags.ForEach(func(ref *plumbing.Reference) error {
+		target := ref.Hash()
+
+		// Annotated tags point at a tag object rather than the commit itself
+		if tagObject, err := repo.TagObject(target); err == nil {
+			commit, err := tagObject.Commit()
+			if err != nil {
+				return nil // Tag of a non-commit object - skip
+			}
+			target = commit.Hash
+		}
+
+		name := ref.Name().Short()
+		if target == commitHash && (match == "" || name < match) {
+			match = name
+		}
+		return nil
+	})
+	if err != nil {
+		return "", fmt.Errorf(errGetTags, err)
+	}
+
+	return match, nil
+}
+
 // ============================================================================
 // Change Detection
 // ============================================================================
@@ -389,9 +466,6 @@ func GetLatestCommitMessage(path string) (string, error) {
 // Returns the number of commits the local branch is ahead.
 // Returns 0 if the branches are in sync or if the remote branch doesn't exist.
 //
-// Note: This is a simplified implementation that returns 1 if there are any differences.
-// A full implementation would walk the commit graph to count exact commits ahead.
-//
 // Example:
 //
 //	ahead, err := IsAheadOfRemote("/path/to/repo")
@@ -402,44 +476,191 @@ func GetLatestCommitMessage(path string) (string, error) {
 //	    fmt.Printf("Local branch is %d commit(s) ahead of remote\n", ahead)
 //	}
 func IsAheadOfRemote(path string) (int, error) {
+	ahead, _, err := AheadBehind(path)
+	return ahead, err
+}
+
+// IsBehindRemote checks if the local branch is behind the remote branch.
+// Returns the number of commits the remote tracking branch has that the local branch doesn't.
+// Returns 0 if the branches are in sync or if the remote branch doesn't exist.
+//
+// Example:
+//
+//	behind, err := IsBehindRemote("/path/to/repo")
+//	if err != nil {
+//	    return err
+//	}
+//	if behind > 0 {
+//	    fmt.Printf("Local branch is %d commit(s) behind remote - run git pull\n", behind)
+//	}
+func IsBehindRemote(path string) (int, error) {
+	_, behind, err := AheadBehind(path)
+	return behind, err
+}
+
+// AheadBehind counts how many commits the local branch and its origin/<branch>
+// tracking ref each have that the other doesn't (relative to their merge-base).
+// Returns (0, 0) if the remote tracking branch doesn't exist, or if the history
+// is cut off before the merge-base (e.g., a shallow clone), since the counts are unknown.
+//
+// Example:
+//
+//	ahead, behind, err := AheadBehind("/path/to/repo")
+//	if err != nil {
+//	    return err
+//	}
+//	fmt.Printf("↑%d ↓%d\n", ahead, behind)
+func AheadBehind(path string) (ahead, behind int, err error) {
 	repo, err := openRepo(path)
 	if err != nil {
-		return 0, err
+		return 0, 0, err
 	}
 
 	head, err := getHead(repo)
 	if err != nil {
-		return 0, err
+		return 0, 0, err
 	}
 
-	// Get the current branch name
-	branchName := head.Name().Short()
-
 	// Get the remote tracking branch
-	remoteBranchName := plumbing.NewRemoteReferenceName("origin", branchName)
+	remoteBranchName := plumbing.NewRemoteReferenceName("origin", head.Name().Short())
 	remoteBranch, err := repo.Reference(remoteBranchName, true)
 	if err != nil {
 		// Remote branch might not exist
-		return 0, nil
+		return 0, 0, nil
+	}
+
+	// Same commit - nothing to walk
+	if head.Hash() == remoteBranch.Hash() {
+		return 0, 0, nil
 	}
 
-	// Count commits between local and remote
 	localCommit, err := repo.CommitObject(head.Hash())
 	if err != nil {
-		return 0, fmt.Errorf(errGetLocalCommit, err)
+		return 0, 0, fmt.Errorf(errGetLocalCommit, err)
 	}
 
 	remoteCommit, err := repo.CommitObject(remoteBranch.Hash())
 	if err != nil {
-		return 0, fmt.Errorf(errGetRemoteCommit, err)
+		return 0, 0, fmt.Errorf(errGetRemoteCommit, err)
 	}
 
-	// Simple check: if hashes are different, we might be ahead
-	if localCommit.Hash == remoteCommit.Hash {
-		return 0, nil
+	ahead, behind, known, err := countAheadBehind(repo, localCommit, remoteCommit)
+	if err != nil || !known {
+		// History cut off before the merge-base (e.g., a shallow clone) leaves the counts unknown
+		return 0, 0, err
 	}
+	return ahead, behind, nil
+}
+
+// ============================================================================
+// Internal Helper Functions - Ahead/Behind
+// ============================================================================
 
-	// For a more accurate count, we'd need to walk the commit graph
-	// For now, we'll just return 1 if they're different (simplified)
-	return 1, nil
+// Which side of an ahead/behind walk reaches a commit
+const (
+	reachLocal  uint8 = 1 << iota // Reachable from the local branch
+	reachRemote                   // Reachable from the remote tracking branch
+	reachBoth   = reachLocal | reachRemote
+)
+
+// commitQueue orders commits newest first, so the walk meets the merge-base before going past it
+type commitQueue []*object.Commit
+
+func (q commitQueue) Len() int           { return len(q) }
+func (q commitQueue) Less(i, j int) bool { return q[i].Committer.When.After(q[j].Committer.When) }
+func (q commitQueue) Swap(i, j int)      { q[i], q[j] = q[j], q[i] }
+func (q *commitQueue) Push(x any)        { *q = append(*q, x.(*object.Commit)) }
+func (q *commitQueue) Pop() any {
+	old := *q
+	commit := old[len(old)-1]
+	*q = old[:len(old)-1]
+	return commit
+}
+
+// countAheadBehind counts the commits only local reaches (ahead) and only remote reaches (behind)
+// Both histories are walked together, newest commit first, marking which side reaches each commit;
+// the walk stops once every pending commit is reachable from both sides, so shared history below the
+// merge-base is never loaded. Reports known=false if a commit only one side reaches is missing
+// (e.g., past a shallow clone's boundary), since the counts can't be trusted then.
+func countAheadBehind(repo *git.Repository, local, remote *object.Commit) (ahead, behind int, known bool, err error) {
+	reach := make(map[plumbing.Hash]uint8)
+	queued := make(map[plumbing.Hash]bool)
+	missing := make(map[plumbing.Hash]bool)
+	queue := &commitQueue{}
+	exclusive := 0 // Queued commits not (yet) known to be reachable from both sides
+
+	push := func(commit *object.Commit) {
+		heap.Push(queue, commit)
+		queued[commit.Hash] = true
+		if reach[commit.Hash] != reachBoth {
+			exclusive++
+		}
+	}
+
+	// mark records that side reaches hash, queueing it again if that's news, so it passes it on to its parents
+	mark := func(hash plumbing.Hash, side uint8) error {
+		before := reach[hash]
+		if before|side == before {
+			return nil
+		}
+		reach[hash] = before | side
+
+		if queued[hash] {
+			if reach[hash] == reachBoth {
+				exclusive--
+			}
+			return nil
+		}
+		if missing[hash] {
+			return nil
+		}
+
+		commit, err := repo.CommitObject(hash)
+		if errors.Is(err, plumbing.ErrObjectNotFound) {
+			missing[hash] = true
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf(errGetCommit, err)
+		}
+		push(commit)
+		return nil
+	}
+
+	reach[local.Hash] = reachLocal
+	push(local)
+	reach[remote.Hash] = reachRemote
+	push(remote)
+
+	for exclusive > 0 {
+		commit := heap.Pop(queue).(*object.Commit)
+		queued[commit.Hash] = false
+		side := reach[commit.Hash]
+		if side != reachBoth {
+			exclusive--
+		}
+
+		for _, parent := range commit.ParentHashes {
+			if err := mark(parent, side); err != nil {
+				return 0, 0, false, err
+			}
+		}
+	}
+
+	// A missing commit both sides reach is shared history, which doesn't affect the counts
+	for hash := range missing {
+		if reach[hash] != reachBoth {
+			return 0, 0, false, nil
+		}
+	}
+
+	for _, side := range reach {
+		switch side {
+		case reachLocal:
+			ahead++
+		case reachRemote:
+			behind++
+		}
+	}
+	return ahead, behind, true, nil
 }
@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
 	"github.com/go-git/go-git/v5/plumbing/object"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -480,3 +481,244 @@ func TestBuildChangesSummary(t *testing.T) {
 		})
 	}
 }
+
+func TestAheadBehind(t *testing.T) {
+	t.Run("no remote tracking branch", func(t *testing.T) {
+		repoPath, repo := createTestRepo(t)
+		createTestCommit(t, repo, repoPath, "a.txt", "a")
+
+		ahead, behind, err := AheadBehind(repoPath)
+		require.NoError(t, err)
+		assert.Equal(t, 0, ahead)
+		assert.Equal(t, 0, behind)
+	})
+
+	t.Run("in sync with remote", func(t *testing.T) {
+		repoPath, repo := createTestRepo(t)
+		createTestCommit(t, repo, repoPath, "a.txt", "a")
+		setRemoteRef(t, repo, headHash(t, repo))
+
+		ahead, behind, err := AheadBehind(repoPath)
+		require.NoError(t, err)
+		assert.Equal(t, 0, ahead)
+		assert.Equal(t, 0, behind)
+	})
+
+	t.Run("ahead only", func(t *testing.T) {
+		repoPath, repo := createTestRepo(t)
+		createTestCommit(t, repo, repoPath, "a.txt", "a")
+		setRemoteRef(t, repo, headHash(t, repo))
+		createTestCommit(t, repo, repoPath, "b.txt", "b")
+		createTestCommit(t, repo, repoPath, "c.txt", "c")
+
+		ahead, behind, err := AheadBehind(repoPath)
+		require.NoError(t, err)
+		assert.Equal(t, 2, ahead)
+		assert.Equal(t, 0, behind)
+	})
+
+	t.Run("diverged from remote", func(t *testing.T) {
+		repoPath, repo := createTestRepo(t)
+		createTestCommit(t, repo, repoPath, "a.txt", "a")
+		createTestCommit(t, repo, repoPath, "b.txt", "b")
+		base := headHash(t, repo)
+
+		// Commits that only the remote has
+		createTestCommit(t, repo, repoPath, "r1.txt", "r1")
+		createTestCommit(t, repo, repoPath, "r2.txt", "r2")
+		setRemoteRef(t, repo, headHash(t, repo))
+
+		// Rewind the local branch to the merge-base and diverge
+		w, err := repo.Worktree()
+		require.NoError(t, err)
+		require.NoError(t, w.Reset(&git.ResetOptions{Commit: base, Mode: git.HardReset}))
+		createTestCommit(t, repo, repoPath, "l1.txt", "l1")
+		createTestCommit(t, repo, repoPath, "l2.txt", "l2")
+		createTestCommit(t, repo, repoPath, "l3.txt", "l3")
+
+		ahead, behind, err := AheadBehind(repoPath)
+		require.NoError(t, err)
+		assert.Equal(t, 3, ahead)
+		assert.Equal(t, 2, behind)
+
+		// IsAheadOfRemote reports the same ahead count
+		aheadOnly, err := IsAheadOfRemote(repoPath)
+		require.NoError(t, err)
+		assert.Equal(t, 3, aheadOnly)
+	})
+
+	t.Run("history missing below the merge-base", func(t *testing.T) {
+		repoPath, repo := createTestRepo(t)
+		createTestCommit(t, repo, repoPath, "a.txt", "a")
+		root := headHash(t, repo)
+		createTestCommit(t, repo, repoPath, "b.txt", "b")
+		createTestCommit(t, repo, repoPath, "r1.txt", "r1")
+		setRemoteRef(t, repo, headHash(t, repo))
+		createTestCommit(t, repo, repoPath, "l1.txt", "l1")
+
+		// Like a shallow clone, the walk must stop at the merge-base instead of needing the root
+		removeCommitObject(t, repoPath, root)
+
+		ahead, behind, err := AheadBehind(repoPath)
+		require.NoError(t, err)
+		assert.Equal(t, 1, ahead)
+		assert.Equal(t, 0, behind)
+	})
+
+	t.Run("history missing before the merge-base", func(t *testing.T) {
+		repoPath, repo := createTestRepo(t)
+		createTestCommit(t, repo, repoPath, "a.txt", "a")
+		base := headHash(t, repo)
+		createTestCommit(t, repo, repoPath, "r1.txt", "r1")
+		remoteOnly := headHash(t, repo)
+		createTestCommit(t, repo, repoPath, "r2.txt", "r2")
+		setRemoteRef(t, repo, headHash(t, repo))
+
+		w, err := repo.Worktree()
+		require.NoError(t, err)
+		require.NoError(t, w.Reset(&git.ResetOptions{Commit: base, Mode: git.HardReset}))
+		createTestCommit(t, repo, repoPath, "l1.txt", "l1")
+
+		// Without r1 the merge-base can't be found, so the counts are unknown rather than an error
+		removeCommitObject(t, repoPath, remoteOnly)
+
+		ahead, behind, err := AheadBehind(repoPath)
+		require.NoError(t, err)
+		assert.Equal(t, 0, ahead)
+		assert.Equal(t, 0, behind)
+	})
+}
+
+func TestIsBehindRemote(t *testing.T) {
+	t.Run("no remote tracking branch", func(t *testing.T) {
+		repoPath, repo := createTestRepo(t)
+		createTestCommit(t, repo, repoPath, "a.txt", "a")
+
+		behind, err := IsBehindRemote(repoPath)
+		require.NoError(t, err)
+		assert.Equal(t, 0, behind)
+	})
+
+	t.Run("remote ahead of local", func(t *testing.T) {
+		repoPath, repo := createTestRepo(t)
+		createTestCommit(t, repo, repoPath, "a.txt", "a")
+		base := headHash(t, repo)
+
+		// The remote gets two commits the local branch doesn't have
+		createTestCommit(t, repo, repoPath, "r1.txt", "r1")
+		createTestCommit(t, repo, repoPath, "r2.txt", "r2")
+		setRemoteRef(t, repo, headHash(t, repo))
+
+		w, err := repo.Worktree()
+		require.NoError(t, err)
+		require.NoError(t, w.Reset(&git.ResetOptions{Commit: base, Mode: git.HardReset}))
+
+		behind, err := IsBehindRemote(repoPath)
+		require.NoError(t, err)
+		assert.Equal(t, 2, behind)
+
+		// Nothing to push - the local branch is an ancestor of the remote
+		ahead, err := IsAheadOfRemote(repoPath)
+		require.NoError(t, err)
+		assert.Equal(t, 0, ahead)
+	})
+
+	t.Run("local ahead of remote", func(t *testing.T) {
+		repoPath, repo := createTestRepo(t)
+		createTestCommit(t, repo, repoPath, "a.txt", "a")
+		setRemoteRef(t, repo, headHash(t, repo))
+		createTestCommit(t, repo, repoPath, "b.txt", "b")
+
+		behind, err := IsBehindRemote(repoPath)
+		require.NoError(t, err)
+		assert.Equal(t, 0, behind)
+	})
+}
+
+// headHash returns the commit hash HEAD points to
+func headHash(t *testing.T, repo *git.Repository) plumbing.Hash {
+	t.Helper()
+
+	head, err := repo.Head()
+	require.NoError(t, err)
+	return head.Hash()
+}
+
+// removeCommitObject deletes a commit's loose object, leaving a hole in the history like a shallow clone's boundary
+func removeCommitObject(t *testing.T, repoPath string, hash plumbing.Hash) {
+	t.Helper()
+
+	name := hash.String()
+	require.NoError(t, os.Remove(filepath.Join(repoPath, ".git", "objects", name[:2], name[2:])))
+}
+
+// setRemoteRef points origin/<current branch> at the given commit
+func setRemoteRef(t *testing.T, repo *git.Repository, hash plumbing.Hash) {
+	t.Helper()
+
+	head, err := repo.Head()
+	require.NoError(t, err)
+
+	ref := plumbing.NewHashReference(plumbing.NewRemoteReferenceName("origin", head.Name().Short()), hash)
+	require.NoError(t, repo.Storer.SetReference(ref))
+}
+
+func TestGetRepoState_DetachedHead(t *testing.T) {
+	t.Run("lightweight tag at HEAD", func(t *testing.T) {
+		repoPath, repo := createTestRepo(t)
+		createTestCommit(t, repo, repoPath, "a.txt", "a")
+		hash := headHash(t, repo)
+		_, err := repo.CreateTag("v1.0.0", hash, nil)
+		require.NoError(t, err)
+		checkoutCommit(t, repo, hash)
+
+		state, err := GetRepoState(repoPath)
+		require.NoError(t, err)
+		assert.Equal(t, "v1.0.0", state.Branch)
+		assert.Equal(t, hash.String()[:7], state.CommitHash)
+	})
+
+	t.Run("annotated tag at HEAD", func(t *testing.T) {
+		repoPath, repo := createTestRepo(t)
+		createTestCommit(t, repo, repoPath, "a.txt", "a")
+		hash := headHash(t, repo)
+		_, err := repo.CreateTag("v2.0.0", hash, &git.CreateTagOptions{
+			Message: "Release v2.0.0",
+			Tagger:  &object.Signature{Name: "Test User", Email: "test@example.com"},
+		})
+		require.NoError(t, err)
+		checkoutCommit(t, repo, hash)
+
+		state, err := GetRepoState(repoPath)
+		require.NoError(t, err)
+		assert.Equal(t, "v2.0.0", state.Branch)
+	})
+
+	t.Run("no tag falls back to short hash", func(t *testing.T) {
+		repoPath, repo := createTestRepo(t)
+		createTestCommit(t, repo, repoPath, "a.txt", "a")
+		tagged := headHash(t, repo)
+		_, err := repo.CreateTag("v1.0.0", tagged, nil)
+		require.NoError(t, err)
+		createTestCommit(t, repo, repoPath, "b.txt", "b")
+		hash := headHash(t, repo)
+		checkoutCommit(t, repo, hash)
+
+		state, err := GetRepoState(repoPath)
+		require.NoError(t, err)
+		assert.Equal(t, "detached@"+hash.String()[:7], state.Branch)
+	})
+}
+
+// checkoutCommit checks out a commit directly, detaching HEAD
+func checkoutCommit(t *testing.T, repo *git.Repository, hash plumbing.Hash) {
+	t.Helper()
+
+	w, err := repo.Worktree()
+	require.NoError(t, err)
+	require.NoError(t, w.Checkout(&git.CheckoutOptions{Hash: hash}))
+
+	head, err := repo.Head()
+	require.NoError(t, err)
+	require.False(t, head.Name().IsBranch(), "expected detached HEAD")
+}
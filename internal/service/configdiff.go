@@ -0,0 +1,204 @@
+package service
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/ork-cli/ork/internal/config"
+)
+
+// ============================================================================
+// Type Definitions
+// ============================================================================
+
+// Labels recording the config a container was created from, so later runs can tell what changed
+const (
+	LabelConfigImage   = "ork.config.image"    // Image from ork.yml (empty for build-based services)
+	LabelConfigPorts   = "ork.config.ports"    // Sorted, comma-separated port mappings
+	LabelConfigEnv     = "ork.config.env"      // Sorted, comma-separated env keys
+	LabelConfigEnvHash = "ork.config.env.hash" // One SHA-256 digest over all sorted KEY=value pairs
+)
+
+// ConfigDiff describes how a service's config differs from the one its container was created with
+type ConfigDiff struct {
+	OldImage     string   // Image the container runs (only set when the image changed)
+	NewImage     string   // Image from the new config (only set when the image changed)
+	PortsAdded   []string // Port mappings in the new config only
+	PortsRemoved []string // Port mappings in the old config only
+	EnvAdded     []string // Env keys in the new config only
+	EnvRemoved   []string // Env keys in the old config only
+
+	// EnvValuesChanged is set when the env keys are the same but a value changed
+	// Values are recorded only as one combined digest, so which key changed isn't known
+	EnvValuesChanged bool
+}
+
+// ============================================================================
+// Public API
+// ============================================================================
+
+// ConfigLabels returns the labels recording a service's image, ports, and env
+// Env values are only stored as a single digest over the whole env, so secrets don't end up in
+// container labels and no value can be guessed on its own
+func ConfigLabels(cfg config.Service) map[string]string {
+	ports := append([]string(nil), cfg.Ports...)
+	sort.Strings(ports)
+
+	keys := mapKeys(cfg.Env)
+	sort.Strings(keys)
+
+	return map[string]string{
+		LabelConfigImage:   cfg.Image,
+		LabelConfigPorts:   strings.Join(ports, ","),
+		LabelConfigEnv:     strings.Join(keys, ","),
+		LabelConfigEnvHash: hashEnv(cfg.Env),
+	}
+}
+
+// DiffConfig compares the config recorded in a container's labels with a service's new config
+// Reports false if the container predates config labels, since nothing can be compared
+func DiffConfig(labels map[string]string, cfg config.Service) (ConfigDiff, bool) {
+	if _, ok := labels[LabelConfigImage]; !ok {
+		return ConfigDiff{}, false
+	}
+
+	var diff ConfigDiff
+	current := ConfigLabels(cfg)
+
+	if labels[LabelConfigImage] != current[LabelConfigImage] {
+		diff.OldImage = labels[LabelConfigImage]
+		diff.NewImage = current[LabelConfigImage]
+	}
+
+	diff.PortsAdded, diff.PortsRemoved = diffSets(splitLabelList(labels[LabelConfigPorts]), cfg.Ports)
+
+	diff.EnvAdded, diff.EnvRemoved = diffSets(decodeEnvKeys(labels[LabelConfigEnv]), mapKeys(cfg.Env))
+
+	// Containers labeled before the digest existed have no hash, so their values can't be compared
+	if oldHash, ok := labels[LabelConfigEnvHash]; ok && len(diff.EnvAdded) == 0 && len(diff.EnvRemoved) == 0 {
+		diff.EnvValuesChanged = oldHash != current[LabelConfigEnvHash]
+	}
+
+	return diff, true
+}
+
+// IsEmpty reports whether nothing changed
+func (d ConfigDiff) IsEmpty() bool {
+	return len(d.Lines()) == 0
+}
+
+// Lines returns a concise, human-readable summary of the changes (one line per kind)
+func (d ConfigDiff) Lines() []string {
+	var lines []string
+
+	if d.OldImage != d.NewImage {
+		lines = append(lines, fmt.Sprintf("image: %s → %s", displayImage(d.OldImage), displayImage(d.NewImage)))
+	}
+	if ports := formatChanges(d.PortsAdded, d.PortsRemoved, nil); ports != "" {
+		lines = append(lines, "ports: "+ports)
+	}
+	if env := formatChanges(d.EnvAdded, d.EnvRemoved, nil); env != "" {
+		lines = append(lines, "env: "+env)
+	}
+	if d.EnvValuesChanged {
+		lines = append(lines, "env: values changed")
+	}
+
+	return lines
+}
+
+// ============================================================================
+// Private Helpers
+// ============================================================================
+
+// hashEnv returns a hex SHA-256 digest over the env's sorted KEY=value pairs
+func hashEnv(env map[string]string) string {
+	keys := mapKeys(env)
+	sort.Strings(keys)
+
+	hash := sha256.New()
+	for _, key := range keys {
+		// NUL can't appear in an env var, so the pairs can't run into each other
+		fmt.Fprintf(hash, "%s=%s\x00", key, env[key])
+	}
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+// decodeEnvKeys parses the env keys recorded in LabelConfigEnv
+// Older labels stored KEY=<hash> pairs, so anything after an '=' is dropped
+func decodeEnvKeys(label string) []string {
+	entries := splitLabelList(label)
+	keys := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		key, _, _ := strings.Cut(entry, "=")
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+// splitLabelList splits a comma-separated label value (empty yields nil)
+func splitLabelList(label string) []string {
+	if label == "" {
+		return nil
+	}
+	return strings.Split(label, ",")
+}
+
+// diffSets returns the sorted items only in newItems (added) and only in oldItems (removed)
+func diffSets(oldItems, newItems []string) (added, removed []string) {
+	oldSet := make(map[string]bool, len(oldItems))
+	for _, item := range oldItems {
+		oldSet[item] = true
+	}
+	newSet := make(map[string]bool, len(newItems))
+	for _, item := range newItems {
+		newSet[item] = true
+		if !oldSet[item] {
+			added = append(added, item)
+		}
+	}
+	for _, item := range oldItems {
+		if !newSet[item] {
+			removed = append(removed, item)
+		}
+	}
+
+	sort.Strings(added)
+	sort.Strings(removed)
+	return added, removed
+}
+
+// mapKeys returns the keys of a map
+func mapKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+// formatChanges formats added (+), removed (-), and changed (~) items on one line
+func formatChanges(added, removed, changed []string) string {
+	parts := make([]string, 0, len(added)+len(removed)+len(changed))
+	for _, item := range added {
+		parts = append(parts, "+"+item)
+	}
+	for _, item := range removed {
+		parts = append(parts, "-"+item)
+	}
+	for _, item := range changed {
+		parts = append(parts, "~"+item)
+	}
+	return strings.Join(parts, " ")
+}
+
+// displayImage shows build-based services (no image in ork.yml) as "(build)"
+func displayImage(image string) string {
+	if image == "" {
+		return "(build)"
+	}
+	return image
+}
@@ -0,0 +1,108 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/ork-cli/ork/internal/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// ============================================================================
+// Config Diff Tests
+// ============================================================================
+
+func TestDiffConfig_ChangedImage(t *testing.T) {
+	labels := ConfigLabels(config.Service{Image: "node:18", Ports: []string{"3000:3000"}})
+
+	diff, ok := DiffConfig(labels, config.Service{Image: "node:20", Ports: []string{"3000:3000"}})
+
+	require.True(t, ok)
+	assert.Equal(t, "node:18", diff.OldImage)
+	assert.Equal(t, "node:20", diff.NewImage)
+	assert.Empty(t, diff.PortsAdded)
+	assert.Empty(t, diff.PortsRemoved)
+	assert.Equal(t, []string{"image: node:18 → node:20"}, diff.Lines())
+}
+
+func TestDiffConfig_ChangedPort(t *testing.T) {
+	labels := ConfigLabels(config.Service{Image: "nginx", Ports: []string{"8080:80", "8443:443"}})
+
+	diff, ok := DiffConfig(labels, config.Service{Image: "nginx", Ports: []string{"9090:80", "8443:443"}})
+
+	require.True(t, ok)
+	assert.Empty(t, diff.OldImage)
+	assert.Equal(t, []string{"9090:80"}, diff.PortsAdded)
+	assert.Equal(t, []string{"8080:80"}, diff.PortsRemoved)
+	assert.Equal(t, []string{"ports: +9090:80 -8080:80"}, diff.Lines())
+}
+
+func TestDiffConfig_EnvKeys(t *testing.T) {
+	labels := ConfigLabels(config.Service{Image: "api", Env: map[string]string{
+		"LEVEL": "info", "PORT": "8080", "LEGACY": "1",
+	}})
+
+	diff, ok := DiffConfig(labels, config.Service{Image: "api", Env: map[string]string{
+		"LEVEL": "debug", "PORT": "8080", "DEBUG": "true",
+	}})
+
+	require.True(t, ok)
+	assert.Equal(t, []string{"DEBUG"}, diff.EnvAdded)
+	assert.Equal(t, []string{"LEGACY"}, diff.EnvRemoved)
+	assert.Equal(t, []string{"env: +DEBUG -LEGACY"}, diff.Lines())
+}
+
+func TestDiffConfig_EnvValues(t *testing.T) {
+	labels := ConfigLabels(config.Service{Image: "api", Env: map[string]string{"LEVEL": "info", "PORT": "8080"}})
+
+	diff, ok := DiffConfig(labels, config.Service{Image: "api", Env: map[string]string{"LEVEL": "debug", "PORT": "8080"}})
+
+	require.True(t, ok)
+	assert.True(t, diff.EnvValuesChanged)
+	assert.Equal(t, []string{"env: values changed"}, diff.Lines())
+}
+
+func TestDiffConfig_LegacyEnvLabel(t *testing.T) {
+	labels := map[string]string{LabelConfigImage: "api", LabelConfigEnv: "LEVEL=1a2b3c4d"}
+
+	diff, ok := DiffConfig(labels, config.Service{Image: "api", Env: map[string]string{"LEVEL": "debug", "DEBUG": "1"}})
+
+	require.True(t, ok)
+	assert.Equal(t, []string{"DEBUG"}, diff.EnvAdded)
+	assert.Empty(t, diff.EnvRemoved)
+	assert.False(t, diff.EnvValuesChanged)
+}
+
+func TestDiffConfig_NoChanges(t *testing.T) {
+	cfg := config.Service{Image: "redis:7", Ports: []string{"6379:6379"}, Env: map[string]string{"A": "1"}}
+
+	diff, ok := DiffConfig(ConfigLabels(cfg), cfg)
+
+	require.True(t, ok)
+	assert.True(t, diff.IsEmpty())
+}
+
+func TestDiffConfig_PortOrderIgnored(t *testing.T) {
+	labels := ConfigLabels(config.Service{Ports: []string{"1:1", "2:2"}})
+
+	diff, ok := DiffConfig(labels, config.Service{Ports: []string{"2:2", "1:1"}})
+
+	require.True(t, ok)
+	assert.True(t, diff.IsEmpty())
+}
+
+func TestDiffConfig_UnlabeledContainer(t *testing.T) {
+	_, ok := DiffConfig(map[string]string{"ork.service": "api"}, config.Service{Image: "node:18"})
+
+	assert.False(t, ok)
+}
+
+func TestConfigLabels_HidesEnvValues(t *testing.T) {
+	labels := ConfigLabels(config.Service{Env: map[string]string{"API_KEY": "hunter2", "PORT": "8080"}})
+
+	assert.Equal(t, "API_KEY,PORT", labels[LabelConfigEnv])
+	assert.Len(t, labels[LabelConfigEnvHash], 64)
+	for _, value := range labels {
+		assert.NotContains(t, value, "hunter2")
+	}
+}
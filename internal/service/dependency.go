@@ -67,7 +67,7 @@ func buildDependencyGraph(services map[string]config.Service) *DependencyGraph {
 		}
 
 		// Add each dependency
-		for _, dep := range service.DependsOn {
+		for _, dep := range service.DependsOn.Names() {
 			graph.dependencies[serviceName] = append(graph.dependencies[serviceName], dep)
 
 			// Track reverse relationship (who depends on this service)
@@ -36,11 +36,11 @@ func TestResolveDependencies_LinearChain(t *testing.T) {
 	services := map[string]config.Service{
 		"frontend": {
 			Image:     "nginx:alpine",
-			DependsOn: []string{"api"},
+			DependsOn: config.DependsOnServices("api"),
 		},
 		"api": {
 			Image:     "node:18",
-			DependsOn: []string{"postgres"},
+			DependsOn: config.DependsOnServices("postgres"),
 		},
 		"postgres": {
 			Image: "postgres:15",
@@ -78,7 +78,7 @@ func TestResolveDependencies_MultipleDependencies(t *testing.T) {
 	services := map[string]config.Service{
 		"frontend": {
 			Image:     "nginx:alpine",
-			DependsOn: []string{"api", "auth"},
+			DependsOn: config.DependsOnServices("api", "auth"),
 		},
 		"api": {
 			Image: "node:18",
@@ -116,15 +116,15 @@ func TestResolveDependencies_DiamondDependency(t *testing.T) {
 	services := map[string]config.Service{
 		"frontend": {
 			Image:     "nginx:alpine",
-			DependsOn: []string{"api", "cache"},
+			DependsOn: config.DependsOnServices("api", "cache"),
 		},
 		"api": {
 			Image:     "node:18",
-			DependsOn: []string{"database"},
+			DependsOn: config.DependsOnServices("database"),
 		},
 		"cache": {
 			Image:     "redis:alpine",
-			DependsOn: []string{"database"},
+			DependsOn: config.DependsOnServices("database"),
 		},
 		"database": {
 			Image: "postgres:15",
@@ -159,11 +159,11 @@ func TestResolveDependencies_PartialServiceRequest(t *testing.T) {
 	services := map[string]config.Service{
 		"frontend": {
 			Image:     "nginx:alpine",
-			DependsOn: []string{"api"},
+			DependsOn: config.DependsOnServices("api"),
 		},
 		"api": {
 			Image:     "node:18",
-			DependsOn: []string{"postgres"},
+			DependsOn: config.DependsOnServices("postgres"),
 		},
 		"postgres": {
 			Image: "postgres:15",
@@ -202,15 +202,15 @@ func TestResolveDependencies_MultipleRequestedServices(t *testing.T) {
 	services := map[string]config.Service{
 		"web": {
 			Image:     "nginx:alpine",
-			DependsOn: []string{"api"},
+			DependsOn: config.DependsOnServices("api"),
 		},
 		"api": {
 			Image:     "node:18",
-			DependsOn: []string{"db"},
+			DependsOn: config.DependsOnServices("db"),
 		},
 		"worker": {
 			Image:     "python:3.11",
-			DependsOn: []string{"db", "redis"},
+			DependsOn: config.DependsOnServices("db", "redis"),
 		},
 		"db": {
 			Image: "postgres:15",
@@ -259,8 +259,8 @@ func TestResolveDependencies_MultipleRequestedServices(t *testing.T) {
 // TestResolveDependencies_AllServices tests requesting all services in a complex graph
 func TestResolveDependencies_AllServices(t *testing.T) {
 	services := map[string]config.Service{
-		"frontend": {Image: "nginx:alpine", DependsOn: []string{"api"}},
-		"api":      {Image: "node:18", DependsOn: []string{"db"}},
+		"frontend": {Image: "nginx:alpine", DependsOn: config.DependsOnServices("api")},
+		"api":      {Image: "node:18", DependsOn: config.DependsOnServices("db")},
 		"db":       {Image: "postgres:15"},
 	}
 
@@ -339,11 +339,11 @@ func TestResolveDependencies_CircularDependencySimple(t *testing.T) {
 	services := map[string]config.Service{
 		"frontend": {
 			Image:     "nginx:alpine",
-			DependsOn: []string{"api"},
+			DependsOn: config.DependsOnServices("api"),
 		},
 		"api": {
 			Image:     "node:18",
-			DependsOn: []string{"frontend"},
+			DependsOn: config.DependsOnServices("frontend"),
 		},
 	}
 
@@ -362,15 +362,15 @@ func TestResolveDependencies_CircularDependencyComplex(t *testing.T) {
 	services := map[string]config.Service{
 		"frontend": {
 			Image:     "nginx:alpine",
-			DependsOn: []string{"api"},
+			DependsOn: config.DependsOnServices("api"),
 		},
 		"api": {
 			Image:     "node:18",
-			DependsOn: []string{"database"},
+			DependsOn: config.DependsOnServices("database"),
 		},
 		"database": {
 			Image:     "postgres:15",
-			DependsOn: []string{"frontend"}, // Creates cycle
+			DependsOn: config.DependsOnServices("frontend"), // Creates cycle
 		},
 	}
 
@@ -389,7 +389,7 @@ func TestResolveDependencies_SelfDependency(t *testing.T) {
 	services := map[string]config.Service{
 		"api": {
 			Image:     "node:18",
-			DependsOn: []string{"api"}, // Self-dependency
+			DependsOn: config.DependsOnServices("api"), // Self-dependency
 		},
 	}
 
@@ -438,11 +438,11 @@ func TestResolveDependencies_MissingTransitiveDependency(t *testing.T) {
 	services := map[string]config.Service{
 		"frontend": {
 			Image:     "nginx:alpine",
-			DependsOn: []string{"api"},
+			DependsOn: config.DependsOnServices("api"),
 		},
 		"api": {
 			Image:     "node:18",
-			DependsOn: []string{"postgres"}, // postgres doesn't exist
+			DependsOn: config.DependsOnServices("postgres"), // postgres doesn't exist
 		},
 	}
 
@@ -505,11 +505,11 @@ func TestBuildDependencyGraph_WithDependencies(t *testing.T) {
 	services := map[string]config.Service{
 		"frontend": {
 			Image:     "nginx:alpine",
-			DependsOn: []string{"api", "auth"},
+			DependsOn: config.DependsOnServices("api", "auth"),
 		},
 		"api": {
 			Image:     "node:18",
-			DependsOn: []string{"db"},
+			DependsOn: config.DependsOnServices("db"),
 		},
 		"auth": {
 			Image: "keycloak:latest",
@@ -618,8 +618,8 @@ func TestCollectAllDependencies_NoDependencies(t *testing.T) {
 // TestCollectAllDependencies_WithDependencies tests collecting with dependencies
 func TestCollectAllDependencies_WithDependencies(t *testing.T) {
 	services := map[string]config.Service{
-		"frontend": {Image: "nginx:alpine", DependsOn: []string{"api"}},
-		"api":      {Image: "node:18", DependsOn: []string{"db"}},
+		"frontend": {Image: "nginx:alpine", DependsOn: config.DependsOnServices("api")},
+		"api":      {Image: "node:18", DependsOn: config.DependsOnServices("db")},
 		"db":       {Image: "postgres:15"},
 	}
 	graph := buildDependencyGraph(services)
@@ -638,8 +638,8 @@ func TestCollectAllDependencies_WithDependencies(t *testing.T) {
 // TestCollectAllDependencies_MultipleBranches tests collecting with multiple dependency branches
 func TestCollectAllDependencies_MultipleBranches(t *testing.T) {
 	services := map[string]config.Service{
-		"web":   {Image: "nginx:alpine", DependsOn: []string{"api", "cache"}},
-		"api":   {Image: "node:18", DependsOn: []string{"db"}},
+		"web":   {Image: "nginx:alpine", DependsOn: config.DependsOnServices("api", "cache")},
+		"api":   {Image: "node:18", DependsOn: config.DependsOnServices("db")},
 		"cache": {Image: "redis:alpine"},
 		"db":    {Image: "postgres:15"},
 	}
@@ -654,8 +654,8 @@ func TestCollectAllDependencies_MultipleBranches(t *testing.T) {
 // TestCollectAllDependencies_SharedDependencies tests that shared dependencies are not duplicated
 func TestCollectAllDependencies_SharedDependencies(t *testing.T) {
 	services := map[string]config.Service{
-		"web":    {Image: "nginx:alpine", DependsOn: []string{"db"}},
-		"worker": {Image: "python:3.11", DependsOn: []string{"db"}},
+		"web":    {Image: "nginx:alpine", DependsOn: config.DependsOnServices("db")},
+		"worker": {Image: "python:3.11", DependsOn: config.DependsOnServices("db")},
 		"db":     {Image: "postgres:15"},
 	}
 	graph := buildDependencyGraph(services)
@@ -686,8 +686,8 @@ func TestCollectAllDependencies_SharedDependencies(t *testing.T) {
 // TestDetectCircularDependencies_NoCycle tests detection with no cycles
 func TestDetectCircularDependencies_NoCycle(t *testing.T) {
 	services := map[string]config.Service{
-		"frontend": {Image: "nginx:alpine", DependsOn: []string{"api"}},
-		"api":      {Image: "node:18", DependsOn: []string{"db"}},
+		"frontend": {Image: "nginx:alpine", DependsOn: config.DependsOnServices("api")},
+		"api":      {Image: "node:18", DependsOn: config.DependsOnServices("db")},
 		"db":       {Image: "postgres:15"},
 	}
 	graph := buildDependencyGraph(services)
@@ -701,8 +701,8 @@ func TestDetectCircularDependencies_NoCycle(t *testing.T) {
 // TestDetectCircularDependencies_SimpleCycle tests simple cycle detection (A -> B -> A)
 func TestDetectCircularDependencies_SimpleCycle(t *testing.T) {
 	services := map[string]config.Service{
-		"a": {Image: "nginx:alpine", DependsOn: []string{"b"}},
-		"b": {Image: "node:18", DependsOn: []string{"a"}},
+		"a": {Image: "nginx:alpine", DependsOn: config.DependsOnServices("b")},
+		"b": {Image: "node:18", DependsOn: config.DependsOnServices("a")},
 	}
 	graph := buildDependencyGraph(services)
 
@@ -719,7 +719,7 @@ func TestDetectCircularDependencies_SimpleCycle(t *testing.T) {
 // TestDetectCircularDependencies_SelfCycle tests self-referencing cycle
 func TestDetectCircularDependencies_SelfCycle(t *testing.T) {
 	services := map[string]config.Service{
-		"a": {Image: "nginx:alpine", DependsOn: []string{"a"}},
+		"a": {Image: "nginx:alpine", DependsOn: config.DependsOnServices("a")},
 	}
 	graph := buildDependencyGraph(services)
 
@@ -736,10 +736,10 @@ func TestDetectCircularDependencies_SelfCycle(t *testing.T) {
 // TestDetectCircularDependencies_LongCycle tests longer cycle (A -> B -> C -> D -> A)
 func TestDetectCircularDependencies_LongCycle(t *testing.T) {
 	services := map[string]config.Service{
-		"a": {Image: "nginx:alpine", DependsOn: []string{"b"}},
-		"b": {Image: "node:18", DependsOn: []string{"c"}},
-		"c": {Image: "redis:alpine", DependsOn: []string{"d"}},
-		"d": {Image: "postgres:15", DependsOn: []string{"a"}},
+		"a": {Image: "nginx:alpine", DependsOn: config.DependsOnServices("b")},
+		"b": {Image: "node:18", DependsOn: config.DependsOnServices("c")},
+		"c": {Image: "redis:alpine", DependsOn: config.DependsOnServices("d")},
+		"d": {Image: "postgres:15", DependsOn: config.DependsOnServices("a")},
 	}
 	graph := buildDependencyGraph(services)
 
@@ -756,10 +756,10 @@ func TestDetectCircularDependencies_LongCycle(t *testing.T) {
 // TestDetectCircularDependencies_PartialCycle tests cycle in part of the graph
 func TestDetectCircularDependencies_PartialCycle(t *testing.T) {
 	services := map[string]config.Service{
-		"frontend": {Image: "nginx:alpine", DependsOn: []string{"api"}},
-		"api":      {Image: "node:18", DependsOn: []string{"cache"}},
-		"cache":    {Image: "redis:alpine", DependsOn: []string{"api"}}, // Cycle here
-		"db":       {Image: "postgres:15"},                              // Independent
+		"frontend": {Image: "nginx:alpine", DependsOn: config.DependsOnServices("api")},
+		"api":      {Image: "node:18", DependsOn: config.DependsOnServices("cache")},
+		"cache":    {Image: "redis:alpine", DependsOn: config.DependsOnServices("api")}, // Cycle here
+		"db":       {Image: "postgres:15"},                                              // Independent
 	}
 	graph := buildDependencyGraph(services)
 
@@ -782,8 +782,8 @@ func TestDetectCircularDependencies_PartialCycle(t *testing.T) {
 // TestTopologicalSort_LinearChain tests sorting a linear dependency chain
 func TestTopologicalSort_LinearChain(t *testing.T) {
 	services := map[string]config.Service{
-		"a": {Image: "nginx:alpine", DependsOn: []string{"b"}},
-		"b": {Image: "node:18", DependsOn: []string{"c"}},
+		"a": {Image: "nginx:alpine", DependsOn: config.DependsOnServices("b")},
+		"b": {Image: "node:18", DependsOn: config.DependsOnServices("c")},
 		"c": {Image: "postgres:15"},
 	}
 	graph := buildDependencyGraph(services)
@@ -827,9 +827,9 @@ func TestTopologicalSort_IndependentServices(t *testing.T) {
 // TestTopologicalSort_DiamondPattern tests sorting a diamond dependency pattern
 func TestTopologicalSort_DiamondPattern(t *testing.T) {
 	services := map[string]config.Service{
-		"a": {Image: "nginx:alpine", DependsOn: []string{"b", "c"}},
-		"b": {Image: "node:18", DependsOn: []string{"d"}},
-		"c": {Image: "redis:alpine", DependsOn: []string{"d"}},
+		"a": {Image: "nginx:alpine", DependsOn: config.DependsOnServices("b", "c")},
+		"b": {Image: "node:18", DependsOn: config.DependsOnServices("d")},
+		"c": {Image: "redis:alpine", DependsOn: config.DependsOnServices("d")},
 		"d": {Image: "postgres:15"},
 	}
 	graph := buildDependencyGraph(services)
@@ -861,8 +861,8 @@ func TestTopologicalSort_DiamondPattern(t *testing.T) {
 // TestTopologicalSort_PartialGraph tests sorting only a subset of services
 func TestTopologicalSort_PartialGraph(t *testing.T) {
 	services := map[string]config.Service{
-		"frontend": {Image: "nginx:alpine", DependsOn: []string{"api"}},
-		"api":      {Image: "node:18", DependsOn: []string{"db"}},
+		"frontend": {Image: "nginx:alpine", DependsOn: config.DependsOnServices("api")},
+		"api":      {Image: "node:18", DependsOn: config.DependsOnServices("db")},
 		"db":       {Image: "postgres:15"},
 		"cache":    {Image: "redis:alpine"}, // Not in our subset
 	}
@@ -3,6 +3,8 @@ package service
 import (
 	"context"
 	"fmt"
+	"sort"
+	"strings"
 	"sync"
 	"time"
 
@@ -22,8 +24,12 @@ type Orchestrator struct {
 	dockerClient *docker.Client      // Docker client for operations
 	projectName  string              // Project name
 	networkID    string              // Network ID for inter-service communication
+	maxParallel  int                 // Max services started at once (0 uses DefaultMaxParallel)
 }
 
+// DefaultMaxParallel is how many services start at once when max_parallel is not configured
+const DefaultMaxParallel = 4
+
 // NewOrchestrator creates a new service orchestrator
 func NewOrchestrator(projectName string, dockerClient *docker.Client, networkID string) *Orchestrator {
 	return &Orchestrator{
@@ -41,6 +47,25 @@ func (o *Orchestrator) AddService(name string, cfg config.Service) {
 	o.services[name] = New(name, o.projectName, cfg)
 }
 
+// setBaseDir sets the directory every service reads its .env files from
+// Each service is updated under its own lock, since Start reads BaseDir while holding it
+func (o *Orchestrator) setBaseDir(dir string) {
+	for _, svc := range o.Services() {
+		svc.mu.Lock()
+		svc.BaseDir = dir
+		svc.mu.Unlock()
+	}
+}
+
+// SetPullPolicy sets when every service's image is pulled before its container is created
+func (o *Orchestrator) SetPullPolicy(policy docker.PullPolicy) {
+	for _, svc := range o.Services() {
+		svc.mu.Lock()
+		svc.PullPolicy = policy
+		svc.mu.Unlock()
+	}
+}
+
 // GetService returns a service by name
 func (o *Orchestrator) GetService(name string) (*Service, bool) {
 	o.mu.RLock()
@@ -49,12 +74,30 @@ func (o *Orchestrator) GetService(name string) (*Service, bool) {
 	return svc, ok
 }
 
+// Services returns a snapshot of every service, sorted by name
+// Safe to call while services are being added; later additions aren't included
+func (o *Orchestrator) Services() []*Service {
+	o.mu.RLock()
+	services := make([]*Service, 0, len(o.services))
+	for _, svc := range o.services {
+		services = append(services, svc)
+	}
+	o.mu.RUnlock()
+
+	sort.Slice(services, func(i, j int) bool {
+		return services[i].Name < services[j].Name
+	})
+	return services
+}
+
 // ============================================================================
 // Parallel Start with Health Check Waiting
 // ============================================================================
 
 // StartServicesInOrder starts services in dependency order with parallel execution
 // Services at the same dependency level are started in parallel
+// A level only blocks on the health of services that a dependent requires to be healthy
+// (or that nothing depends on); the remaining health checks run once every level has started
 // Returns an error if any service fails, rolling back successfully started services
 func (o *Orchestrator) StartServicesInOrder(ctx context.Context, orderedServiceNames []string, cfg *config.Config) error {
 	// Build dependency levels for parallel execution
@@ -63,11 +106,33 @@ func (o *Orchestrator) StartServicesInOrder(ctx context.Context, orderedServiceN
 		return fmt.Errorf("failed to build dependency levels: %w", err)
 	}
 
+	// Cap how many services start simultaneously within a level
+	o.maxParallel = cfg.MaxParallel
+
+	// Read .env files from the project root, wherever the command was run
+	o.setBaseDir(cfg.BaseDir)
+
+	// Find dependencies whose dependents only need them started, not healthy
+	deferrable := deferrableHealthWaits(orderedServiceNames, cfg.Services)
+
 	// Track started services for potential rollback
 	startedServices := make([]*Service, 0)
+	var deferredHealth []string
 
 	// Start services level by level
 	for levelNum, levelServices := range levels {
+		// A level is empty when its services aren't being started (e.g., a dependency scaled to 0)
+		if len(levelServices) == 0 {
+			continue
+		}
+
+		// Stop before the next level if the start was cancelled (e.g., Ctrl+C)
+		if err := ctx.Err(); err != nil {
+			ui.Error("Start cancelled")
+			o.rollbackStartedServices(ctx, startedServices)
+			return err
+		}
+
 		ui.Subheader(fmt.Sprintf("Level %d: %s", levelNum+1, ui.Dim(fmt.Sprintf("%v", levelServices))))
 
 		// Start all services in this level in parallel
@@ -78,8 +143,16 @@ func (o *Orchestrator) StartServicesInOrder(ctx context.Context, orderedServiceN
 			return err
 		}
 
-		// Wait for all services in this level to become healthy
-		if err := o.waitForHealthy(ctx, levelServices); err != nil {
+		// Wait for the services in this level that must be healthy before moving on
+		blocking := make([]string, 0, len(levelServices))
+		for _, name := range levelServices {
+			if deferrable[name] {
+				deferredHealth = append(deferredHealth, name)
+			} else {
+				blocking = append(blocking, name)
+			}
+		}
+		if err := o.waitForHealthy(ctx, blocking); err != nil {
 			// Rollback on health check failure
 			ui.Error(fmt.Sprintf("Health check failed: %v", err))
 			o.rollbackStartedServices(ctx, startedServices)
@@ -87,25 +160,194 @@ func (o *Orchestrator) StartServicesInOrder(ctx context.Context, orderedServiceN
 		}
 	}
 
+	// Finally, check the health of services nothing had to wait on
+	if err := o.waitForHealthy(ctx, deferredHealth); err != nil {
+		ui.Error(fmt.Sprintf("Health check failed: %v", err))
+		o.rollbackStartedServices(ctx, startedServices)
+		return err
+	}
+
 	return nil
 }
 
+// deferrableHealthWaits returns the services whose health doesn't need to block their dependents
+// A service qualifies if at least one service being started depends on it and all of them set
+// condition: service_started explicitly; the list form keeps waiting on health, like before conditions existed
+func deferrableHealthWaits(serviceNames []string, allServices map[string]config.Service) map[string]bool {
+	starting := make(map[string]bool, len(serviceNames))
+	for _, name := range serviceNames {
+		starting[name] = true
+	}
+
+	hasDependents := make(map[string]bool)
+	requiredHealthy := make(map[string]bool)
+	for _, name := range serviceNames {
+		for _, dep := range allServices[name].DependsOn {
+			if !starting[dep.Service] {
+				continue
+			}
+			hasDependents[dep.Service] = true
+			if dep.Condition != config.ConditionServiceStarted {
+				requiredHealthy[dep.Service] = true
+			}
+		}
+	}
+
+	deferrable := make(map[string]bool)
+	for name := range hasDependents {
+		if !requiredHealthy[name] {
+			deferrable[name] = true
+		}
+	}
+	return deferrable
+}
+
+// ============================================================================
+// Dry Run
+// ============================================================================
+
+// PlannedStart is what StartServicesInOrder would run for a single service
+type PlannedStart struct {
+	Name       string            // Service name
+	RunOptions docker.RunOptions // Options the container would be created with
+}
+
+// PlanStart computes the dependency levels and run options StartServicesInOrder would use
+// Only the config and .env files are read - the Docker client is never called, so it may be nil
+// Each inner slice is one level, in the same order buildDependencyLevels returns
+func (o *Orchestrator) PlanStart(orderedServiceNames []string, cfg *config.Config) ([][]PlannedStart, error) {
+	levels, err := o.buildDependencyLevels(orderedServiceNames, cfg.Services)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build dependency levels: %w", err)
+	}
+
+	o.setBaseDir(cfg.BaseDir)
+
+	plan := make([][]PlannedStart, len(levels))
+	for i, levelServices := range levels {
+		plan[i] = make([]PlannedStart, 0, len(levelServices))
+		for _, name := range levelServices {
+			svc, ok := o.GetService(name)
+			if !ok {
+				return nil, fmt.Errorf("service %s not found in orchestrator", name)
+			}
+
+			opts, err := svc.planRunOptions()
+			if err != nil {
+				return nil, fmt.Errorf("failed to plan %s: %w", name, err)
+			}
+			plan[i] = append(plan[i], PlannedStart{Name: name, RunOptions: opts})
+		}
+	}
+
+	return plan, nil
+}
+
+// DryRun prints the start plan level by level without touching Docker
+func (o *Orchestrator) DryRun(orderedServiceNames []string, cfg *config.Config) error {
+	plan, err := o.PlanStart(orderedServiceNames, cfg)
+	if err != nil {
+		return err
+	}
+
+	ui.Info(fmt.Sprintf("Dry run - start plan for %d service(s), no changes will be made", len(orderedServiceNames)))
+	ui.EmptyLine()
+
+	for levelNum, level := range plan {
+		if len(level) == 0 {
+			continue
+		}
+
+		names := make([]string, 0, len(level))
+		for _, planned := range level {
+			names = append(names, planned.Name)
+		}
+		ui.Subheader(fmt.Sprintf("Level %d: %s", levelNum+1, ui.Dim(fmt.Sprintf("%v", names))))
+
+		for _, planned := range level {
+			ui.ListItem(ui.SymbolArrow, ui.Bold(planned.Name))
+			for _, line := range runOptionLines(planned.RunOptions) {
+				ui.List(line)
+			}
+		}
+	}
+
+	return nil
+}
+
+// runOptionLines describes run options as "key value" lines, sorted within maps for stable output
+// Env values that look like secrets are masked
+func runOptionLines(opts docker.RunOptions) []string {
+	lines := []string{
+		"container " + opts.Name,
+		"image " + opts.Image,
+	}
+
+	hostPorts := make([]string, 0, len(opts.Ports))
+	for hostPort := range opts.Ports {
+		hostPorts = append(hostPorts, hostPort)
+	}
+	sort.Strings(hostPorts)
+	for _, hostPort := range hostPorts {
+		lines = append(lines, fmt.Sprintf("port %s:%s", hostPort, opts.Ports[hostPort]))
+	}
+
+	// Secrets would otherwise land in the terminal (and CI logs) in plain text
+	env := make(map[string]string, len(opts.Env))
+	for key, value := range opts.Env {
+		env[key] = config.MaskEnvValue(key, value)
+	}
+	lines = append(lines, sortedPairs("env", env)...)
+	lines = append(lines, sortedPairs("label", opts.Labels)...)
+
+	if len(opts.Entrypoint) > 0 {
+		lines = append(lines, "entrypoint "+strings.Join(opts.Entrypoint, " "))
+	}
+	if len(opts.Command) > 0 {
+		lines = append(lines, "command "+strings.Join(opts.Command, " "))
+	}
+	for _, volume := range opts.Volumes {
+		lines = append(lines, "volume "+volume)
+	}
+	if opts.NetworkMode != "" {
+		lines = append(lines, "network "+opts.NetworkMode)
+	}
+
+	return lines
+}
+
+// sortedPairs formats a map as "prefix key=value" lines sorted by key
+func sortedPairs(prefix string, values map[string]string) []string {
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	lines := make([]string, 0, len(keys))
+	for _, key := range keys {
+		lines = append(lines, fmt.Sprintf("%s %s=%s", prefix, key, values[key]))
+	}
+	return lines
+}
+
 // ============================================================================
 // Private Methods - Dependency Level Building
 // ============================================================================
 
 // buildDependencyLevels groups services into levels based on dependencies
 // Services in the same level can be started in parallel
+// Circular dependencies are rejected before any level is computed
 func (o *Orchestrator) buildDependencyLevels(orderedServiceNames []string, allServices map[string]config.Service) ([][]string, error) {
 	// Return an empty slice if no services to start
 	if len(orderedServiceNames) == 0 {
 		return [][]string{}, nil
 	}
 
-	// Build dependency graph
-	graph := make(map[string][]string)
-	for name, svc := range allServices {
-		graph[name] = svc.DependsOn
+	// Build dependency graph, rejecting cycles - a level only exists for an acyclic graph
+	graph := buildDependencyGraph(allServices)
+	if err := detectCircularDependencies(graph, orderedServiceNames); err != nil {
+		return nil, err
 	}
 
 	// Track the level of each service
@@ -113,7 +355,9 @@ func (o *Orchestrator) buildDependencyLevels(orderedServiceNames []string, allSe
 
 	// Calculate levels based on dependencies
 	for _, name := range orderedServiceNames {
-		o.calculateServiceLevel(name, graph, serviceLevels, make(map[string]bool))
+		if _, err := o.calculateServiceLevel(name, graph.dependencies, serviceLevels, make(map[string]bool)); err != nil {
+			return nil, err
+		}
 	}
 
 	// Group services by level
@@ -136,40 +380,35 @@ func (o *Orchestrator) buildDependencyLevels(orderedServiceNames []string, allSe
 	return levels, nil
 }
 
-// calculateServiceLevel recursively calculates the dependency level of a service
+// calculateServiceLevel calculates the dependency level of a service in post-order
 // Level 0 = no dependencies, Level N = max(dependency levels) + 1
-func (o *Orchestrator) calculateServiceLevel(serviceName string, graph map[string][]string, levels map[string]int, visited map[string]bool) int {
+// Levels are memoized once every dependency is done, so shared subgraphs are computed once;
+// visiting holds the services on the current path, and reaching one again is a cycle
+func (o *Orchestrator) calculateServiceLevel(serviceName string, graph map[string][]string, levels map[string]int, visiting map[string]bool) (int, error) {
 	// Return cached level if already calculated
 	if level, ok := levels[serviceName]; ok {
-		return level
-	}
-
-	// Check for circular dependencies
-	if visited[serviceName] {
-		return 0 // Already handled by dependency resolution
+		return level, nil
 	}
-	visited[serviceName] = true
 
-	// Get dependencies
-	deps := graph[serviceName]
-	if len(deps) == 0 {
-		// No dependencies = level 0
-		levels[serviceName] = 0
-		return 0
+	// A service still on the path depends on itself, directly or transitively
+	if visiting[serviceName] {
+		return 0, fmt.Errorf("circular dependency detected at service '%s'", serviceName)
 	}
+	visiting[serviceName] = true
+	defer delete(visiting, serviceName)
 
-	// Calculate level as max(dependency levels) + 1
-	maxDepLevel := -1
-	for _, dep := range deps {
-		depLevel := o.calculateServiceLevel(dep, graph, levels, visited)
-		if depLevel > maxDepLevel {
-			maxDepLevel = depLevel
+	// Calculate level as max(dependency levels) + 1 (no dependencies = level 0)
+	level := 0
+	for _, dep := range graph[serviceName] {
+		depLevel, err := o.calculateServiceLevel(dep, graph, levels, visiting)
+		if err != nil {
+			return 0, err
 		}
+		level = max(level, depLevel+1)
 	}
 
-	level := maxDepLevel + 1
 	levels[serviceName] = level
-	return level
+	return level, nil
 }
 
 // ============================================================================
@@ -177,18 +416,32 @@ func (o *Orchestrator) calculateServiceLevel(serviceName string, graph map[strin
 // ============================================================================
 
 // startServicesInParallel starts multiple services concurrently
+// At most maxParallel services are starting at any one time
 func (o *Orchestrator) startServicesInParallel(ctx context.Context, serviceNames []string, startedServices *[]*Service) error {
 	// Use a wait group to track parallel starts
 	var wg sync.WaitGroup
 	var mu sync.Mutex // Protects concurrent access to the startedServices slice
 	errChan := make(chan error, len(serviceNames))
 
+	// Semaphore limiting the number of in-flight starts
+	semaphore := make(chan struct{}, o.parallelLimit())
+
 	// Start each service in a separate goroutine
 	for _, name := range serviceNames {
 		wg.Add(1)
 		go func(serviceName string) {
 			defer wg.Done()
 
+			// Wait for a free slot
+			semaphore <- struct{}{}
+			defer func() { <-semaphore }()
+
+			// Don't begin new starts once cancelled
+			if err := ctx.Err(); err != nil {
+				errChan <- fmt.Errorf("failed to start %s: %w", serviceName, err)
+				return
+			}
+
 			// Get the service (thread-safe via GetService)
 			svc, ok := o.GetService(serviceName)
 			if !ok {
@@ -237,6 +490,14 @@ func (o *Orchestrator) startServicesInParallel(ctx context.Context, serviceNames
 	return nil
 }
 
+// parallelLimit returns the configured start concurrency, falling back to the default
+func (o *Orchestrator) parallelLimit() int {
+	if o.maxParallel <= 0 {
+		return DefaultMaxParallel
+	}
+	return o.maxParallel
+}
+
 // ============================================================================
 // Private Methods - Health Check Waiting
 // ============================================================================
@@ -252,7 +513,7 @@ func (o *Orchestrator) waitForHealthy(ctx context.Context, serviceNames []string
 	hasHealthChecks := false
 	for _, name := range serviceNames {
 		svc, ok := o.GetService(name)
-		if ok && svc.Config.Health != nil {
+		if ok && svc.NeedsHealthWait() {
 			hasHealthChecks = true
 			break
 		}
@@ -276,7 +537,7 @@ func (o *Orchestrator) waitForHealthy(ctx context.Context, serviceNames []string
 		}
 
 		// Only wait for services with health checks
-		if svc.Config.Health == nil {
+		if !svc.NeedsHealthWait() {
 			continue
 		}
 
@@ -285,7 +546,10 @@ func (o *Orchestrator) waitForHealthy(ctx context.Context, serviceNames []string
 			defer wg.Done()
 
 			// Wait for health with timeout
-			if err := o.waitForServiceHealth(ctx, service); err != nil {
+			waitStart := time.Now()
+			err := o.waitForServiceHealth(ctx, service)
+			service.recordHealthWait(time.Since(waitStart))
+			if err != nil {
 				errChan <- err
 				return
 			}
@@ -308,18 +572,19 @@ func (o *Orchestrator) waitForHealthy(ctx context.Context, serviceNames []string
 	return nil
 }
 
-// waitForServiceHealth waits for a single service to become healthy
-func (o *Orchestrator) waitForServiceHealth(ctx context.Context, svc *Service) error {
+// WaitForHealthy polls a started service's health check until it passes
+// Checks run every health interval (default 5s) and give up after the service's health wait timeout
+func WaitForHealthy(ctx context.Context, client *docker.Client, svc *Service) error {
 	// Parse health check interval
 	interval := 5 * time.Second
-	if svc.Config.Health.Interval != "" {
+	if svc.Config.Health != nil && svc.Config.Health.Interval != "" {
 		if d, err := time.ParseDuration(svc.Config.Health.Interval); err == nil {
 			interval = d
 		}
 	}
 
-	// Maximum wait time (30 seconds)
-	maxWait := 30 * time.Second
+	// Maximum wait time (configurable per service)
+	maxWait := healthWaitTimeout(svc.Config.Health)
 	deadline := time.Now().Add(maxWait)
 
 	// Poll health until healthy or timeout
@@ -337,7 +602,7 @@ func (o *Orchestrator) waitForServiceHealth(ctx context.Context, svc *Service) e
 			}
 
 			// Perform health check
-			if err := svc.CheckHealth(ctx); err == nil {
+			if err := svc.CheckHealth(ctx, client); err == nil {
 				// Service is healthy
 				return nil
 			}
@@ -346,15 +611,40 @@ func (o *Orchestrator) waitForServiceHealth(ctx context.Context, svc *Service) e
 	}
 }
 
+// waitForServiceHealth waits for a single service to become healthy
+func (o *Orchestrator) waitForServiceHealth(ctx context.Context, svc *Service) error {
+	return WaitForHealthy(ctx, o.dockerClient, svc)
+}
+
+// defaultHealthWaitTimeout is how long to wait for a service to become healthy when not configured
+const defaultHealthWaitTimeout = 30 * time.Second
+
+// healthWaitTimeout returns the overall deadline for a service to become healthy
+// Falls back to the default when start_period is unset, invalid, or not positive
+func healthWaitTimeout(health *config.HealthCheck) time.Duration {
+	if health == nil || health.StartPeriod == "" {
+		return defaultHealthWaitTimeout
+	}
+
+	d, err := time.ParseDuration(health.StartPeriod)
+	if err != nil || d <= 0 {
+		return defaultHealthWaitTimeout
+	}
+
+	return d
+}
+
 // ============================================================================
 // Private Methods - Rollback
 // ============================================================================
 
 // rollbackStartedServices stops and removes all successfully started services
+// Rollback still runs when ctx has been cancelled, since cancellation is usually what triggered it
 func (o *Orchestrator) rollbackStartedServices(ctx context.Context, startedServices []*Service) {
 	if len(startedServices) == 0 {
 		return
 	}
+	ctx = context.WithoutCancel(ctx)
 
 	ui.EmptyLine()
 	ui.Warning(fmt.Sprintf("Rolling back %d started service(s)...", len(startedServices)))
@@ -376,37 +666,96 @@ func (o *Orchestrator) rollbackStartedServices(ctx context.Context, startedServi
 // Cleanup Methods
 // ============================================================================
 
+// StopProgress is called as each teardown level begins, with its (1-based) start level and its services
+type StopProgress func(level int, serviceNames []string)
+
 // StopAll stops all services managed by the orchestrator
+// Services are stopped in reverse dependency order (dependents before their dependencies),
+// with services at the same dependency level stopped in parallel
+// If the dependency graph can't be leveled (e.g. a cycle), services are stopped one by one by name
 func (o *Orchestrator) StopAll(ctx context.Context) error {
-	// Copy services to a slice while holding the lock
-	o.mu.RLock()
-	services := make([]*Service, 0, len(o.services))
-	for _, svc := range o.services {
-		services = append(services, svc)
+	// Snapshot service names and configs, so services added meanwhile don't race the walk
+	services := o.Services()
+	names := make([]string, 0, len(services))
+	configs := make(map[string]config.Service, len(services))
+	for _, svc := range services {
+		names = append(names, svc.Name)
+		configs[svc.Name] = svc.Config
 	}
-	o.mu.RUnlock()
 
-	var wg sync.WaitGroup
-	errChan := make(chan error, len(services))
+	errors, err := o.StopInReverseLevels(ctx, names, configs, o.stopService, nil)
+	if err != nil {
+		// Stopping in the wrong order beats leaving services running
+		ui.Warning(fmt.Sprintf("Stopping services one by one: %v", err))
+		sort.Strings(names)
+		errors = nil
+		for _, name := range names {
+			if stopErr := o.stopService(ctx, name); stopErr != nil {
+				errors = append(errors, stopErr)
+			}
+		}
+	}
 
-	// Stop all services in parallel
-	for _, svc := range services {
-		if !svc.IsRunning() {
+	if len(errors) > 0 {
+		return fmt.Errorf("failed to stop some services: %v", errors)
+	}
+
+	return nil
+}
+
+// StopInReverseLevels stops serviceNames with stop, walking the start levels from the highest down,
+// so dependents stop before the services they rely on; services within a level are stopped in parallel
+// Levels come from the dependency graph of allServices, and levels with nothing to stop are skipped
+// Every stop error is returned, continuing so that as much as possible is stopped
+func (o *Orchestrator) StopInReverseLevels(ctx context.Context, serviceNames []string, allServices map[string]config.Service, stop func(ctx context.Context, name string) error, progress StopProgress) ([]error, error) {
+	// Reuse the start levels - stopping walks them from the highest level down
+	levels, err := o.buildDependencyLevels(serviceNames, allServices)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build dependency levels: %w", err)
+	}
+
+	var errors []error
+	for i := len(levels) - 1; i >= 0; i-- {
+		if len(levels[i]) == 0 {
 			continue
 		}
+		if progress != nil {
+			progress(i+1, levels[i])
+		}
+		errors = append(errors, stopInParallel(ctx, levels[i], stop)...)
+	}
 
+	return errors, nil
+}
+
+// stopService stops one running service with a spinner (services that aren't running are skipped)
+func (o *Orchestrator) stopService(ctx context.Context, name string) error {
+	svc, ok := o.GetService(name)
+	if !ok || !svc.IsRunning() {
+		return nil
+	}
+
+	spinner := ui.ShowSpinner(fmt.Sprintf("Stopping %s", svc.Name))
+	if err := svc.Stop(ctx, o.dockerClient); err != nil {
+		spinner.Error(fmt.Sprintf("Failed to stop %s", svc.Name))
+		return fmt.Errorf("failed to stop %s: %w", svc.Name, err)
+	}
+	spinner.Success(fmt.Sprintf("Stopped %s", svc.Name))
+	return nil
+}
+
+// stopInParallel runs stop for every service in serviceNames concurrently
+// Returns every error encountered (empty if all stops succeeded)
+func stopInParallel(ctx context.Context, serviceNames []string, stop func(ctx context.Context, name string) error) []error {
+	var wg sync.WaitGroup
+	errChan := make(chan error, len(serviceNames))
+
+	for _, name := range serviceNames {
 		wg.Add(1)
-		go func(service *Service) {
+		go func(name string) {
 			defer wg.Done()
-
-			spinner := ui.ShowSpinner(fmt.Sprintf("Stopping %s", service.Name))
-			if err := service.Stop(ctx, o.dockerClient); err != nil {
-				spinner.Error(fmt.Sprintf("Failed to stop %s", service.Name))
-				errChan <- fmt.Errorf("failed to stop %s: %w", service.Name, err)
-				return
-			}
-			spinner.Success(fmt.Sprintf("Stopped %s", service.Name))
-		}(svc)
+			errChan <- stop(ctx, name)
+		}(name)
 	}
 
 	// Wait for all stops to complete
@@ -421,9 +770,5 @@ func (o *Orchestrator) StopAll(ctx context.Context) error {
 		}
 	}
 
-	if len(errors) > 0 {
-		return fmt.Errorf("failed to stop some services: %v", errors)
-	}
-
-	return nil
+	return errors
 }
@@ -1,11 +1,24 @@
 package service
 
 import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
 	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/ork-cli/ork/internal/config"
+	"github.com/ork-cli/ork/internal/docker"
+	"github.com/ork-cli/ork/internal/docker/dockertest"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 // ============================================================================
@@ -130,11 +143,11 @@ func TestOrchestrator_buildDependencyLevels_LinearChain(t *testing.T) {
 	allServices := map[string]config.Service{
 		"frontend": {
 			Image:     "nginx:alpine",
-			DependsOn: []string{"api"},
+			DependsOn: config.DependsOnServices("api"),
 		},
 		"api": {
 			Image:     "node:18",
-			DependsOn: []string{"postgres"},
+			DependsOn: config.DependsOnServices("postgres"),
 		},
 		"postgres": {
 			Image: "postgres:15",
@@ -158,11 +171,11 @@ func TestOrchestrator_buildDependencyLevels_ParallelWithSharedDependency(t *test
 	allServices := map[string]config.Service{
 		"frontend": {
 			Image:     "nginx:alpine",
-			DependsOn: []string{"api"},
+			DependsOn: config.DependsOnServices("api"),
 		},
 		"api": {
 			Image:     "node:18",
-			DependsOn: []string{"postgres", "redis"},
+			DependsOn: config.DependsOnServices("postgres", "redis"),
 		},
 		"postgres": {
 			Image: "postgres:15",
@@ -195,15 +208,15 @@ func TestOrchestrator_buildDependencyLevels_DiamondPattern(t *testing.T) {
 	allServices := map[string]config.Service{
 		"frontend": {
 			Image:     "nginx:alpine",
-			DependsOn: []string{"api", "cache"},
+			DependsOn: config.DependsOnServices("api", "cache"),
 		},
 		"api": {
 			Image:     "node:18",
-			DependsOn: []string{"postgres"},
+			DependsOn: config.DependsOnServices("postgres"),
 		},
 		"cache": {
 			Image:     "redis:7",
-			DependsOn: []string{"postgres"},
+			DependsOn: config.DependsOnServices("postgres"),
 		},
 		"postgres": {
 			Image: "postgres:15",
@@ -233,19 +246,19 @@ func TestOrchestrator_buildDependencyLevels_ComplexGraph(t *testing.T) {
 	allServices := map[string]config.Service{
 		"frontend": {
 			Image:     "nginx:alpine",
-			DependsOn: []string{"api", "auth"},
+			DependsOn: config.DependsOnServices("api", "auth"),
 		},
 		"api": {
 			Image:     "node:18",
-			DependsOn: []string{"postgres", "redis", "queue"},
+			DependsOn: config.DependsOnServices("postgres", "redis", "queue"),
 		},
 		"auth": {
 			Image:     "node:18",
-			DependsOn: []string{"postgres", "redis"},
+			DependsOn: config.DependsOnServices("postgres", "redis"),
 		},
 		"queue": {
 			Image:     "rabbitmq:3",
-			DependsOn: []string{"redis"},
+			DependsOn: config.DependsOnServices("redis"),
 		},
 		"postgres": {
 			Image: "postgres:15",
@@ -284,11 +297,11 @@ func TestOrchestrator_buildDependencyLevels_PartialServiceList(t *testing.T) {
 	allServices := map[string]config.Service{
 		"frontend": {
 			Image:     "nginx:alpine",
-			DependsOn: []string{"api"},
+			DependsOn: config.DependsOnServices("api"),
 		},
 		"api": {
 			Image:     "node:18",
-			DependsOn: []string{"postgres"},
+			DependsOn: config.DependsOnServices("postgres"),
 		},
 		"postgres": {
 			Image: "postgres:15",
@@ -365,10 +378,11 @@ func TestOrchestrator_calculateServiceLevel(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			orch := NewOrchestrator("myproject", nil, "network-123")
 			levels := make(map[string]int)
-			visited := make(map[string]bool)
+			visiting := make(map[string]bool)
 
-			level := orch.calculateServiceLevel(tt.serviceName, tt.graph, levels, visited)
+			level, err := orch.calculateServiceLevel(tt.serviceName, tt.graph, levels, visiting)
 
+			require.NoError(t, err)
 			assert.Equal(t, tt.wantLevel, level)
 			assert.Equal(t, tt.wantLevel, levels[tt.serviceName], "level should be cached")
 		})
@@ -384,14 +398,16 @@ func TestOrchestrator_calculateServiceLevel_Caching(t *testing.T) {
 	}
 
 	levels := make(map[string]int)
-	visited := make(map[string]bool)
+	visiting := make(map[string]bool)
 
 	// First call calculates
-	level1 := orch.calculateServiceLevel("api", graph, levels, visited)
+	level1, err := orch.calculateServiceLevel("api", graph, levels, visiting)
+	require.NoError(t, err)
 	assert.Equal(t, 1, level1)
 
 	// Second call should return cached value
-	level2 := orch.calculateServiceLevel("api", graph, levels, visited)
+	level2, err := orch.calculateServiceLevel("api", graph, levels, visiting)
+	require.NoError(t, err)
 	assert.Equal(t, 1, level2)
 	assert.Equal(t, level1, level2)
 }
@@ -442,14 +458,94 @@ func TestOrchestrator_calculateServiceLevel_CircularDependency(t *testing.T) {
 	}
 
 	levels := make(map[string]int)
-	visited := make(map[string]bool)
+	visiting := make(map[string]bool)
 
-	// Should handle circular dependencies gracefully (returns 0)
-	level := orch.calculateServiceLevel("A", graph, levels, visited)
+	// A cycle is an error, not a silently flattened level
+	_, err := orch.calculateServiceLevel("A", graph, levels, visiting)
 
-	// With circular dependency detected via visited, should return some level
-	// The exact behavior depends on implementation, but it shouldn't panic
-	assert.GreaterOrEqual(t, level, 0)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "circular dependency")
+	assert.Empty(t, levels, "no service in the cycle gets a level")
+}
+
+func TestOrchestrator_calculateServiceLevel_DeepDiamond(t *testing.T) {
+	orch := NewOrchestrator("myproject", nil, "network-123")
+
+	// Two branches of different depth share a root and rejoin at app:
+	//   db <- cache <- queue <- worker <- app
+	//   db <- api ------------------------^
+	// app is reached through api first, so db is already memoized when the long branch gets there
+	graph := map[string][]string{
+		"db":     {},
+		"api":    {"db"},
+		"cache":  {"db"},
+		"queue":  {"cache"},
+		"worker": {"queue"},
+		"app":    {"api", "worker"},
+	}
+
+	levels := make(map[string]int)
+	level, err := orch.calculateServiceLevel("app", graph, levels, make(map[string]bool))
+
+	require.NoError(t, err)
+	assert.Equal(t, 4, level, "the longest path decides the level")
+	assert.Equal(t, map[string]int{"db": 0, "api": 1, "cache": 1, "queue": 2, "worker": 3, "app": 4}, levels)
+}
+
+func TestOrchestrator_buildDependencyLevels_DeepDiamond(t *testing.T) {
+	orch := NewOrchestrator("myproject", nil, "network-123")
+
+	// Nested diamonds: edge and admin share api and auth, which share db and cache
+	allServices := map[string]config.Service{
+		"db":    {Image: "postgres:15"},
+		"cache": {Image: "redis:7"},
+		"auth":  {Image: "auth:latest", DependsOn: config.DependsOnServices("db", "cache")},
+		"api":   {Image: "api:latest", DependsOn: config.DependsOnServices("db", "auth")},
+		"admin": {Image: "admin:latest", DependsOn: config.DependsOnServices("api", "cache")},
+		"edge":  {Image: "nginx:alpine", DependsOn: config.DependsOnServices("admin", "db")},
+	}
+	ordered, err := ResolveDependencies(allServices, []string{"edge"})
+	require.NoError(t, err)
+
+	levels, err := orch.buildDependencyLevels(ordered, allServices)
+
+	require.NoError(t, err)
+	require.Len(t, levels, 5)
+	assert.ElementsMatch(t, []string{"db", "cache"}, levels[0])
+	assert.Equal(t, []string{"auth"}, levels[1])
+	assert.Equal(t, []string{"api"}, levels[2])
+	assert.Equal(t, []string{"admin"}, levels[3])
+	assert.Equal(t, []string{"edge"}, levels[4])
+}
+
+func TestOrchestrator_buildDependencyLevels_RejectsCycles(t *testing.T) {
+	orch := NewOrchestrator("myproject", nil, "network-123")
+
+	tests := map[string]map[string]config.Service{
+		"self dependency": {
+			"api": {Image: "node:18", DependsOn: config.DependsOnServices("api")},
+		},
+		"cycle below a diamond": {
+			"db":  {Image: "postgres:15", DependsOn: config.DependsOnServices("api")},
+			"api": {Image: "node:18", DependsOn: config.DependsOnServices("db")},
+			"web": {Image: "nginx:alpine", DependsOn: config.DependsOnServices("api", "db")},
+		},
+	}
+
+	for name, allServices := range tests {
+		t.Run(name, func(t *testing.T) {
+			names := make([]string, 0, len(allServices))
+			for serviceName := range allServices {
+				names = append(names, serviceName)
+			}
+
+			levels, err := orch.buildDependencyLevels(names, allServices)
+
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), "circular dependency")
+			assert.Nil(t, levels)
+		})
+	}
 }
 
 // ============================================================================
@@ -513,6 +609,46 @@ func TestOrchestrator_ConcurrentAddAndGet(t *testing.T) {
 	assert.NotNil(t, svc)
 }
 
+// TestOrchestrator_ConcurrentAddDistinctAndIterate adds distinct services from several goroutines
+// while others iterate them; run with -race to catch unguarded map access
+func TestOrchestrator_ConcurrentAddDistinctAndIterate(t *testing.T) {
+	orch := NewOrchestrator("myproject", nil, "network-123")
+
+	const writers, perWriter = 8, 25
+	var wg sync.WaitGroup
+
+	for w := 0; w < writers; w++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			for i := 0; i < perWriter; i++ {
+				orch.AddService(fmt.Sprintf("service-%d-%d", w, i), config.Service{Image: "nginx:alpine"})
+			}
+		}(w)
+	}
+
+	for r := 0; r < 4; r++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < perWriter; i++ {
+				for _, svc := range orch.Services() {
+					_, _ = orch.GetService(svc.Name)
+				}
+				orch.setBaseDir("/project")
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	services := orch.Services()
+	require.Len(t, services, writers*perWriter)
+	assert.True(t, sort.SliceIsSorted(services, func(i, j int) bool {
+		return services[i].Name < services[j].Name
+	}), "expected services sorted by name")
+}
+
 // TestOrchestrator_startServicesInParallel_ConcurrentSliceAppend tests that
 // the mutex properly protects concurrent appends to the startedServices slice.
 // This test simulates the race condition that would occur without the mutex
@@ -649,6 +785,49 @@ func TestOrchestrator_RollbackOrdering_PreservesReverseOrder(t *testing.T) {
 	assert.Equal(t, startOrder[len(startOrder)-1], rollbackOrder[0])
 }
 
+func TestOrchestrator_StartServicesInOrder_CancelledMidStartRollsBack(t *testing.T) {
+	t.Chdir(t.TempDir())
+	fake, client := dockertest.NewServer(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// Containers get sequential IDs: db is created first, then api
+	dbID, apiID := fmt.Sprintf("%064d", 1), fmt.Sprintf("%064d", 2)
+
+	// Simulate Ctrl+C arriving while api's container is starting
+	fake.Handle(http.MethodPost, "/containers/"+apiID+"/start", func(w http.ResponseWriter, r *http.Request) {
+		cancel()
+		w.WriteHeader(http.StatusInternalServerError)
+		_, _ = w.Write([]byte(`{"message":"interrupted"}`))
+	})
+
+	cfg := &config.Config{
+		Project: "myproject",
+		Services: map[string]config.Service{
+			"db":  {Image: "postgres:15"},
+			"api": {Image: "node:18", DependsOn: config.DependsOnServices("db")},
+			"web": {Image: "nginx:alpine", DependsOn: config.DependsOnServices("api")},
+		},
+	}
+	orch := NewOrchestrator("myproject", client, "")
+	for _, name := range []string{"db", "api", "web"} {
+		orch.AddService(name, cfg.Services[name])
+	}
+
+	err := orch.StartServicesInOrder(ctx, []string{"db", "api", "web"}, cfg)
+	require.Error(t, err)
+
+	// db had started, so it's rolled back even though ctx is cancelled
+	assert.True(t, fake.HasRequest("POST /containers/"+dbID+"/stop"))
+	assert.True(t, fake.HasRequest("DELETE /containers/"+dbID))
+	db, _ := orch.GetService("db")
+	assert.Equal(t, StateStopped, db.GetState())
+
+	// api's half-started container is removed, and web is never created
+	assert.True(t, fake.HasRequest("DELETE /containers/"+apiID))
+	assert.Equal(t, 2, fake.RequestCount("POST /containers/create"))
+}
+
 // ============================================================================
 // Integration-style Tests (without Docker)
 // ============================================================================
@@ -660,19 +839,19 @@ func TestOrchestrator_RealWorldScenario(t *testing.T) {
 	allServices := map[string]config.Service{
 		"nginx": {
 			Image:     "nginx:alpine",
-			DependsOn: []string{"frontend", "api"},
+			DependsOn: config.DependsOnServices("frontend", "api"),
 		},
 		"frontend": {
 			Image:     "react-app:latest",
-			DependsOn: []string{"api"},
+			DependsOn: config.DependsOnServices("api"),
 		},
 		"api": {
 			Image:     "node:18",
-			DependsOn: []string{"postgres", "redis", "rabbitmq"},
+			DependsOn: config.DependsOnServices("postgres", "redis", "rabbitmq"),
 		},
 		"worker": {
 			Image:     "node:18",
-			DependsOn: []string{"postgres", "redis", "rabbitmq"},
+			DependsOn: config.DependsOnServices("postgres", "redis", "rabbitmq"),
 		},
 		"postgres": {
 			Image: "postgres:15",
@@ -704,3 +883,516 @@ func TestOrchestrator_RealWorldScenario(t *testing.T) {
 	// Level 3: Nginx depends on frontend and api
 	assert.Equal(t, []string{"nginx"}, levels[3])
 }
+
+// ============================================================================
+// Native Health Check Tests (against a fake Docker daemon)
+// ============================================================================
+
+// nativeHealthHandler serves inspect responses that walk through the given health statuses
+func nativeHealthHandler(statuses ...string) (http.HandlerFunc, *atomic.Int32) {
+	var calls atomic.Int32
+	return func(w http.ResponseWriter, r *http.Request) {
+		i := int(calls.Add(1)) - 1
+		if i >= len(statuses) {
+			i = len(statuses) - 1
+		}
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"Id":    "0123456789abcdef",
+			"State": map[string]any{"Status": "running", "Running": true, "Health": map[string]any{"Status": statuses[i]}},
+		})
+	}, &calls
+}
+
+func TestOrchestrator_waitForServiceHealth_NativeStartingToHealthy(t *testing.T) {
+	fake, client := dockertest.NewServer(t)
+	handler, calls := nativeHealthHandler("starting", "starting", "healthy")
+	fake.Handle(http.MethodGet, "/containers/0123456789abcdef/json", handler)
+
+	orch := NewOrchestrator("myproject", client, "")
+	orch.AddService("db", config.Service{
+		Image:               "postgres:15",
+		WaitForNativeHealth: true,
+		Health:              &config.HealthCheck{Interval: "10ms"},
+	})
+	svc, _ := orch.GetService("db")
+	svc.mu.Lock()
+	svc.state = StateRunning
+	svc.containerID = "0123456789abcdef"
+	svc.mu.Unlock()
+
+	err := orch.waitForServiceHealth(context.Background(), svc)
+	require.NoError(t, err)
+
+	assert.Equal(t, int32(3), calls.Load(), "expected to poll until the native status became healthy")
+	assert.True(t, svc.IsHealthy())
+}
+
+// ============================================================================
+// Health Wait Deadline Tests
+// ============================================================================
+
+func TestHealthWaitTimeout(t *testing.T) {
+	tests := []struct {
+		name   string
+		health *config.HealthCheck
+		want   time.Duration
+	}{
+		{name: "no health check uses default", health: nil, want: 30 * time.Second},
+		{name: "unset start period uses default", health: &config.HealthCheck{Endpoint: "/"}, want: 30 * time.Second},
+		{name: "minutes", health: &config.HealthCheck{StartPeriod: "2m"}, want: 2 * time.Minute},
+		{name: "seconds", health: &config.HealthCheck{StartPeriod: "45s"}, want: 45 * time.Second},
+		{name: "compound duration", health: &config.HealthCheck{StartPeriod: "1m30s"}, want: 90 * time.Second},
+		{name: "invalid duration falls back", health: &config.HealthCheck{StartPeriod: "forever"}, want: 30 * time.Second},
+		{name: "missing unit falls back", health: &config.HealthCheck{StartPeriod: "60"}, want: 30 * time.Second},
+		{name: "negative duration falls back", health: &config.HealthCheck{StartPeriod: "-5s"}, want: 30 * time.Second},
+		{name: "zero duration falls back", health: &config.HealthCheck{StartPeriod: "0s"}, want: 30 * time.Second},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, healthWaitTimeout(tt.health))
+		})
+	}
+}
+
+func TestOrchestrator_waitForServiceHealth_RespectsStartPeriod(t *testing.T) {
+	fake, client := dockertest.NewServer(t)
+	handler, _ := nativeHealthHandler("starting")
+	fake.Handle(http.MethodGet, "/containers/0123456789abcdef/json", handler)
+
+	orch := NewOrchestrator("myproject", client, "")
+	orch.AddService("search", config.Service{
+		Image:               "elasticsearch:8",
+		WaitForNativeHealth: true,
+		Health:              &config.HealthCheck{Interval: "10ms", StartPeriod: "50ms"},
+	})
+	svc, _ := orch.GetService("search")
+	svc.mu.Lock()
+	svc.state = StateRunning
+	svc.containerID = "0123456789abcdef"
+	svc.mu.Unlock()
+
+	start := time.Now()
+	err := orch.waitForServiceHealth(context.Background(), svc)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "did not become healthy within 50ms")
+	assert.Less(t, time.Since(start), 5*time.Second)
+}
+
+// ============================================================================
+// StopAll Ordering Tests (against a fake Docker daemon)
+// ============================================================================
+
+// stopOrder returns the container IDs in the order the fake daemon received stop requests
+func stopOrder(requests []string) []string {
+	var order []string
+	for _, r := range requests {
+		if strings.HasPrefix(r, "POST /containers/") && strings.HasSuffix(r, "/stop") {
+			id := strings.TrimSuffix(strings.TrimPrefix(r, "POST /containers/"), "/stop")
+			order = append(order, id)
+		}
+	}
+	return order
+}
+
+// markRunning puts an orchestrator service into the running state with a container ID
+func markRunning(t *testing.T, orch *Orchestrator, name, containerID string) {
+	t.Helper()
+	svc, ok := orch.GetService(name)
+	require.True(t, ok)
+	svc.mu.Lock()
+	svc.state = StateRunning
+	svc.containerID = containerID
+	svc.mu.Unlock()
+}
+
+func TestOrchestrator_StopAll_ReverseDependencyOrder(t *testing.T) {
+	fake, client := dockertest.NewServer(t)
+
+	// Linear chain: frontend -> api -> postgres
+	orch := NewOrchestrator("myproject", client, "")
+	orch.AddService("postgres", config.Service{Image: "postgres:15"})
+	orch.AddService("api", config.Service{Image: "node:18", DependsOn: config.DependsOnServices("postgres")})
+	orch.AddService("frontend", config.Service{Image: "nginx:alpine", DependsOn: config.DependsOnServices("api")})
+	markRunning(t, orch, "postgres", "postgres-container")
+	markRunning(t, orch, "api", "api-container")
+	markRunning(t, orch, "frontend", "frontend-container")
+
+	err := orch.StopAll(context.Background())
+	require.NoError(t, err)
+
+	// Dependents must be stopped before their dependencies - the database goes last
+	assert.Equal(t, []string{"frontend-container", "api-container", "postgres-container"}, stopOrder(fake.Requests()))
+
+	for _, name := range []string{"postgres", "api", "frontend"} {
+		svc, _ := orch.GetService(name)
+		assert.Equal(t, StateStopped, svc.GetState(), name)
+	}
+}
+
+func TestOrchestrator_StopAll_SkipsServicesNotRunning(t *testing.T) {
+	fake, client := dockertest.NewServer(t)
+
+	orch := NewOrchestrator("myproject", client, "")
+	orch.AddService("postgres", config.Service{Image: "postgres:15"})
+	orch.AddService("api", config.Service{Image: "node:18", DependsOn: config.DependsOnServices("postgres")})
+	markRunning(t, orch, "postgres", "postgres-container")
+
+	require.NoError(t, orch.StopAll(context.Background()))
+	assert.Equal(t, []string{"postgres-container"}, stopOrder(fake.Requests()))
+}
+
+func TestOrchestrator_StopAll_AggregatesErrors(t *testing.T) {
+	fake, client := dockertest.NewServer(t)
+	fake.Handle(http.MethodPost, "/containers/api-container/stop", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+		_, _ = w.Write([]byte(`{"message":"boom"}`))
+	})
+
+	orch := NewOrchestrator("myproject", client, "")
+	orch.AddService("postgres", config.Service{Image: "postgres:15"})
+	orch.AddService("api", config.Service{Image: "node:18", DependsOn: config.DependsOnServices("postgres")})
+	markRunning(t, orch, "postgres", "postgres-container")
+	markRunning(t, orch, "api", "api-container")
+
+	err := orch.StopAll(context.Background())
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to stop api")
+
+	// A failure in one level must not prevent lower levels from being stopped
+	assert.Contains(t, stopOrder(fake.Requests()), "postgres-container")
+}
+
+func TestOrchestrator_StopAll_CycleStopsOneByOne(t *testing.T) {
+	fake, client := dockertest.NewServer(t)
+
+	orch := NewOrchestrator("myproject", client, "")
+	orch.AddService("a", config.Service{Image: "alpine", DependsOn: config.DependsOnServices("b")})
+	orch.AddService("b", config.Service{Image: "alpine", DependsOn: config.DependsOnServices("a")})
+	markRunning(t, orch, "a", "a-container")
+	markRunning(t, orch, "b", "b-container")
+
+	require.NoError(t, orch.StopAll(context.Background()))
+	assert.Equal(t, []string{"a-container", "b-container"}, stopOrder(fake.Requests()))
+}
+
+func TestOrchestrator_StopInReverseLevels_ReportsLevelsHighestFirst(t *testing.T) {
+	_, client := dockertest.NewServer(t)
+	services := map[string]config.Service{
+		"postgres": {Image: "postgres:15"},
+		"redis":    {Image: "redis:7"},
+		"api":      {Image: "node:18", DependsOn: config.DependsOnServices("postgres", "redis")},
+		"worker":   {Image: "node:18", DependsOn: config.DependsOnServices("redis")},
+		"frontend": {Image: "nginx:alpine", DependsOn: config.DependsOnServices("api")},
+	}
+	orch := NewOrchestrator("myproject", client, "")
+
+	var mu sync.Mutex
+	var stopped []string
+	stop := func(ctx context.Context, name string) error {
+		mu.Lock()
+		defer mu.Unlock()
+		stopped = append(stopped, name)
+		return nil
+	}
+
+	type progressEvent struct {
+		level    int
+		services []string
+		stopped  int // Services already stopped when the level began
+	}
+	var events []progressEvent
+	progress := func(level int, serviceNames []string) {
+		mu.Lock()
+		defer mu.Unlock()
+		events = append(events, progressEvent{level, serviceNames, len(stopped)})
+	}
+
+	errs, err := orch.StopInReverseLevels(context.Background(), []string{"api", "frontend", "postgres", "redis", "worker"}, services, stop, progress)
+	require.NoError(t, err)
+	assert.Empty(t, errs)
+
+	assert.Equal(t, []progressEvent{
+		{level: 3, services: []string{"frontend"}, stopped: 0},
+		{level: 2, services: []string{"api", "worker"}, stopped: 1},
+		{level: 1, services: []string{"postgres", "redis"}, stopped: 3},
+	}, events)
+	assert.Len(t, stopped, 5)
+}
+
+func TestOrchestrator_StopInReverseLevels_SkipsEmptyLevels(t *testing.T) {
+	_, client := dockertest.NewServer(t)
+	services := map[string]config.Service{
+		"postgres": {Image: "postgres:15"},
+		"api":      {Image: "node:18", DependsOn: config.DependsOnServices("postgres")},
+		"frontend": {Image: "nginx:alpine", DependsOn: config.DependsOnServices("api")},
+	}
+	orch := NewOrchestrator("myproject", client, "")
+
+	// Stopping frontend and postgres without api: the levels keep their place in the full graph
+	var levels []int
+	_, err := orch.StopInReverseLevels(context.Background(), []string{"frontend", "postgres"}, services,
+		func(ctx context.Context, name string) error { return nil },
+		func(level int, serviceNames []string) { levels = append(levels, level) })
+
+	require.NoError(t, err)
+	assert.Equal(t, []int{3, 1}, levels)
+}
+
+func TestOrchestrator_StopInReverseLevels_RejectsCycles(t *testing.T) {
+	_, client := dockertest.NewServer(t)
+	services := map[string]config.Service{
+		"a": {DependsOn: config.DependsOnServices("b")},
+		"b": {DependsOn: config.DependsOnServices("a")},
+	}
+	orch := NewOrchestrator("myproject", client, "")
+
+	called := false
+	_, err := orch.StopInReverseLevels(context.Background(), []string{"a", "b"}, services,
+		func(ctx context.Context, name string) error { called = true; return nil }, nil)
+
+	require.Error(t, err)
+	assert.False(t, called, "nothing is stopped when the levels can't be built")
+}
+
+// ============================================================================
+// Dependency Condition Tests
+// ============================================================================
+
+func TestDeferrableHealthWaits(t *testing.T) {
+	services := map[string]config.Service{
+		"postgres": {Image: "postgres:15"},
+		"redis":    {Image: "redis:7"},
+		"cache":    {Image: "memcached"},
+		"api": {Image: "node:18", DependsOn: config.DependsOn{
+			{Service: "postgres", Condition: config.ConditionServiceHealthy},
+			{Service: "redis", Condition: config.ConditionServiceStarted},
+		}},
+		"worker": {Image: "node:18", DependsOn: config.DependsOn{
+			{Service: "postgres", Condition: config.ConditionServiceStarted},
+			{Service: "cache", Condition: config.ConditionServiceStarted},
+		}},
+		"frontend": {Image: "nginx", DependsOn: config.DependsOn{{Service: "api", Condition: config.ConditionServiceStarted}}},
+	}
+
+	deferrable := deferrableHealthWaits([]string{"postgres", "redis", "cache", "api", "worker", "frontend"}, services)
+
+	// postgres is required healthy by api (even though worker only needs it started)
+	assert.False(t, deferrable["postgres"])
+	// redis, cache, and api are only required to have started
+	assert.True(t, deferrable["redis"])
+	assert.True(t, deferrable["cache"])
+	assert.True(t, deferrable["api"])
+	// Nothing depends on frontend, so its health blocks like before
+	assert.False(t, deferrable["frontend"])
+}
+
+func TestDeferrableHealthWaits_ListFormBlocksOnHealth(t *testing.T) {
+	services := map[string]config.Service{
+		"postgres": {Image: "postgres:15", Health: &config.HealthCheck{Command: []string{"pg_isready"}}},
+		"redis":    {Image: "redis:7"},
+		"api":      {Image: "node:18", DependsOn: config.DependsOnServices("postgres")},
+		"worker": {Image: "node:18", DependsOn: config.DependsOn{
+			{Service: "redis", Condition: config.ConditionServiceStarted},
+		}},
+	}
+
+	deferrable := deferrableHealthWaits([]string{"postgres", "redis", "api", "worker"}, services)
+
+	assert.False(t, deferrable["postgres"], "a list-form dependency keeps blocking on its health check")
+	assert.True(t, deferrable["redis"], "an explicit service_started dependency doesn't block")
+}
+
+func TestDeferrableHealthWaits_IgnoresDependentsNotStarting(t *testing.T) {
+	services := map[string]config.Service{
+		"postgres": {Image: "postgres:15"},
+		"api":      {Image: "node:18", DependsOn: config.DependsOnServices("postgres")},
+	}
+
+	deferrable := deferrableHealthWaits([]string{"postgres"}, services)
+
+	assert.False(t, deferrable["postgres"], "a service with no dependents being started should block on its health")
+}
+
+// ============================================================================
+// Start Concurrency Limit Tests
+// ============================================================================
+
+// concurrencyTracker records the peak number of in-flight container creates
+type concurrencyTracker struct {
+	inFlight atomic.Int32
+	peak     atomic.Int32
+	created  atomic.Int32
+}
+
+// handler serves container creates slowly enough for starts to overlap
+func (c *concurrencyTracker) handler(w http.ResponseWriter, r *http.Request) {
+	current := c.inFlight.Add(1)
+	for {
+		peak := c.peak.Load()
+		if current <= peak || c.peak.CompareAndSwap(peak, current) {
+			break
+		}
+	}
+
+	time.Sleep(20 * time.Millisecond)
+	c.inFlight.Add(-1)
+
+	id := c.created.Add(1)
+	_ = json.NewEncoder(w).Encode(map[string]any{"Id": strings.Repeat("c", 11) + string(rune('a'+id))})
+}
+
+func TestOrchestrator_startServicesInParallel_RespectsMaxParallel(t *testing.T) {
+	t.Chdir(t.TempDir())
+	fake, client := dockertest.NewServer(t)
+	tracker := &concurrencyTracker{}
+	fake.Handle(http.MethodPost, "/containers/create", tracker.handler)
+
+	orch := NewOrchestrator("myproject", client, "")
+	orch.maxParallel = 3
+
+	names := make([]string, 0, 10)
+	for i := 0; i < 10; i++ {
+		name := "svc-" + string(rune('a'+i))
+		names = append(names, name)
+		orch.AddService(name, config.Service{Image: "nginx:alpine"})
+	}
+
+	var started []*Service
+	require.NoError(t, orch.startServicesInParallel(context.Background(), names, &started))
+
+	assert.Len(t, started, 10, "every service should still be tracked")
+	assert.Equal(t, int32(10), tracker.created.Load())
+	assert.LessOrEqual(t, tracker.peak.Load(), int32(3), "no more than max_parallel starts may be in flight")
+	assert.Greater(t, tracker.peak.Load(), int32(1), "starts should still run in parallel")
+}
+
+func TestOrchestrator_parallelLimit(t *testing.T) {
+	orch := NewOrchestrator("myproject", nil, "")
+	assert.Equal(t, DefaultMaxParallel, orch.parallelLimit())
+
+	orch.maxParallel = 8
+	assert.Equal(t, 8, orch.parallelLimit())
+}
+
+// ============================================================================
+// Dry Run Tests
+// ============================================================================
+
+func dryRunTestConfig(t *testing.T) *config.Config {
+	t.Helper()
+
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, ".env"), []byte("LOG_LEVEL=debug\n"), 0o644))
+
+	return &config.Config{
+		Project: "shop",
+		BaseDir: dir,
+		Services: map[string]config.Service{
+			"postgres": {Image: "postgres:15", Env: map[string]string{"POSTGRES_DB": "shop"}},
+			"cache":    {Image: "redis:7"},
+			"api": {
+				Build:     &config.Build{Context: "./api"},
+				Ports:     []string{"8080:8080"},
+				Env:       map[string]string{"LOG": "${LOG_LEVEL}"},
+				Labels:    map[string]string{"team": "payments"},
+				DependsOn: config.DependsOnServices("postgres", "cache"),
+			},
+			"web": {Image: "nginx:alpine", DependsOn: config.DependsOnServices("api")},
+		},
+	}
+}
+
+func TestOrchestrator_PlanStart_MatchesDependencyLevels(t *testing.T) {
+	cfg := dryRunTestConfig(t)
+	ordered := []string{"cache", "postgres", "api", "web"}
+
+	orch := NewOrchestrator(cfg.Project, nil, "")
+	for _, name := range ordered {
+		orch.AddService(name, cfg.Services[name])
+	}
+
+	plan, err := orch.PlanStart(ordered, cfg)
+	require.NoError(t, err)
+
+	levels, err := orch.buildDependencyLevels(ordered, cfg.Services)
+	require.NoError(t, err)
+
+	require.Len(t, plan, len(levels))
+	for i, level := range plan {
+		names := make([]string, 0, len(level))
+		for _, planned := range level {
+			names = append(names, planned.Name)
+		}
+		assert.Equal(t, levels[i], names, "level %d", i+1)
+	}
+
+	api := plan[1][0].RunOptions
+	assert.Equal(t, "ork-shop-api", api.Name)
+	assert.Equal(t, "ork-shop-api:latest", api.Image, "build-based services plan the tag they would be built as")
+	assert.Equal(t, map[string]string{"8080": "8080"}, api.Ports)
+	assert.Equal(t, "debug", api.Env["LOG"], "env is loaded from the project .env like a real start")
+	assert.Equal(t, "payments", api.Labels["team"])
+	assert.Equal(t, "api", api.Labels["ork.service"])
+}
+
+func TestOrchestrator_DryRun_MakesNoDockerCalls(t *testing.T) {
+	fake, client := dockertest.NewServer(t)
+	cfg := dryRunTestConfig(t)
+	ordered := []string{"cache", "postgres", "api", "web"}
+
+	orch := NewOrchestrator(cfg.Project, client, "net-1")
+	for _, name := range ordered {
+		orch.AddService(name, cfg.Services[name])
+	}
+
+	before := len(fake.Requests())
+	require.NoError(t, orch.DryRun(ordered, cfg))
+
+	assert.Len(t, fake.Requests(), before, "dry run must not call the Docker daemon")
+	for _, name := range ordered {
+		svc, _ := orch.GetService(name)
+		assert.Equal(t, StatePending, svc.GetState())
+	}
+}
+
+func TestRunOptionLines(t *testing.T) {
+	lines := runOptionLines(docker.RunOptions{
+		Name:        "ork-shop-api",
+		Image:       "node:18",
+		Ports:       map[string]string{"9090": "9090", "8080": "80"},
+		Env:         map[string]string{"B": "2", "A": "1"},
+		Labels:      map[string]string{"ork.service": "api"},
+		Command:     []string{"npm", "start"},
+		NetworkMode: "host",
+	})
+
+	assert.Equal(t, []string{
+		"container ork-shop-api",
+		"image node:18",
+		"port 8080:80",
+		"port 9090:9090",
+		"env A=1",
+		"env B=2",
+		"label ork.service=api",
+		"command npm start",
+		"network host",
+	}, lines)
+}
+
+func TestRunOptionLines_MasksSecrets(t *testing.T) {
+	lines := runOptionLines(docker.RunOptions{
+		Env: map[string]string{
+			"DB_PASSWORD":  "hunter2",
+			"DATABASE_URL": "postgres://app:hunter2@db:5432/app",
+			"LOG_LEVEL":    "debug",
+		},
+	})
+
+	assert.Contains(t, lines, "env DB_PASSWORD=****")
+	assert.Contains(t, lines, "env DATABASE_URL=postgres://app:****@db:5432/app")
+	assert.Contains(t, lines, "env LOG_LEVEL=debug")
+	for _, line := range lines {
+		assert.NotContains(t, line, "hunter2")
+	}
+}
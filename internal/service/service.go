@@ -3,9 +3,14 @@ package service
 import (
 	"context"
 	"fmt"
+	"net"
 	"net/http"
+	"path/filepath"
+	"sort"
+	"strconv"
 	"strings"
 	"sync"
+	"syscall"
 	"time"
 
 	"github.com/ork-cli/ork/internal/config"
@@ -24,7 +29,8 @@ const (
 	StateStarting State = "starting" // Service is being started
 	StateRunning  State = "running"  // Service is running
 	StateStopping State = "stopping" // Service is being stopped
-	StateStopped  State = "stopped"  // Service has been stopped
+	StateStopped  State = "stopped"  // Service has been stopped by Ork
+	StateExited   State = "exited"   // Container exited on its own (crashed or finished), not stopped by Ork
 	StateFailed   State = "failed"   // Service failed to start or crashed
 )
 
@@ -38,6 +44,16 @@ const (
 	HealthStarting  HealthStatus = "starting"  // Service is starting (health check has not run yet)
 )
 
+// StartTimings breaks down how long bringing a service up took
+type StartTimings struct {
+	Build      time.Duration // Building the image from source (build-based services only)
+	Pull       time.Duration // Checking for (and pulling) the image
+	Create     time.Duration // Creating the container
+	Start      time.Duration // Starting the container
+	HealthWait time.Duration // Waiting for the service to become healthy
+	Total      time.Duration // Wall time of Start plus the health wait
+}
+
 // ============================================================================
 // Service Structure
 // ============================================================================
@@ -45,9 +61,12 @@ const (
 // Service represents a runtime service instance with state tracking
 type Service struct {
 	// Service identification
-	Name        string         // Service name (e.g., "frontend", "api")
-	ProjectName string         // Project this service belongs to
-	Config      config.Service // Service configuration from ork.yml
+	Name        string            // Service name (e.g., "frontend", "api")
+	ProjectName string            // Project this service belongs to
+	Config      config.Service    // Service configuration from ork.yml
+	BaseDir     string            // Directory containing ork.yml, where .env files are read from (empty uses the current directory)
+	PullPolicy  docker.PullPolicy // When to pull the image before creating the container (empty means docker.PullMissing)
+	Rebuild     bool              // Build the image even when hash_tag finds one for unchanged inputs
 
 	// Runtime state
 	state             State        // Current service state
@@ -55,9 +74,12 @@ type Service struct {
 	containerID       string       // Docker container ID (when running)
 	networkID         string       // Network ID the service is connected to
 	startedAt         time.Time    // When the service was started
-	stoppedAt         time.Time    // When the service was stopped
+	stoppedAt         time.Time    // When the service was stopped (or its container exited)
+	exitCode          int          // Exit code of a container that exited on its own
 	lastError         error        // Last error encountered
 	wasAlreadyRunning bool         // True if the container was found already running (not newly started)
+	timings           StartTimings // Phase durations recorded by the last Start
+	builtImage        string       // Tag of the image built from source (build-based services only)
 
 	// Synchronization
 	mu sync.RWMutex // Protects state changes
@@ -87,10 +109,20 @@ func (s *Service) Start(ctx context.Context, client *docker.Client, networkID st
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
-	// Check if already running
+	// Check if already running (or being started, since s.mu is released while waiting on wait_for)
 	if s.state == StateRunning {
 		return fmt.Errorf("service %s is already running", s.Name)
 	}
+	if s.state == StateStarting {
+		return fmt.Errorf("service %s is already starting", s.Name)
+	}
+
+	// Record the total once Start returns, whether it succeeded or not
+	began := time.Now()
+	s.timings = StartTimings{}
+	defer func() {
+		s.timings.Total = time.Since(began)
+	}()
 
 	// Update state to starting
 	s.state = StateStarting
@@ -111,28 +143,72 @@ func (s *Service) Start(ctx context.Context, client *docker.Client, networkID st
 		return nil
 	}
 
+	// Fail fast on a taken host port, before building anything or creating a container
+	if err := docker.CheckPortsAvailable(s.parsePortMappings(), s.Name); err != nil {
+		s.state = StateFailed
+		s.lastError = err
+		return err
+	}
+
 	// Load environment variables
-	envVars, err := config.LoadAllEnvForService(s.Name, s.Config.Env)
+	envVars, err := config.LoadAllEnvForService(s.BaseDir, s.Name, s.Config.Env)
 	if err != nil {
 		s.state = StateFailed
 		s.lastError = fmt.Errorf("failed to load environment variables: %w", err)
 		return s.lastError
 	}
 
+	// Build the image from source for build-based services
+	if s.Config.Build != nil {
+		buildStart := time.Now()
+		tag, err := s.buildImage(ctx, client)
+		s.timings.Build = time.Since(buildStart)
+		if err != nil {
+			s.state = StateFailed
+			s.lastError = fmt.Errorf("failed to build image: %w", err)
+			return s.lastError
+		}
+		s.builtImage = tag
+	}
+
+	// Wait for external dependencies Ork doesn't manage (e.g., a shared database)
+	// The lock is released meanwhile, so status reads (e.g. the start progress) don't block for the whole wait
+	s.mu.Unlock()
+	err = waitForExternal(ctx, s.Config.WaitFor, config.ResolveWaitForTimeout(s.Config))
+	s.mu.Lock()
+	if err != nil {
+		s.state = StateFailed
+		s.lastError = fmt.Errorf("failed waiting for wait_for hosts: %w", err)
+		return s.lastError
+	}
+
+	// Create named volumes up front, labeled with the project, so 'ork down --volumes' only
+	// ever removes this project's volumes (Docker would create them unlabeled)
+	for _, volume := range s.Config.NamedVolumes() {
+		if err := client.EnsureVolume(ctx, volume, s.ProjectName); err != nil {
+			s.state = StateFailed
+			s.lastError = err
+			return err
+		}
+	}
+
 	// Build run options
 	runOpts := s.buildRunOptions(envVars)
 
 	// Start the container
-	containerID, err := client.Run(ctx, runOpts)
+	containerID, runTimings, err := client.RunWithTimings(ctx, runOpts)
+	s.timings.Pull = runTimings.Pull
+	s.timings.Create = runTimings.Create
+	s.timings.Start = runTimings.Start
 	if err != nil {
 		s.state = StateFailed
 		s.lastError = fmt.Errorf("failed to start container: %w", err)
 		return s.lastError
 	}
 
-	// Connect to network if provided
-	if networkID != "" {
-		if err := client.ConnectContainer(ctx, s.ProjectName, containerID); err != nil {
+	// Connect to the project network if provided (host and none networking replace it)
+	if networkID != "" && s.Config.UsesProjectNetwork() {
+		if err := client.ConnectContainer(ctx, s.ProjectName, containerID, s.Config.NetworkAliases(s.Name)); err != nil {
 			// Non-fatal - log but continue
 			fmt.Printf("⚠️  Warning: failed to connect %s to network: %v\n", s.Name, err)
 		}
@@ -140,7 +216,9 @@ func (s *Service) Start(ctx context.Context, client *docker.Client, networkID st
 
 	// Update state
 	s.containerID = containerID
-	s.networkID = networkID
+	if s.Config.UsesProjectNetwork() {
+		s.networkID = networkID
+	}
 	s.startedAt = time.Now()
 	s.state = StateRunning
 	s.healthStatus = HealthUnknown // Will be checked later
@@ -166,8 +244,12 @@ func (s *Service) Stop(ctx context.Context, client *docker.Client) error {
 	// Update state to stopping
 	s.state = StateStopping
 
-	// Stop and remove the container
-	if err := client.StopAndRemove(ctx, s.containerID); err != nil {
+	// Stop and remove the container, giving it the configured time to shut down gracefully
+	stopOpts := docker.StopOptions{
+		Timeout:     config.ResolveStopTimeout(nil, s.Config),
+		GracePeriod: config.ResolveStopGracePeriod(nil, s.Config),
+	}
+	if err := client.StopAndRemove(ctx, s.containerID, stopOpts); err != nil {
 		s.state = StateFailed
 		s.lastError = fmt.Errorf("failed to stop container: %w", err)
 		return s.lastError
@@ -191,6 +273,15 @@ func (s *Service) Restart(ctx context.Context, client *docker.Client, networkID
 		}
 	}
 
+	// Re-ensure the project network - it may have been removed between stop and start
+	if networkID != "" {
+		ensuredID, err := client.EnsureNetwork(ctx, s.ProjectName)
+		if err != nil {
+			return fmt.Errorf("failed to ensure network during restart: %w", err)
+		}
+		networkID = ensuredID
+	}
+
 	// Start the service
 	if err := s.Start(ctx, client, networkID); err != nil {
 		return fmt.Errorf("failed to start service during restart: %w", err)
@@ -238,6 +329,14 @@ func (s *Service) GetLastError() error {
 	return s.lastError
 }
 
+// GetExitCode returns the exit code of a container that exited on its own
+// Only meaningful in StateExited
+func (s *Service) GetExitCode() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.exitCode
+}
+
 // GetUptime returns how long the service has been running
 func (s *Service) GetUptime() time.Duration {
 	s.mu.RLock()
@@ -250,6 +349,21 @@ func (s *Service) GetUptime() time.Duration {
 	return time.Since(s.startedAt)
 }
 
+// GetTimings returns the phase durations recorded while starting the service
+func (s *Service) GetTimings() StartTimings {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.timings
+}
+
+// recordHealthWait adds the time spent waiting for the service to become healthy
+func (s *Service) recordHealthWait(d time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.timings.HealthWait = d
+	s.timings.Total += d
+}
+
 // IsRunning returns true if the service is currently running
 func (s *Service) IsRunning() bool {
 	return s.GetState() == StateRunning
@@ -267,12 +381,142 @@ func (s *Service) WasAlreadyRunning() bool {
 	return s.wasAlreadyRunning
 }
 
+// ============================================================================
+// Reconciliation
+// ============================================================================
+
+// ExitedUnexpectedly reports whether a container that exited with exitCode crashed, rather than
+// finishing cleanly (0) or being stopped by a signal ('docker stop', 'ork down', Ctrl+C)
+// Used when it's unknown whether a stop was requested, e.g., for a container found by a fresh process
+func ExitedUnexpectedly(exitCode int) bool {
+	switch exitCode {
+	case 0, 128 + int(syscall.SIGINT), 128 + int(syscall.SIGKILL), 128 + int(syscall.SIGTERM):
+		return false
+	}
+	return true
+}
+
+// Reconcile refreshes the service state from its container's actual state in Docker
+// Without a known container ID, the service's container is looked up by its labels
+// A container that stopped without Ork stopping it is marked StateExited
+func (s *Service) Reconcile(ctx context.Context, client *docker.Client) error {
+	containerID := s.GetContainerID()
+	if containerID == "" {
+		found, err := s.findContainerID(ctx, client)
+		if err != nil {
+			return fmt.Errorf("failed to reconcile service %s: %w", s.Name, err)
+		}
+		if found == "" {
+			return nil // No container to compare against
+		}
+		containerID = found
+	}
+
+	details, err := client.Inspect(ctx, containerID)
+	if docker.IsNotFound(err) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		s.applyContainerRemoved()
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to reconcile service %s: %w", s.Name, err)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.containerID = details.ID
+	s.applyContainerState(details)
+	return nil
+}
+
+// findContainerID looks up the ID of the service's container by its project and service labels
+// Returns "" when the client is nil or no container exists
+func (s *Service) findContainerID(ctx context.Context, client *docker.Client) (string, error) {
+	if client == nil {
+		return "", nil
+	}
+
+	containers, err := client.ListByService(ctx, s.ProjectName, s.Name)
+	if err != nil {
+		return "", err
+	}
+	if len(containers) == 0 {
+		return "", nil
+	}
+	return containers[0].ID, nil
+}
+
+// applyContainerState maps a container's inspected state onto the service state
+// Must be called with the lock held
+func (s *Service) applyContainerState(details *docker.ContainerDetails) {
+	if details.Running {
+		s.state = StateRunning
+		if !details.StartedAt.IsZero() {
+			s.startedAt = details.StartedAt
+		}
+		// Only the image's native HEALTHCHECK is visible to Docker; Ork's own checks keep their last result
+		switch details.Health {
+		case "healthy":
+			s.healthStatus = HealthHealthy
+		case "unhealthy":
+			s.healthStatus = HealthUnhealthy
+		case "starting":
+			s.healthStatus = HealthStarting
+		}
+		return
+	}
+
+	// Ork stopped it - the container just hasn't been removed yet
+	if s.state == StateStopping || s.state == StateStopped {
+		s.state = StateStopped
+		return
+	}
+
+	// Without having seen the container run, only a crash counts as unexpected - a clean exit or a
+	// stop signal most likely means it was stopped on purpose, e.g., by an earlier 'ork' invocation
+	sawRunning := s.state == StateRunning || s.state == StateStarting || s.state == StateExited
+	if !sawRunning && !ExitedUnexpectedly(details.ExitCode) {
+		s.state = StateStopped
+		s.healthStatus = HealthUnknown
+		s.stoppedAt = details.FinishedAt
+		return
+	}
+
+	// Nothing asked the container to stop, so it exited on its own
+	s.state = StateExited
+	s.healthStatus = HealthUnknown
+	s.exitCode = details.ExitCode
+	s.stoppedAt = details.FinishedAt
+	s.lastError = fmt.Errorf("container exited unexpectedly with code %d", details.ExitCode)
+}
+
+// applyContainerRemoved records that the service's container no longer exists
+// Must be called with the lock held
+func (s *Service) applyContainerRemoved() {
+	s.containerID = ""
+	s.healthStatus = HealthUnknown
+
+	if s.state == StateStopping || s.state == StateStopped {
+		s.state = StateStopped
+		return
+	}
+
+	// Removed behind Ork's back (e.g., 'docker rm -f'), so it's gone without a clean stop
+	if s.state == StateRunning || s.state == StateStarting {
+		s.state = StateExited
+		s.stoppedAt = time.Now()
+		s.lastError = fmt.Errorf("container was removed outside of ork")
+	}
+}
+
 // ============================================================================
 // Health Check Methods
 // ============================================================================
 
 // CheckHealth performs a health check on the service
-func (s *Service) CheckHealth(ctx context.Context) error {
+// The client is used for command-based checks, which run inside the container
+func (s *Service) CheckHealth(ctx context.Context, client *docker.Client) error {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
@@ -281,48 +525,109 @@ func (s *Service) CheckHealth(ctx context.Context) error {
 		return fmt.Errorf("service %s is not running", s.Name)
 	}
 
-	// If no health check is configured, assume healthy
-	if s.Config.Health == nil {
-		s.healthStatus = HealthHealthy
-		return nil
+	// Run the configured check type
+	var err error
+	switch s.Config.Health.Kind() {
+	case config.HealthCheckCommand:
+		err = s.performCommandHealthCheck(ctx, client)
+	case config.HealthCheckTCP:
+		err = s.performTCPHealthCheck(ctx)
+	case config.HealthCheckHTTP:
+		err = s.performHTTPHealthCheck(ctx)
+	default:
+		// No Ork health check configured - optionally defer to the image's native HEALTHCHECK
+		if s.Config.WaitForNativeHealth {
+			return s.performNativeHealthCheck(ctx, client)
+		}
 	}
 
-	// Perform HTTP health check
-	if s.Config.Health.Endpoint != "" {
-		if err := s.performHTTPHealthCheck(ctx); err != nil {
-			s.healthStatus = HealthUnhealthy
-			return err
-		}
+	if err != nil {
+		s.healthStatus = HealthUnhealthy
+		return err
+	}
+	s.healthStatus = HealthHealthy
+	return nil
+}
+
+// performNativeHealthCheck inspects the container and maps Docker's native health status
+// Images without a HEALTHCHECK have nothing to wait on and are considered healthy
+func (s *Service) performNativeHealthCheck(ctx context.Context, client *docker.Client) error {
+	if client == nil {
+		return fmt.Errorf("native health check requires a Docker client")
+	}
+
+	details, err := client.Inspect(ctx, s.containerID)
+	if err != nil {
+		s.healthStatus = HealthUnhealthy
+		return fmt.Errorf("failed to read native health status: %w", err)
+	}
+
+	switch details.Health {
+	case "", "healthy":
 		s.healthStatus = HealthHealthy
 		return nil
+	case "starting":
+		s.healthStatus = HealthStarting
+		return fmt.Errorf("native health check is still starting")
+	default:
+		s.healthStatus = HealthUnhealthy
+		return fmt.Errorf("native health check reports %s", details.Health)
 	}
+}
+
+// NeedsHealthWait returns true if starting this service should wait for it to become healthy
+func (s *Service) NeedsHealthWait() bool {
+	if s.Config.Health.Disabled() {
+		return false
+	}
+	return s.Config.Health != nil || s.Config.WaitForNativeHealth
+}
+
+// performCommandHealthCheck runs the health check command inside the container
+// The service is healthy if the command exits with code 0
+func (s *Service) performCommandHealthCheck(ctx context.Context, client *docker.Client) error {
+	if client == nil {
+		return fmt.Errorf("command health check requires a Docker client")
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, s.healthCheckTimeout())
+	defer cancel()
+
+	exitCode, err := client.ExecExitCode(ctx, s.containerID, s.Config.Health.Command)
+	if err != nil {
+		return fmt.Errorf("health check command failed: %w", err)
+	}
+	if exitCode != 0 {
+		return fmt.Errorf("health check command exited with code %d", exitCode)
+	}
+
+	return nil
+}
+
+// performTCPHealthCheck dials the first host port and considers a successful connection healthy
+func (s *Service) performTCPHealthCheck(ctx context.Context) error {
+	dialer := net.Dialer{Timeout: s.healthCheckTimeout()}
+
+	address := net.JoinHostPort("localhost", s.getFirstPort())
+	conn, err := dialer.DialContext(ctx, "tcp", address)
+	if err != nil {
+		return fmt.Errorf("tcp health check failed: %w", err)
+	}
+	_ = conn.Close()
 
-	// No health check configured
-	s.healthStatus = HealthHealthy
 	return nil
 }
 
 // performHTTPHealthCheck performs an HTTP health check
 func (s *Service) performHTTPHealthCheck(ctx context.Context) error {
-	// Parse timeout (default to 3 seconds)
-	timeout := 3 * time.Second
-	if s.Config.Health.Timeout != "" {
-		if d, err := time.ParseDuration(s.Config.Health.Timeout); err == nil {
-			timeout = d
-		}
-	}
+	timeout := s.healthCheckTimeout()
 
 	// Create HTTP client with timeout
 	client := &http.Client{
 		Timeout: timeout,
 	}
 
-	// Build health check URL
-	// Use localhost since we're checking from the host
-	url := fmt.Sprintf("http://localhost:%s%s", s.getFirstPort(), s.Config.Health.Endpoint)
-
-	// Create request
-	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	req, err := s.newHealthCheckRequest(ctx)
 	if err != nil {
 		return fmt.Errorf("failed to create health check request: %w", err)
 	}
@@ -356,16 +661,49 @@ func (s *Service) performHTTPHealthCheck(ctx context.Context) error {
 	return fmt.Errorf("health check failed after %d retries: %w", retries, lastErr)
 }
 
+// newHealthCheckRequest builds the HTTP health check request, with the configured method (default GET) and headers
+func (s *Service) newHealthCheckRequest(ctx context.Context) (*http.Request, error) {
+	method := http.MethodGet
+	if s.Config.Health.Method != "" {
+		method = strings.ToUpper(s.Config.Health.Method)
+	}
+
+	// Use localhost since we're checking from the host
+	url := fmt.Sprintf("http://localhost:%s%s", s.getFirstPort(), s.Config.Health.Endpoint)
+
+	req, err := http.NewRequestWithContext(ctx, method, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	for name, value := range s.Config.Health.Headers {
+		req.Header.Set(name, value)
+	}
+
+	return req, nil
+}
+
+// healthCheckTimeout returns the configured health check timeout (default to 3 seconds)
+func (s *Service) healthCheckTimeout() time.Duration {
+	timeout := 3 * time.Second
+	if s.Config.Health.Timeout != "" {
+		if d, err := time.ParseDuration(s.Config.Health.Timeout); err == nil {
+			timeout = d
+		}
+	}
+	return timeout
+}
+
 // getFirstPort extracts the first host port from the service configuration
 func (s *Service) getFirstPort() string {
 	if len(s.Config.Ports) == 0 {
 		return "80" // Default port
 	}
 
-	// Parse port mapping like "8080:80"
+	// Parse port mapping like "8080:80" (or "3000-3005:3000-3005", using the start of the range)
 	parts := strings.Split(s.Config.Ports[0], ":")
 	if len(parts) >= 1 {
-		return parts[0]
+		hostPort, _, _ := strings.Cut(parts[0], "-")
+		return hostPort
 	}
 
 	return "80"
@@ -377,71 +715,275 @@ func (s *Service) getFirstPort() string {
 
 // checkAndCleanupExistingContainer checks if a container for this service already exists
 func (s *Service) checkAndCleanupExistingContainer(ctx context.Context, client *docker.Client) error {
-	containers, err := client.List(ctx, s.ProjectName)
+	containers, err := client.ListByService(ctx, s.ProjectName, s.Name)
 	if err != nil {
 		return fmt.Errorf("failed to check existing containers: %w", err)
 	}
 
 	for _, container := range containers {
-		if container.Labels["ork.service"] == s.Name {
-			// Check if it's running
-			if strings.HasPrefix(container.Status, "Up") {
-				// Update our state to match reality - service is already running
-				s.containerID = container.ID
-				s.state = StateRunning
-				s.startedAt = time.Now()   // Approximate start time
-				s.wasAlreadyRunning = true // Mark as already running (not newly started)
-				// Return nil (success) - the service is already in the desired state
-				return nil
-			}
+		// Check if it's running
+		if strings.HasPrefix(container.Status, "Up") {
+			// Update our state to match reality - service is already running
+			s.containerID = container.ID
+			s.state = StateRunning
+			s.startedAt = time.Now()   // Approximate start time
+			s.wasAlreadyRunning = true // Mark as already running (not newly started)
+			// Return nil (success) - the service is already in the desired state
+			return nil
+		}
 
-			// Container exists but is stopped - remove it
-			if err := client.Remove(ctx, container.ID); err != nil {
-				return fmt.Errorf("failed to remove stopped container: %w", err)
-			}
+		// Container exists but is stopped - remove it
+		if err := client.Remove(ctx, container.ID); err != nil {
+			return fmt.Errorf("failed to remove stopped container: %w", err)
 		}
 	}
 
 	return nil
 }
 
+// buildImage builds the service's image from its build section and returns the image tag
+func (s *Service) buildImage(ctx context.Context, client *docker.Client) (string, error) {
+	buildKit, err := client.BuildKitAvailable(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	opts := BuildOptionsFor(s.ProjectName, s.Name, s.BaseDir, s.Config)
+	opts.BuildKit = buildKit
+	opts.Rebuild = s.Rebuild
+	return client.Build(ctx, opts)
+}
+
+// BuildOptionsFor converts a service's build section into Docker build options
+// A relative build context is resolved against baseDir (the directory containing ork.yml)
+// The service must have a build section
+func BuildOptionsFor(projectName, serviceName, baseDir string, cfg config.Service) docker.BuildOptions {
+	buildContext := cfg.Build.Context
+	if baseDir != "" && !filepath.IsAbs(buildContext) {
+		buildContext = filepath.Join(baseDir, buildContext)
+	}
+
+	opts := docker.BuildOptions{
+		Context:    buildContext,
+		Dockerfile: cfg.Build.Dockerfile,
+		Tag:        docker.ImageTag(projectName, serviceName),
+		Args:       cfg.Build.Args,
+		CacheFrom:  cfg.Build.CacheFrom,
+		HashTag:    cfg.Build.HashTag,
+	}
+
+	if cfg.Build.Target != nil {
+		opts.Target = *cfg.Build.Target
+	}
+
+	return opts
+}
+
+// HostPorts returns the host ports a service publishes (e.g., "8080", "5353/udp"), sorted
+// Ranges are expanded and malformed mappings skipped, exactly as when the container is created
+func HostPorts(cfg config.Service) []string {
+	mappings := (&Service{Config: cfg}).parsePortMappings()
+
+	ports := make([]string, 0, len(mappings))
+	for hostPort := range mappings {
+		ports = append(ports, hostPort)
+	}
+	sort.Strings(ports)
+	return ports
+}
+
+// image returns the image to run - the locally built tag for build-based services
+// Before a build has run, build-based services use the deterministic tag it will produce
+func (s *Service) image() string {
+	if s.builtImage != "" {
+		return s.builtImage
+	}
+	if s.Config.Build != nil {
+		return docker.ImageTag(s.ProjectName, s.Name)
+	}
+	return s.Config.Image
+}
+
 // buildRunOptions constructs Docker run options from the service configuration
 func (s *Service) buildRunOptions(envVars map[string]string) docker.RunOptions {
+	// The validator has already rejected malformed values, so parse errors fall back to the defaults
+	restart, _ := config.ParseRestartPolicy(s.Config.Restart)
+	nanoCPUs, _ := s.Config.Resources.NanoCPUs()
+	memoryBytes, _ := s.Config.Resources.MemoryBytes()
+
+	// Bridge is Docker's default, and the project network is joined after the container starts
+	networkMode := ""
+	if !s.Config.UsesProjectNetwork() {
+		networkMode = s.Config.NetworkMode
+	}
+
+	// A built image only exists locally, so pulling its tag would fail or fetch an unrelated image
+	pullPolicy := s.PullPolicy
+	if s.Config.Build != nil {
+		pullPolicy = docker.PullNever
+	}
+
 	return docker.RunOptions{
 		Name:       fmt.Sprintf("ork-%s-%s", s.ProjectName, s.Name),
-		Image:      s.Config.Image,
+		Image:      s.image(),
 		Ports:      s.parsePortMappings(),
 		Env:        envVars,
 		Labels:     s.buildLabels(),
 		Command:    s.Config.Command,
 		Entrypoint: s.Config.Entrypoint,
+		Volumes:    s.Config.Volumes,
+
+		DisableHealthcheck: s.Config.Health.Disabled(),
+		RestartPolicy:      restart.Name,
+		RestartMaxRetries:  restart.MaxRetries,
+		NanoCPUs:           nanoCPUs,
+		MemoryBytes:        memoryBytes,
+		NetworkMode:        networkMode,
+		PullPolicy:         pullPolicy,
 	}
 }
 
+// planRunOptions returns the run options Start would use, without touching Docker
+func (s *Service) planRunOptions() (docker.RunOptions, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	envVars, err := config.LoadAllEnvForService(s.BaseDir, s.Name, s.Config.Env)
+	if err != nil {
+		return docker.RunOptions{}, fmt.Errorf("failed to load environment variables: %w", err)
+	}
+
+	return s.buildRunOptions(envVars), nil
+}
+
 // parsePortMappings converts port strings like "8080:80" to map["8080"]="80"
+// An optional protocol suffix ("8080:80/udp") is kept on both sides, so udp and tcp
+// bindings for the same host port don't collide; "/tcp" is the default and is dropped.
+// Contiguous ranges ("3000-3002:4000-4002") are expanded into individual bindings.
+// Mappings that don't fit these forms are skipped.
 func (s *Service) parsePortMappings() map[string]string {
 	ports := make(map[string]string)
 
 	for _, mapping := range s.Config.Ports {
 		// Split "8080:80" into ["8080", "80"]
 		parts := strings.Split(mapping, ":")
-		if len(parts) == 2 {
-			hostPort := parts[0]
-			containerPort := parts[1]
-			ports[hostPort] = containerPort
+		if len(parts) != 2 {
+			continue
+		}
+
+		containerSpec, protocol, hasProtocol := strings.Cut(parts[1], "/")
+		suffix := ""
+		if hasProtocol {
+			switch protocol {
+			case "tcp":
+			case "udp":
+				suffix = "/udp"
+			default:
+				continue // Unsupported protocol
+			}
+		}
+
+		hostPorts, containerPorts, ok := expandPortRange(parts[0], containerSpec)
+		if !ok {
+			continue
+		}
+		for i, hostPort := range hostPorts {
+			ports[hostPort+suffix] = containerPorts[i] + suffix
 		}
 	}
 
 	return ports
 }
 
-// buildLabels creates standard Ork labels for container tracking
+// expandPortRange expands matching host and container ranges ("3000-3002", "4000-4002")
+// into individual ports. Single ports are returned unchanged.
+// Reports false if only one side is a range or the ranges differ in size.
+func expandPortRange(hostSpec, containerSpec string) (hostPorts, containerPorts []string, ok bool) {
+	hostStart, hostEnd, hostIsRange := parsePortRange(hostSpec)
+	containerStart, containerEnd, containerIsRange := parsePortRange(containerSpec)
+
+	if !hostIsRange && !containerIsRange {
+		return []string{hostSpec}, []string{containerSpec}, true
+	}
+	if !hostIsRange || !containerIsRange || hostEnd-hostStart != containerEnd-containerStart {
+		return nil, nil, false
+	}
+
+	for offset := 0; offset <= hostEnd-hostStart; offset++ {
+		hostPorts = append(hostPorts, strconv.Itoa(hostStart+offset))
+		containerPorts = append(containerPorts, strconv.Itoa(containerStart+offset))
+	}
+	return hostPorts, containerPorts, true
+}
+
+// parsePortRange parses a "start-end" port range
+// Reports false if spec is not a valid ascending range
+func parsePortRange(spec string) (start, end int, isRange bool) {
+	startText, endText, found := strings.Cut(spec, "-")
+	if !found {
+		return 0, 0, false
+	}
+
+	start, startErr := strconv.Atoi(startText)
+	end, endErr := strconv.Atoi(endText)
+	if startErr != nil || endErr != nil || start > end {
+		return 0, 0, false
+	}
+	return start, end, true
+}
+
+// buildLabels creates standard Ork labels for container tracking, on top of the service's custom labels
+// Also records the service config so 'ork restart' can report what changed
 func (s *Service) buildLabels() map[string]string {
-	return map[string]string{
-		"ork.managed": "true",
-		"ork.project": s.ProjectName,
-		"ork.service": s.Name,
+	labels := make(map[string]string, len(s.Config.Labels))
+	for key, value := range s.Config.Labels {
+		labels[key] = value
+	}
+
+	// Ork's own labels are applied last, so they always win over custom ones
+	for key, value := range ConfigLabels(s.Config) {
+		labels[key] = value
 	}
+	labels["ork.managed"] = "true"
+	labels["ork.project"] = s.ProjectName
+	labels["ork.service"] = s.Name
+	return labels
+}
+
+// waitForExternalPollInterval is how long to wait before dialing an unreachable wait_for address again
+const waitForExternalPollInterval = 500 * time.Millisecond
+
+// waitForExternal blocks until every address (host:port) accepts a TCP connection
+// Fails once timeout passes with an address still unreachable, naming it along with the last dial error
+func waitForExternal(ctx context.Context, addresses []string, timeout time.Duration) error {
+	if len(addresses) == 0 {
+		return nil
+	}
+
+	waitCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	dialer := net.Dialer{Timeout: time.Second}
+	for _, address := range addresses {
+		for {
+			conn, err := dialer.DialContext(waitCtx, "tcp", address)
+			if err == nil {
+				_ = conn.Close()
+				break
+			}
+
+			select {
+			case <-waitCtx.Done():
+				if ctx.Err() != nil {
+					return ctx.Err()
+				}
+				return fmt.Errorf("%s did not accept connections within %s: %w", address, timeout, err)
+			case <-time.After(waitForExternalPollInterval):
+			}
+		}
+	}
+
+	return nil
 }
 
 // ============================================================================
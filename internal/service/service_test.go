@@ -1,11 +1,24 @@
 package service
 
 import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/ork-cli/ork/internal/config"
+	"github.com/ork-cli/ork/internal/docker"
+	"github.com/ork-cli/ork/internal/docker/dockertest"
+	"github.com/ork-cli/ork/pkg/utils"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 // ============================================================================
@@ -301,6 +314,46 @@ func TestService_parsePortMappings(t *testing.T) {
 			ports: []string{"abc:def", "8080:80"},
 			want:  map[string]string{"abc": "def", "8080": "80"},
 		},
+		{
+			name:  "udp protocol suffix",
+			ports: []string{"8080:80/udp"},
+			want:  map[string]string{"8080/udp": "80/udp"},
+		},
+		{
+			name:  "explicit tcp is the default",
+			ports: []string{"8080:80/tcp"},
+			want:  map[string]string{"8080": "80"},
+		},
+		{
+			name:  "same port over tcp and udp",
+			ports: []string{"53:53/tcp", "53:53/udp"},
+			want:  map[string]string{"53": "53", "53/udp": "53/udp"},
+		},
+		{
+			name:  "unsupported protocol is skipped",
+			ports: []string{"8080:80/sctp", "9000:90"},
+			want:  map[string]string{"9000": "90"},
+		},
+		{
+			name:  "port range is expanded",
+			ports: []string{"3000-3002:4000-4002"},
+			want:  map[string]string{"3000": "4000", "3001": "4001", "3002": "4002"},
+		},
+		{
+			name:  "udp port range",
+			ports: []string{"6000-6001:6000-6001/udp"},
+			want:  map[string]string{"6000/udp": "6000/udp", "6001/udp": "6001/udp"},
+		},
+		{
+			name:  "mismatched range sizes are skipped",
+			ports: []string{"3000-3005:3000-3002", "9000:90"},
+			want:  map[string]string{"9000": "90"},
+		},
+		{
+			name:  "range on one side only is skipped",
+			ports: []string{"3000-3002:3000", "9000:90"},
+			want:  map[string]string{"9000": "90"},
+		},
 		{
 			name:  "mixed valid and invalid mappings",
 			ports: []string{"8080:80", "invalid", "9000:90", "", "3000:3000"},
@@ -317,6 +370,13 @@ func TestService_parsePortMappings(t *testing.T) {
 	}
 }
 
+func TestHostPorts(t *testing.T) {
+	ports := HostPorts(config.Service{Ports: []string{"9090:90", "3000-3001:4000-4001", "5353:53/udp", "bad"}})
+
+	assert.Equal(t, []string{"3000", "3001", "5353/udp", "9090"}, ports)
+	assert.Empty(t, HostPorts(config.Service{}))
+}
+
 func TestService_buildLabels(t *testing.T) {
 	service := New("api", "myproject", config.Service{Image: "nginx:alpine"})
 	labels := service.buildLabels()
@@ -326,6 +386,24 @@ func TestService_buildLabels(t *testing.T) {
 	assert.Equal(t, "api", labels["ork.service"])
 }
 
+func TestService_buildLabels_MergesCustomLabels(t *testing.T) {
+	service := New("api", "myproject", config.Service{
+		Image: "nginx:alpine",
+		Labels: map[string]string{
+			"traefik.enable":        "true",
+			"com.example.cost-team": "payments",
+			"ork.project":           "spoofed", // Rejected by validation, but must never win
+		},
+	})
+	labels := service.buildLabels()
+
+	assert.Equal(t, "true", labels["traefik.enable"])
+	assert.Equal(t, "payments", labels["com.example.cost-team"])
+	assert.Equal(t, "myproject", labels["ork.project"], "ork labels take precedence over custom ones")
+	assert.Equal(t, "true", labels["ork.managed"])
+	assert.Equal(t, "api", labels["ork.service"])
+}
+
 func TestService_getFirstPort(t *testing.T) {
 	tests := []struct {
 		name  string
@@ -352,6 +430,11 @@ func TestService_getFirstPort(t *testing.T) {
 			ports: []string{"8080"},
 			want:  "8080",
 		},
+		{
+			name:  "port range returns its start",
+			ports: []string{"3000-3005:3000-3005"},
+			want:  "3000",
+		},
 	}
 
 	for _, tt := range tests {
@@ -373,6 +456,7 @@ func TestService_buildRunOptions(t *testing.T) {
 		Ports:      []string{"8080:80"},
 		Command:    []string{"nginx", "-g", "daemon off;"},
 		Entrypoint: []string{"/bin/sh"},
+		Volumes:    []string{"/srv/data:/data:ro", "pgdata:/var/lib/postgresql/data"},
 	})
 
 	envVars := map[string]string{
@@ -388,9 +472,63 @@ func TestService_buildRunOptions(t *testing.T) {
 	assert.Equal(t, envVars, opts.Env)
 	assert.Equal(t, []string{"nginx", "-g", "daemon off;"}, opts.Command)
 	assert.Equal(t, []string{"/bin/sh"}, opts.Entrypoint)
+	assert.Equal(t, []string{"/srv/data:/data:ro", "pgdata:/var/lib/postgresql/data"}, opts.Volumes)
 	assert.Equal(t, "true", opts.Labels["ork.managed"])
 	assert.Equal(t, "myproject", opts.Labels["ork.project"])
 	assert.Equal(t, "api", opts.Labels["ork.service"])
+	assert.Equal(t, "no", opts.RestartPolicy, "restart policy should default to no")
+}
+
+func TestService_buildRunOptions_RestartPolicy(t *testing.T) {
+	service := New("api", "myproject", config.Service{Image: "nginx:alpine", Restart: "on-failure:3"})
+
+	opts := service.buildRunOptions(nil)
+
+	assert.Equal(t, "on-failure", opts.RestartPolicy)
+	assert.Equal(t, 3, opts.RestartMaxRetries)
+}
+
+func TestService_buildRunOptions_Resources(t *testing.T) {
+	service := New("api", "myproject", config.Service{
+		Image:     "nginx:alpine",
+		Resources: &config.Resources{CPUs: "0.5", Memory: "1g"},
+	})
+
+	opts := service.buildRunOptions(nil)
+
+	assert.Equal(t, int64(500_000_000), opts.NanoCPUs)
+	assert.Equal(t, int64(1024*1024*1024), opts.MemoryBytes)
+}
+
+func TestService_buildRunOptions_NetworkMode(t *testing.T) {
+	tests := []struct {
+		mode     string
+		expected string
+	}{
+		{"", ""},
+		{config.NetworkModeBridge, ""},
+		{config.NetworkModeHost, "host"},
+		{config.NetworkModeNone, "none"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.mode, func(t *testing.T) {
+			service := New("api", "myproject", config.Service{Image: "nginx:alpine", NetworkMode: tt.mode})
+
+			assert.Equal(t, tt.expected, service.buildRunOptions(nil).NetworkMode)
+		})
+	}
+}
+
+func TestService_buildRunOptions_PullPolicy(t *testing.T) {
+	image := New("api", "myproject", config.Service{Image: "nginx:alpine"})
+	image.PullPolicy = docker.PullAlways
+	assert.Equal(t, docker.PullAlways, image.buildRunOptions(nil).PullPolicy)
+
+	// The built tag only exists locally, so it's never pulled
+	built := New("api", "myproject", config.Service{Build: &config.Build{Context: "."}})
+	built.PullPolicy = docker.PullAlways
+	assert.Equal(t, docker.PullNever, built.buildRunOptions(nil).PullPolicy)
 }
 
 // ============================================================================
@@ -485,7 +623,7 @@ func TestService_CheckHealth_NotRunning(t *testing.T) {
 	})
 
 	// Service is not running, health check should fail
-	err := service.CheckHealth(nil)
+	err := service.CheckHealth(nil, nil)
 	assert.Error(t, err)
 	if err != nil {
 		assert.Contains(t, err.Error(), "not running")
@@ -510,3 +648,773 @@ func TestService_GetLastError(t *testing.T) {
 
 	assert.Equal(t, expectedErr, service.GetLastError())
 }
+
+// ============================================================================
+// Lifecycle Tests (against a fake Docker daemon)
+// ============================================================================
+
+func TestService_Restart_RecreatesMissingNetwork(t *testing.T) {
+	t.Chdir(t.TempDir()) // Keep .env lookups away from the repository
+	fake, client := dockertest.NewServer(t)
+
+	service := New("api", "myproject", config.Service{Image: "nginx:alpine"})
+
+	// Simulate a running service whose network was removed during teardown
+	service.mu.Lock()
+	service.state = StateRunning
+	service.containerID = "0123456789abcdef"
+	service.mu.Unlock()
+
+	err := service.Restart(context.Background(), client, "stale-network-id")
+	require.NoError(t, err)
+
+	assert.True(t, fake.HasRequest("POST /networks/create"), "expected the network to be recreated")
+	assert.True(t, fake.HasRequest("POST /networks/"+fake.NetworkID("ork-myproject-network")+"/connect"))
+	assert.Equal(t, StateRunning, service.GetState())
+	assert.Equal(t, fake.NetworkID("ork-myproject-network"), service.networkID)
+}
+
+func TestService_Restart_ReusesExistingNetwork(t *testing.T) {
+	t.Chdir(t.TempDir())
+	fake, client := dockertest.NewServer(t)
+	fake.AddNetwork("ork-myproject-network", "existing-network-id")
+
+	service := New("api", "myproject", config.Service{Image: "nginx:alpine"})
+
+	err := service.Restart(context.Background(), client, "existing-network-id")
+	require.NoError(t, err)
+
+	assert.False(t, fake.HasRequest("POST /networks/create"), "expected the existing network to be reused")
+	assert.Equal(t, "existing-network-id", service.networkID)
+}
+
+func TestService_Restart_WithoutNetwork(t *testing.T) {
+	t.Chdir(t.TempDir())
+	fake, client := dockertest.NewServer(t)
+
+	service := New("api", "myproject", config.Service{Image: "nginx:alpine"})
+
+	err := service.Restart(context.Background(), client, "")
+	require.NoError(t, err)
+
+	assert.False(t, fake.HasRequest("POST /networks/create"))
+	assert.False(t, fake.HasRequest("GET /networks"))
+}
+
+func TestService_Start_ConnectsBridgeToProjectNetwork(t *testing.T) {
+	t.Chdir(t.TempDir())
+	fake, client := dockertest.NewServer(t)
+	fake.AddNetwork("ork-myproject-network", "project-network-id")
+
+	service := New("api", "myproject", config.Service{Image: "nginx:alpine"})
+	require.NoError(t, service.Start(context.Background(), client, "project-network-id"))
+
+	assert.True(t, fake.HasRequest("POST /networks/project-network-id/connect"))
+	assert.Equal(t, "project-network-id", service.networkID)
+	assert.Equal(t, []string{"api"}, fake.NetworkAliases(service.GetContainerID()), "the service name is an implicit alias")
+}
+
+func TestService_Start_ConnectsWithAliases(t *testing.T) {
+	t.Chdir(t.TempDir())
+	fake, client := dockertest.NewServer(t)
+	fake.AddNetwork("ork-myproject-network", "project-network-id")
+
+	service := New("postgres", "myproject", config.Service{Image: "postgres:15", Aliases: []string{"db", "postgres", "db.internal"}})
+	require.NoError(t, service.Start(context.Background(), client, "project-network-id"))
+
+	assert.Equal(t, []string{"postgres", "db", "db.internal"}, fake.NetworkAliases(service.GetContainerID()))
+}
+
+func TestService_Start_CreatesLabeledNamedVolumes(t *testing.T) {
+	t.Chdir(t.TempDir())
+	fake, client := dockertest.NewServer(t)
+	fake.AddVolume("shared", map[string]string{"ork.project": "blog"})
+
+	service := New("db", "myproject", config.Service{
+		Image:   "postgres:15",
+		Volumes: []string{"pgdata:/var/lib/postgresql/data", "shared:/shared", "./init:/docker-entrypoint-initdb.d"},
+	})
+	require.NoError(t, service.Start(context.Background(), client, ""))
+
+	assert.Equal(t, "myproject", fake.VolumeLabels("pgdata")["ork.project"])
+	assert.Equal(t, "blog", fake.VolumeLabels("shared")["ork.project"], "an existing volume is left as it is")
+	assert.Equal(t, 1, fake.RequestCount("POST /volumes/create"), "host paths aren't volumes")
+}
+
+func TestService_Start_HostAndNoneSkipProjectNetwork(t *testing.T) {
+	for _, mode := range []string{config.NetworkModeHost, config.NetworkModeNone} {
+		t.Run(mode, func(t *testing.T) {
+			t.Chdir(t.TempDir())
+			fake, client := dockertest.NewServer(t)
+			fake.AddNetwork("ork-myproject-network", "project-network-id")
+
+			service := New("api", "myproject", config.Service{Image: "nginx:alpine", NetworkMode: mode})
+			require.NoError(t, service.Start(context.Background(), client, "project-network-id"))
+
+			assert.False(t, fake.HasRequest("POST /networks/project-network-id/connect"))
+			assert.Empty(t, service.networkID)
+			assert.Equal(t, StateRunning, service.GetState())
+		})
+	}
+}
+
+func TestService_CheckHealth_Command(t *testing.T) {
+	tests := []struct {
+		name        string
+		exitCode    int
+		wantErr     bool
+		wantHealth  HealthStatus
+		errContains string
+	}{
+		{
+			name:       "exit code 0 is healthy",
+			exitCode:   0,
+			wantHealth: HealthHealthy,
+		},
+		{
+			name:        "non-zero exit code is unhealthy",
+			exitCode:    2,
+			wantErr:     true,
+			wantHealth:  HealthUnhealthy,
+			errContains: "exited with code 2",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fake, client := dockertest.NewServer(t)
+			fake.SetExecExitCode(tt.exitCode)
+
+			service := New("postgres", "myproject", config.Service{
+				Image: "postgres:15",
+				Health: &config.HealthCheck{
+					Command: []string{"pg_isready", "-U", "postgres"},
+				},
+			})
+			service.mu.Lock()
+			service.state = StateRunning
+			service.containerID = "0123456789abcdef"
+			service.mu.Unlock()
+
+			err := service.CheckHealth(context.Background(), client)
+			if tt.wantErr {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errContains)
+			} else {
+				require.NoError(t, err)
+			}
+
+			assert.Equal(t, tt.wantHealth, service.GetHealthStatus())
+			assert.Equal(t, [][]string{{"pg_isready", "-U", "postgres"}}, fake.Execs())
+			assert.False(t, fake.HasRequest("GET /health"), "command checks must not hit HTTP")
+		})
+	}
+}
+
+func TestService_CheckHealth_CommandWithoutClient(t *testing.T) {
+	service := New("postgres", "myproject", config.Service{
+		Image:  "postgres:15",
+		Health: &config.HealthCheck{Command: []string{"pg_isready"}},
+	})
+	service.mu.Lock()
+	service.state = StateRunning
+	service.mu.Unlock()
+
+	err := service.CheckHealth(context.Background(), nil)
+	require.Error(t, err)
+	assert.Equal(t, HealthUnhealthy, service.GetHealthStatus())
+}
+
+func TestService_CheckHealth_Native(t *testing.T) {
+	tests := []struct {
+		name       string
+		status     string
+		wantErr    bool
+		wantHealth HealthStatus
+	}{
+		{name: "healthy", status: "healthy", wantHealth: HealthHealthy},
+		{name: "starting", status: "starting", wantErr: true, wantHealth: HealthStarting},
+		{name: "unhealthy", status: "unhealthy", wantErr: true, wantHealth: HealthUnhealthy},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fake, client := dockertest.NewServer(t)
+			handler, _ := nativeHealthHandler(tt.status)
+			fake.Handle(http.MethodGet, "/containers/0123456789abcdef/json", handler)
+
+			svc := New("db", "myproject", config.Service{Image: "postgres:15", WaitForNativeHealth: true})
+			svc.mu.Lock()
+			svc.state = StateRunning
+			svc.containerID = "0123456789abcdef"
+			svc.mu.Unlock()
+
+			err := svc.CheckHealth(context.Background(), client)
+			assert.Equal(t, tt.wantErr, err != nil)
+			assert.Equal(t, tt.wantHealth, svc.GetHealthStatus())
+		})
+	}
+}
+
+func TestService_CheckHealth_NativeWithoutHealthcheck(t *testing.T) {
+	// Images without a HEALTHCHECK report no health status and are considered healthy
+	fake, client := dockertest.NewServer(t)
+	fake.AddContainer("0123456789abcdef", "myproject", "db", "Up 1 second")
+
+	svc := New("db", "myproject", config.Service{Image: "postgres:15", WaitForNativeHealth: true})
+	svc.mu.Lock()
+	svc.state = StateRunning
+	svc.containerID = "0123456789abcdef"
+	svc.mu.Unlock()
+
+	require.NoError(t, svc.CheckHealth(context.Background(), client))
+	assert.True(t, svc.IsHealthy())
+}
+
+func TestService_ApplyContainerState(t *testing.T) {
+	finished := time.Date(2026, 3, 1, 10, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		state     State
+		details   docker.ContainerDetails
+		wantState State
+		wantExit  int
+	}{
+		{name: "running stays running", state: StateRunning, details: docker.ContainerDetails{Running: true}, wantState: StateRunning},
+		{name: "crash while running", state: StateRunning, details: docker.ContainerDetails{ExitCode: 1, FinishedAt: finished}, wantState: StateExited, wantExit: 1},
+		{name: "clean exit while running", state: StateRunning, details: docker.ContainerDetails{FinishedAt: finished}, wantState: StateExited},
+		{name: "crash while starting", state: StateStarting, details: docker.ContainerDetails{ExitCode: 137}, wantState: StateExited, wantExit: 137},
+		{name: "stopped by ork", state: StateStopping, details: docker.ContainerDetails{ExitCode: 143}, wantState: StateStopped},
+		{name: "already stopped by ork", state: StateStopped, details: docker.ContainerDetails{}, wantState: StateStopped},
+		{name: "restarted after exiting", state: StateExited, details: docker.ContainerDetails{Running: true}, wantState: StateRunning},
+		{name: "unseen clean exit", state: StatePending, details: docker.ContainerDetails{FinishedAt: finished}, wantState: StateStopped},
+		{name: "unseen stop signal", state: StatePending, details: docker.ContainerDetails{ExitCode: 143}, wantState: StateStopped},
+		{name: "unseen crash", state: StatePending, details: docker.ContainerDetails{ExitCode: 1}, wantState: StateExited, wantExit: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := New("api", "myproject", config.Service{Image: "node:18"})
+			svc.mu.Lock()
+			svc.state = tt.state
+			svc.applyContainerState(&tt.details)
+			svc.mu.Unlock()
+
+			assert.Equal(t, tt.wantState, svc.GetState())
+			assert.Equal(t, tt.wantExit, svc.GetExitCode())
+			if tt.wantState == StateExited {
+				assert.ErrorContains(t, svc.GetLastError(), "exited unexpectedly")
+			} else {
+				assert.NoError(t, svc.GetLastError())
+			}
+		})
+	}
+}
+
+func TestExitedUnexpectedly(t *testing.T) {
+	assert.False(t, ExitedUnexpectedly(0))
+	assert.False(t, ExitedUnexpectedly(130), "SIGINT")
+	assert.False(t, ExitedUnexpectedly(137), "SIGKILL")
+	assert.False(t, ExitedUnexpectedly(143), "SIGTERM")
+	assert.True(t, ExitedUnexpectedly(1))
+	assert.True(t, ExitedUnexpectedly(139), "SIGSEGV")
+}
+
+func TestService_Reconcile_MarksCrashedContainerExited(t *testing.T) {
+	fake, client := dockertest.NewServer(t)
+	fake.Handle(http.MethodGet, "/containers/0123456789abcdef/json", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"Id": "0123456789abcdef", "State": {"Status": "exited", "Running": false, "ExitCode": 2, "FinishedAt": "2026-03-01T10:00:00Z"}}`))
+	})
+
+	svc := New("api", "myproject", config.Service{Image: "node:18"})
+	svc.mu.Lock()
+	svc.state = StateRunning
+	svc.containerID = "0123456789abcdef"
+	svc.mu.Unlock()
+
+	require.NoError(t, svc.Reconcile(context.Background(), client))
+	assert.Equal(t, StateExited, svc.GetState())
+	assert.Equal(t, 2, svc.GetExitCode())
+	assert.Zero(t, svc.GetUptime(), "an exited service has no uptime")
+}
+
+func TestService_Reconcile_NoContainer(t *testing.T) {
+	svc := New("api", "myproject", config.Service{Image: "node:18"})
+
+	require.NoError(t, svc.Reconcile(context.Background(), nil))
+	assert.Equal(t, StatePending, svc.GetState())
+}
+
+func TestService_Reconcile_ExternallyStopped(t *testing.T) {
+	fake, client := dockertest.NewServer(t)
+	fake.AddContainer("aaaaaaaaaaaa", "myproject", "api", "Exited (0) 5 seconds ago")
+
+	// A fresh process knows nothing about the container, but remembers the service as running
+	svc := New("api", "myproject", config.Service{Image: "node:18"})
+	svc.mu.Lock()
+	svc.state = StateRunning
+	svc.healthStatus = HealthHealthy
+	svc.mu.Unlock()
+
+	require.NoError(t, svc.Reconcile(context.Background(), client))
+	assert.Equal(t, StateExited, svc.GetState())
+	assert.Equal(t, HealthUnknown, svc.GetHealthStatus())
+	assert.Equal(t, "aaaaaaaaaaaa", svc.GetContainerID(), "the container should be found by its labels")
+	assert.ErrorContains(t, svc.GetLastError(), "exited unexpectedly")
+}
+
+func TestService_Reconcile_StillRunning(t *testing.T) {
+	fake, client := dockertest.NewServer(t)
+	fake.AddContainer("aaaaaaaaaaaa", "myproject", "api", "Up 5 minutes")
+	startedAt := time.Now().Add(-5 * time.Minute).Truncate(time.Second)
+	fake.SetStartedAt("aaaaaaaaaaaa", startedAt)
+
+	svc := New("api", "myproject", config.Service{Image: "node:18"})
+
+	require.NoError(t, svc.Reconcile(context.Background(), client))
+	assert.Equal(t, StateRunning, svc.GetState())
+	assert.Equal(t, "aaaaaaaaaaaa", svc.GetContainerID())
+	assert.True(t, startedAt.Equal(svc.GetStartedAt()), "expected start time %v, got %v", startedAt, svc.GetStartedAt())
+	assert.NoError(t, svc.GetLastError())
+}
+
+func TestService_Reconcile_NativeHealth(t *testing.T) {
+	fake, client := dockertest.NewServer(t)
+	handler, _ := nativeHealthHandler("unhealthy")
+	fake.Handle(http.MethodGet, "/containers/0123456789abcdef/json", handler)
+
+	svc := New("db", "myproject", config.Service{Image: "postgres:15"})
+	svc.mu.Lock()
+	svc.state = StateRunning
+	svc.healthStatus = HealthHealthy
+	svc.containerID = "0123456789abcdef"
+	svc.mu.Unlock()
+
+	require.NoError(t, svc.Reconcile(context.Background(), client))
+	assert.Equal(t, StateRunning, svc.GetState())
+	assert.Equal(t, HealthUnhealthy, svc.GetHealthStatus())
+}
+
+func TestService_Reconcile_ContainerRemoved(t *testing.T) {
+	_, client := dockertest.NewServer(t)
+
+	svc := New("api", "myproject", config.Service{Image: "node:18"})
+	svc.mu.Lock()
+	svc.state = StateRunning
+	svc.containerID = "0123456789abcdef"
+	svc.mu.Unlock()
+
+	require.NoError(t, svc.Reconcile(context.Background(), client))
+	assert.Equal(t, StateExited, svc.GetState())
+	assert.Empty(t, svc.GetContainerID())
+	assert.ErrorContains(t, svc.GetLastError(), "removed outside of ork")
+}
+
+func TestService_NeedsHealthWait(t *testing.T) {
+	assert.False(t, New("a", "p", config.Service{}).NeedsHealthWait())
+	assert.True(t, New("a", "p", config.Service{Health: &config.HealthCheck{Endpoint: "/"}}).NeedsHealthWait())
+	assert.True(t, New("a", "p", config.Service{WaitForNativeHealth: true}).NeedsHealthWait())
+}
+
+func TestService_CheckHealth_TCP(t *testing.T) {
+	// Open port: a listener accepts the connection
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer func() { _ = listener.Close() }()
+	openPort := strconv.Itoa(listener.Addr().(*net.TCPAddr).Port)
+
+	// Closed port: grab a free port, then release it
+	closedListener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	closedPort := strconv.Itoa(closedListener.Addr().(*net.TCPAddr).Port)
+	require.NoError(t, closedListener.Close())
+
+	tests := []struct {
+		name       string
+		port       string
+		wantErr    bool
+		wantHealth HealthStatus
+	}{
+		{name: "open port is healthy", port: openPort, wantHealth: HealthHealthy},
+		{name: "closed port is unhealthy", port: closedPort, wantErr: true, wantHealth: HealthUnhealthy},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service := New("redis", "myproject", config.Service{
+				Image: "redis:7",
+				Ports: []string{tt.port + ":6379"},
+				Health: &config.HealthCheck{
+					Type:    config.HealthCheckTCP,
+					Timeout: "1s",
+				},
+			})
+			service.mu.Lock()
+			service.state = StateRunning
+			service.mu.Unlock()
+
+			err := service.CheckHealth(context.Background(), nil)
+			assert.Equal(t, tt.wantErr, err != nil, "unexpected error state: %v", err)
+			assert.Equal(t, tt.wantHealth, service.GetHealthStatus())
+		})
+	}
+}
+
+func TestService_CheckHealth_HTTPMethodAndHeaders(t *testing.T) {
+	tests := []struct {
+		name       string
+		method     string
+		headers    map[string]string
+		wantMethod string
+	}{
+		{name: "defaults to GET", wantMethod: http.MethodGet},
+		{name: "custom method and header", method: "post", headers: map[string]string{"Authorization": "Bearer dev-token"}, wantMethod: http.MethodPost},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got *http.Request
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				got = r
+			}))
+			defer server.Close()
+			port := server.URL[strings.LastIndex(server.URL, ":")+1:]
+
+			service := New("api", "myproject", config.Service{
+				Image: "node:18",
+				Ports: []string{port + ":3000"},
+				Health: &config.HealthCheck{
+					Endpoint: "/health",
+					Method:   tt.method,
+					Headers:  tt.headers,
+					Timeout:  "1s",
+				},
+			})
+			service.mu.Lock()
+			service.state = StateRunning
+			service.mu.Unlock()
+
+			require.NoError(t, service.CheckHealth(context.Background(), nil))
+			require.NotNil(t, got)
+			assert.Equal(t, tt.wantMethod, got.Method)
+			assert.Equal(t, "/health", got.URL.Path)
+			for name, value := range tt.headers {
+				assert.Equal(t, value, got.Header.Get(name))
+			}
+		})
+	}
+}
+
+func TestService_Start_PortInUse(t *testing.T) {
+	t.Chdir(t.TempDir())
+	fake, client := dockertest.NewServer(t)
+
+	listener, err := net.Listen("tcp", ":0")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = listener.Close() })
+	port := strconv.Itoa(listener.Addr().(*net.TCPAddr).Port)
+
+	service := New("api", "myproject", config.Service{Image: "nginx:alpine", Ports: []string{port + ":80"}})
+
+	err = service.Start(context.Background(), client, "")
+	require.Error(t, err)
+
+	var orkErr *utils.OrkError
+	require.ErrorAs(t, err, &orkErr)
+	assert.Equal(t, "Port "+port+" is already in use", orkErr.Message)
+	assert.Contains(t, orkErr.Details, "Port "+port+" is required by service 'api'")
+
+	assert.False(t, fake.HasRequest("POST /containers/create"), "no container should be created for a taken port")
+	assert.Equal(t, StateFailed, service.GetState())
+}
+
+// ============================================================================
+// Start Timing Tests
+// ============================================================================
+
+func TestService_Start_RecordsPhaseTimings(t *testing.T) {
+	t.Chdir(t.TempDir())
+	fake, client := dockertest.NewServer(t)
+
+	// Make the image check measurably slow so the pull phase is non-trivial
+	fake.Handle(http.MethodGet, "/images/", func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(20 * time.Millisecond)
+		_, _ = w.Write([]byte(`{"Id":"sha256:fake"}`))
+	})
+
+	service := New("api", "myproject", config.Service{Image: "nginx:alpine"})
+	require.NoError(t, service.Start(context.Background(), client, ""))
+
+	timings := service.GetTimings()
+	assert.GreaterOrEqual(t, timings.Pull, 20*time.Millisecond)
+	assert.Positive(t, timings.Create)
+	assert.Positive(t, timings.Start)
+	assert.Zero(t, timings.HealthWait)
+
+	// The phases account for (almost) all of Start - the rest is listing containers and loading env
+	phases := timings.Pull + timings.Create + timings.Start
+	assert.GreaterOrEqual(t, timings.Total, phases)
+	assert.Less(t, timings.Total-phases, 500*time.Millisecond)
+}
+
+func TestService_RecordHealthWait_AddsToTotal(t *testing.T) {
+	service := New("api", "myproject", config.Service{Image: "nginx:alpine"})
+	service.timings = StartTimings{Pull: time.Second, Total: time.Second}
+
+	service.recordHealthWait(2 * time.Second)
+
+	timings := service.GetTimings()
+	assert.Equal(t, 2*time.Second, timings.HealthWait)
+	assert.Equal(t, 3*time.Second, timings.Total)
+}
+
+// ============================================================================
+// Build-from-Source Tests
+// ============================================================================
+
+func TestService_Start_BuildsImageFromSource(t *testing.T) {
+	t.Chdir(t.TempDir())
+	require.NoError(t, os.MkdirAll("api", 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join("api", "Dockerfile"), []byte("FROM alpine\n"), 0o644))
+
+	fake, client := dockertest.NewServer(t)
+	var buildQuery url.Values
+	fake.Handle(http.MethodPost, "/build", func(w http.ResponseWriter, r *http.Request) {
+		buildQuery = r.URL.Query()
+		_, _ = w.Write([]byte(`{"stream":"Successfully built"}` + "\n"))
+	})
+
+	service := New("api", "shop", config.Service{
+		Build: &config.Build{Context: "api", Args: map[string]string{"VERSION": "1.0"}},
+	})
+	require.NoError(t, service.Start(context.Background(), client, ""))
+
+	require.NotNil(t, buildQuery, "expected an image build")
+	assert.Equal(t, []string{"ork-shop-api:latest"}, buildQuery["t"], "the hash tag is opt-in")
+	assert.Contains(t, buildQuery.Get("buildargs"), "VERSION")
+	assert.Equal(t, "ork-shop-api:latest", service.buildRunOptions(nil).Image)
+	assert.Equal(t, StateRunning, service.GetState())
+}
+
+func TestService_Start_HashTagBuildsNewInputs(t *testing.T) {
+	t.Chdir(t.TempDir())
+	require.NoError(t, os.MkdirAll("api", 0o755))
+
+	fake, client := dockertest.NewServer(t)
+	var buildQuery url.Values
+	fake.Handle(http.MethodPost, "/build", func(w http.ResponseWriter, r *http.Request) {
+		buildQuery = r.URL.Query()
+		_, _ = w.Write([]byte(`{"stream":"Successfully built"}` + "\n"))
+	})
+	// No image carries the hash of these inputs yet
+	fake.Handle(http.MethodGet, "/images/ork-shop-api:", func(w http.ResponseWriter, r *http.Request) {
+		if strings.Contains(r.URL.Path, ":latest") {
+			_, _ = w.Write([]byte(`{"Id":"sha256:fake"}`))
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"message":"No such image"}`))
+	})
+
+	service := New("api", "shop", config.Service{Build: &config.Build{Context: "api", HashTag: true}})
+	require.NoError(t, service.Start(context.Background(), client, ""))
+
+	require.NotNil(t, buildQuery, "expected an image build")
+	require.Len(t, buildQuery["t"], 2, "expected the latest tag and a build inputs hash tag")
+	assert.Equal(t, "ork-shop-api:latest", buildQuery["t"][0])
+	assert.Regexp(t, `^ork-shop-api:[0-9a-f]{12}$`, buildQuery["t"][1])
+}
+
+func TestService_Start_HashTagReusesUnchangedInputs(t *testing.T) {
+	t.Chdir(t.TempDir())
+	require.NoError(t, os.MkdirAll("api", 0o755))
+
+	// The fake daemon reports every image as present, including the hash tag
+	fake, client := dockertest.NewServer(t)
+
+	service := New("api", "shop", config.Service{Build: &config.Build{Context: "api", HashTag: true}})
+	require.NoError(t, service.Start(context.Background(), client, ""))
+
+	assert.False(t, fake.HasRequest("POST /build"), "unchanged inputs shouldn't be rebuilt")
+	assert.True(t, fake.HasRequest("POST /images/ork-shop-api:"), "the hash tag is re-tagged as latest")
+	assert.Equal(t, StateRunning, service.GetState())
+}
+
+func TestService_Start_BuildFailure(t *testing.T) {
+	t.Chdir(t.TempDir())
+	require.NoError(t, os.MkdirAll("api", 0o755))
+
+	fake, client := dockertest.NewServer(t)
+	fake.Handle(http.MethodPost, "/build", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"errorDetail":{"message":"Dockerfile not found"}}` + "\n"))
+	})
+
+	service := New("api", "shop", config.Service{Build: &config.Build{Context: "api"}})
+	err := service.Start(context.Background(), client, "")
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to build image")
+	assert.Equal(t, StateFailed, service.GetState())
+	assert.False(t, fake.HasRequest("POST /containers/create"))
+}
+
+func TestBuildOptionsFor(t *testing.T) {
+	target := "dev"
+	opts := BuildOptionsFor("shop", "api", "", config.Service{Build: &config.Build{
+		Context:    "./api",
+		Dockerfile: "Dockerfile.dev",
+		Target:     &target,
+		CacheFrom:  []string{"ghcr.io/org/api:latest"},
+	}})
+
+	assert.Equal(t, "./api", opts.Context)
+	assert.Equal(t, "Dockerfile.dev", opts.Dockerfile)
+	assert.Equal(t, "ork-shop-api:latest", opts.Tag)
+	assert.Equal(t, "dev", opts.Target)
+	assert.Equal(t, []string{"ghcr.io/org/api:latest"}, opts.CacheFrom)
+}
+
+func TestBuildOptionsFor_ResolvesContextAgainstBaseDir(t *testing.T) {
+	baseDir := t.TempDir()
+
+	relative := BuildOptionsFor("shop", "api", baseDir, config.Service{Build: &config.Build{Context: "./api"}})
+	assert.Equal(t, filepath.Join(baseDir, "api"), relative.Context)
+
+	absolute := filepath.Join(t.TempDir(), "api")
+	assert.Equal(t, absolute, BuildOptionsFor("shop", "api", baseDir, config.Service{Build: &config.Build{Context: absolute}}).Context)
+}
+
+// ============================================================================
+// Disabled Health Check Tests
+// ============================================================================
+
+func TestService_DisabledHealthCheck(t *testing.T) {
+	service := New("db", "shop", config.Service{
+		Image:  "postgres:15",
+		Health: &config.HealthCheck{Disable: true},
+	})
+
+	assert.True(t, service.buildRunOptions(nil).DisableHealthcheck)
+	assert.False(t, service.NeedsHealthWait(), "disabled health checks should not be waited on")
+}
+
+func TestService_HealthCheckNotDisabledByDefault(t *testing.T) {
+	service := New("db", "shop", config.Service{Image: "postgres:15"})
+
+	assert.False(t, service.buildRunOptions(nil).DisableHealthcheck)
+}
+
+// ============================================================================
+// External Wait Tests
+// ============================================================================
+
+// closedAddress returns a local host:port that nothing is listening on
+func closedAddress(t *testing.T) string {
+	t.Helper()
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	address := listener.Addr().String()
+	require.NoError(t, listener.Close())
+	return address
+}
+
+func TestWaitForExternal_Reachable(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = listener.Close() })
+
+	err = waitForExternal(context.Background(), []string{listener.Addr().String()}, time.Second)
+
+	assert.NoError(t, err)
+}
+
+func TestWaitForExternal_BecomesReachable(t *testing.T) {
+	address := closedAddress(t)
+	listeners := make(chan net.Listener, 1)
+	go func() {
+		time.Sleep(200 * time.Millisecond)
+		if listener, err := net.Listen("tcp", address); err == nil {
+			listeners <- listener
+		}
+	}()
+
+	err := waitForExternal(context.Background(), []string{address}, 5*time.Second)
+
+	assert.NoError(t, err, "the address is polled until it accepts connections")
+	select {
+	case listener := <-listeners:
+		_ = listener.Close()
+	case <-time.After(time.Second):
+	}
+}
+
+func TestWaitForExternal_TimesOut(t *testing.T) {
+	address := closedAddress(t)
+
+	started := time.Now()
+	err := waitForExternal(context.Background(), []string{address}, 300*time.Millisecond)
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), address+" did not accept connections within 300ms")
+	assert.Less(t, time.Since(started), 2*time.Second)
+}
+
+func TestWaitForExternal_NoAddresses(t *testing.T) {
+	assert.NoError(t, waitForExternal(context.Background(), nil, time.Millisecond))
+}
+
+func TestService_Start_WaitForTimeoutCreatesNothing(t *testing.T) {
+	t.Chdir(t.TempDir())
+	fake, client := dockertest.NewServer(t)
+
+	service := New("api", "myproject", config.Service{
+		Image:          "nginx:alpine",
+		WaitFor:        []string{closedAddress(t)},
+		WaitForTimeout: "300ms",
+	})
+
+	err := service.Start(context.Background(), client, "")
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "wait_for")
+	assert.False(t, fake.HasRequest("POST /containers/create"), "the container only starts once wait_for hosts are reachable")
+	assert.Equal(t, StateFailed, service.GetState())
+}
+
+func TestService_Start_WaitForReleasesLock(t *testing.T) {
+	t.Chdir(t.TempDir())
+	_, client := dockertest.NewServer(t)
+
+	service := New("api", "myproject", config.Service{
+		Image:          "nginx:alpine",
+		WaitFor:        []string{closedAddress(t)},
+		WaitForTimeout: "5s",
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() { done <- service.Start(ctx, client, "") }()
+
+	// Reads must not block behind the wait, and a second start is refused rather than queued
+	require.Eventually(t, func() bool {
+		states := make(chan State, 1)
+		go func() { states <- service.GetState() }()
+		select {
+		case state := <-states:
+			return state == StateStarting
+		case <-time.After(100 * time.Millisecond):
+			return false
+		}
+	}, 2*time.Second, 50*time.Millisecond)
+	assert.ErrorContains(t, service.Start(ctx, client, ""), "already starting")
+
+	cancel()
+	select {
+	case err := <-done:
+		require.ErrorIs(t, err, context.Canceled)
+	case <-time.After(2 * time.Second):
+		t.Fatal("Start did not return after the context was canceled")
+	}
+	assert.Equal(t, StateFailed, service.GetState())
+}
@@ -0,0 +1,22 @@
+package ui
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+// ============================================================================
+// JSON Output - For --json flag
+// ============================================================================
+
+// WriteJSON writes v as indented JSON followed by a newline
+// No styling is applied so the output can be piped into tools like jq
+func WriteJSON(w io.Writer, v any) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(v); err != nil {
+		return fmt.Errorf("failed to encode JSON: %w", err)
+	}
+	return nil
+}
@@ -1,6 +1,8 @@
 package ui
 
 import (
+	"fmt"
+	"hash/fnv"
 	"regexp"
 	"strings"
 
@@ -94,6 +96,56 @@ func detectLogLevel(line string) LogLevel {
 	return LogLevelUnknown
 }
 
+// ============================================================================
+// Log Level Filtering
+// ============================================================================
+
+// ParseLogLevel maps a --level flag value to a LogLevel
+// An empty string returns LogLevelUnknown, which disables filtering
+func ParseLogLevel(s string) (LogLevel, error) {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "":
+		return LogLevelUnknown, nil
+	case "trace":
+		return LogLevelTrace, nil
+	case "debug":
+		return LogLevelDebug, nil
+	case "info":
+		return LogLevelInfo, nil
+	case "warn", "warning":
+		return LogLevelWarn, nil
+	case "error":
+		return LogLevelError, nil
+	default:
+		return LogLevelUnknown, fmt.Errorf("invalid log level %q (expected trace, debug, info, warn, or error)", s)
+	}
+}
+
+// LevelFilter decides which log lines to keep based on their detected level
+type LevelFilter struct {
+	Min         LogLevel // Minimum level to keep (LogLevelUnknown keeps everything)
+	KeepUnknown bool     // Whether lines without a detectable level are kept
+}
+
+// Keep reports whether a log line passes the filter
+func (f LevelFilter) Keep(line string) bool {
+	if f.Min == LogLevelUnknown {
+		return true
+	}
+
+	level := detectLogLevel(line)
+	if level == LogLevelUnknown {
+		return f.KeepUnknown
+	}
+	return level >= f.Min
+}
+
+// FilterByLevel reports whether a log line is at or above the minimum level
+// Lines without a detectable level are always kept
+func FilterByLevel(line string, min LogLevel) (keep bool) {
+	return LevelFilter{Min: min, KeepUnknown: true}.Keep(line)
+}
+
 // ============================================================================
 // Log Formatting Styles
 // ============================================================================
@@ -203,6 +255,40 @@ func FormatLogLine(line string, showTimestamps bool) string {
 	return styledTimestamp + styledContent
 }
 
+// ============================================================================
+// Multi-Service Prefixes
+// ============================================================================
+
+// servicePrefixColors is the palette cycled through for service prefixes (like docker-compose)
+var servicePrefixColors = []lipgloss.Color{
+	ColorSecondary,
+	ColorWarning,
+	ColorSuccess,
+	ColorPrimary,
+	ColorInfo,
+	ColorError,
+}
+
+// FormatLogPrefix formats a colorized "[service]" tag for interleaved logs
+// The tag is padded to width (the longest service name) so log content lines up
+func FormatLogPrefix(serviceName string, width int) string {
+	tag := "[" + serviceName + "]"
+	padding := ""
+	if pad := width - len(serviceName); pad > 0 {
+		padding = strings.Repeat(" ", pad)
+	}
+
+	style := lipgloss.NewStyle().Foreground(servicePrefixColor(serviceName)).Bold(true)
+	return style.Render(tag) + padding + " "
+}
+
+// servicePrefixColor picks a stable color for a service name
+func servicePrefixColor(serviceName string) lipgloss.Color {
+	hash := fnv.New32a()
+	_, _ = hash.Write([]byte(serviceName))
+	return servicePrefixColors[hash.Sum32()%uint32(len(servicePrefixColors))]
+}
+
 // ============================================================================
 // Timestamp Handling
 // ============================================================================
@@ -253,3 +339,8 @@ func stripTimestamp(line string) string {
 func FormatStreamingFooter() string {
 	return StyleDim.Render("\n" + SymbolInfo + " Press Ctrl+C to stop streaming")
 }
+
+// FormatReconnectNotice shows that a followed log stream ended and is re-attaching to the restarted container
+func FormatReconnectNotice(name string) string {
+	return StyleDim.Render(SymbolInfo + " " + name + " restarted, reconnecting…")
+}
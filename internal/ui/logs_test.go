@@ -0,0 +1,104 @@
+package ui
+
+import (
+	"testing"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// ============================================================================
+// Log Level Parsing Tests
+// ============================================================================
+
+func TestParseLogLevel(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected LogLevel
+	}{
+		{"", LogLevelUnknown},
+		{"trace", LogLevelTrace},
+		{"debug", LogLevelDebug},
+		{"info", LogLevelInfo},
+		{"warn", LogLevelWarn},
+		{"warning", LogLevelWarn},
+		{"WARN", LogLevelWarn},
+		{" error ", LogLevelError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			level, err := ParseLogLevel(tt.input)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, level)
+		})
+	}
+}
+
+func TestParseLogLevel_Invalid(t *testing.T) {
+	_, err := ParseLogLevel("loud")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "loud")
+}
+
+// ============================================================================
+// Level Filter Tests
+// ============================================================================
+
+func TestFilterByLevel(t *testing.T) {
+	tests := []struct {
+		name     string
+		line     string
+		min      LogLevel
+		expected bool
+	}{
+		{"error above warn", "ERROR: connection refused", LogLevelWarn, true},
+		{"warn at warn", "[WARN] disk almost full", LogLevelWarn, true},
+		{"info below warn", `level=info msg="listening"`, LogLevelWarn, false},
+		{"debug below info", `{"level":"debug","msg":"tick"}`, LogLevelInfo, false},
+		{"unknown kept", "GET /health 200", LogLevelError, true},
+		{"no threshold keeps all", "DEBUG noisy", LogLevelUnknown, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, FilterByLevel(tt.line, tt.min))
+		})
+	}
+}
+
+func TestLevelFilter_DropUnknown(t *testing.T) {
+	filter := LevelFilter{Min: LogLevelWarn, KeepUnknown: false}
+
+	assert.False(t, filter.Keep("GET /health 200"))
+	assert.True(t, filter.Keep("WARN slow query"))
+
+	// Without a threshold, unknown lines are kept regardless
+	assert.True(t, LevelFilter{KeepUnknown: false}.Keep("GET /health 200"))
+}
+
+// ============================================================================
+// Multi-Service Prefix Tests
+// ============================================================================
+
+func TestFormatLogPrefix_PadsToWidth(t *testing.T) {
+	short := FormatLogPrefix("db", 8)
+	long := FormatLogPrefix("frontend", 8)
+
+	assert.Contains(t, short, "[db]")
+	assert.Contains(t, long, "[frontend]")
+	assert.Equal(t, lipgloss.Width(long), lipgloss.Width(short), "prefixes should align")
+	assert.Equal(t, len("[frontend] "), lipgloss.Width(long))
+}
+
+func TestFormatLogPrefix_NameLongerThanWidth(t *testing.T) {
+	prefix := FormatLogPrefix("frontend", 3)
+
+	assert.Equal(t, len("[frontend] "), lipgloss.Width(prefix))
+}
+
+func TestServicePrefixColor_Stable(t *testing.T) {
+	assert.Equal(t, servicePrefixColor("api"), servicePrefixColor("api"))
+	assert.Contains(t, servicePrefixColors, servicePrefixColor("postgres"))
+}
@@ -2,6 +2,9 @@ package ui
 
 import (
 	"fmt"
+	"io"
+	"os"
+	"time"
 
 	"github.com/charmbracelet/lipgloss"
 )
@@ -133,39 +136,57 @@ const (
 // Formatted Output Functions
 // ============================================================================
 
+// messageOutput is where status messages are written (nil means os.Stdout)
+var messageOutput io.Writer
+
+// SetMessageOutput redirects status messages (success, errors, hints, lists, etc.)
+// Used by --json to keep stdout clean for machine-readable output; nil restores stdout
+func SetMessageOutput(w io.Writer) {
+	messageOutput = w
+}
+
+// messageWriter returns the current status message destination
+// Resolved on each call so a swapped os.Stdout is honored
+func messageWriter() io.Writer {
+	if messageOutput != nil {
+		return messageOutput
+	}
+	return os.Stdout
+}
+
 // Success prints a success message with a checkmark
 func Success(message string) {
-	fmt.Println(StyleSuccess.Render(SymbolSuccess + " " + message))
+	fmt.Fprintln(messageWriter(), StyleSuccess.Render(SymbolSuccess+" "+message))
 }
 
 // Error prints an error message with X mark
 func Error(message string) {
-	fmt.Println(StyleError.Render(SymbolError + " " + message))
+	fmt.Fprintln(messageWriter(), StyleError.Render(SymbolError+" "+message))
 }
 
 // Warning prints a warning message with a warning symbol
 func Warning(message string) {
-	fmt.Println(StyleWarning.Render(SymbolWarning + " " + message))
+	fmt.Fprintln(messageWriter(), StyleWarning.Render(SymbolWarning+" "+message))
 }
 
 // Info prints an info message with an info symbol
 func Info(message string) {
-	fmt.Println(StyleInfo.Render(SymbolInfo + " " + message))
+	fmt.Fprintln(messageWriter(), StyleInfo.Render(SymbolInfo+" "+message))
 }
 
 // Hint prints a helpful hint/tip with lightbulb
 func Hint(message string) {
-	fmt.Println(StyleInfo.Render(SymbolLightbulb + " " + message))
+	fmt.Fprintln(messageWriter(), StyleInfo.Render(SymbolLightbulb+" "+message))
 }
 
 // Header prints a section header
 func Header(message string) {
-	fmt.Println(StyleHeader.Render(message))
+	fmt.Fprintln(messageWriter(), StyleHeader.Render(message))
 }
 
 // Subheader prints a subsection header
 func Subheader(message string) {
-	fmt.Println(StyleSubheader.Render(message))
+	fmt.Fprintln(messageWriter(), StyleSubheader.Render(message))
 }
 
 // ============================================================================
@@ -211,8 +232,10 @@ func FormatServiceStatus(status string) string {
 		return StatusRunning("Running")
 	case "starting":
 		return StatusStarting("Starting")
-	case "stopped", "exited":
+	case "stopped":
 		return StatusStopped("Stopped")
+	case "exited":
+		return StatusFailed("Exited")
 	case "failed", "error":
 		return StatusFailed("Failed")
 	default:
@@ -222,6 +245,63 @@ func FormatServiceStatus(status string) string {
 	}
 }
 
+// FormatServiceStatusWithHealth formats a service status like FormatServiceStatus, adding the health
+// of a running service (e.g., "Running (healthy)"); an unhealthy service is shown in the failure color
+// An empty health (no health check to go by) formats like FormatServiceStatus
+func FormatServiceStatusWithHealth(status, health string) string {
+	if status != "running" && status != "up" {
+		return FormatServiceStatus(status)
+	}
+
+	switch health {
+	case "healthy":
+		return StatusRunning("Running (healthy)")
+	case "unhealthy":
+		return StatusFailed("Running (unhealthy)")
+	case "starting":
+		return StatusStarting("Running (health: starting)")
+	default:
+		return FormatServiceStatus(status)
+	}
+}
+
+// ============================================================================
+// Duration Formatters
+// ============================================================================
+
+// FormatDuration renders a duration compactly with its two largest units (e.g., "45s", "5m12s", "2h14m", "3d4h")
+// A zero second unit is dropped ("2h" rather than "2h0m"); negative durations render as "0s"
+func FormatDuration(d time.Duration) string {
+	if d < time.Second {
+		return "0s"
+	}
+
+	units := []struct {
+		size   time.Duration
+		suffix string
+	}{
+		{24 * time.Hour, "d"},
+		{time.Hour, "h"},
+		{time.Minute, "m"},
+		{time.Second, "s"},
+	}
+
+	for i, unit := range units {
+		if d < unit.size {
+			continue
+		}
+		text := fmt.Sprintf("%d%s", d/unit.size, unit.suffix)
+		if i+1 < len(units) {
+			next := units[i+1]
+			if rest := (d % unit.size) / next.size; rest > 0 {
+				text += fmt.Sprintf("%d%s", rest, next.suffix)
+			}
+		}
+		return text
+	}
+	return "0s"
+}
+
 // ============================================================================
 // Inline Text Formatters (for use within strings)
 // ============================================================================
@@ -255,17 +335,17 @@ func Highlight(text string) string {
 
 // SuccessBox prints a success message in a box
 func SuccessBox(message string) {
-	fmt.Println(StyleSuccessBox.Render(SymbolSuccess + " " + message))
+	fmt.Fprintln(messageWriter(), StyleSuccessBox.Render(SymbolSuccess+" "+message))
 }
 
 // ErrorBox prints an error message in a box
 func ErrorBox(message string) {
-	fmt.Println(StyleErrorBox.Render(SymbolError + " " + message))
+	fmt.Fprintln(messageWriter(), StyleErrorBox.Render(SymbolError+" "+message))
 }
 
 // InfoBox prints an info message in a box
 func InfoBox(message string) {
-	fmt.Println(StyleInfoBox.Render(SymbolInfo + " " + message))
+	fmt.Fprintln(messageWriter(), StyleInfoBox.Render(SymbolInfo+" "+message))
 }
 
 // ============================================================================
@@ -274,20 +354,20 @@ func InfoBox(message string) {
 
 // Separator prints a visual separator line
 func Separator() {
-	fmt.Println(StyleDim.Render("────────────────────────────────────────────────────────────────"))
+	fmt.Fprintln(messageWriter(), StyleDim.Render("────────────────────────────────────────────────────────────────"))
 }
 
 // EmptyLine prints a blank line for spacing
 func EmptyLine() {
-	fmt.Println()
+	fmt.Fprintln(messageWriter())
 }
 
 // List prints a bulleted list item
 func List(item string) {
-	fmt.Printf("  %s %s\n", StyleDim.Render(SymbolBullet), item)
+	fmt.Fprintf(messageWriter(), "  %s %s\n", StyleDim.Render(SymbolBullet), item)
 }
 
 // ListItem prints a bulleted list item with a custom prefix
 func ListItem(prefix, item string) {
-	fmt.Printf("  %s %s\n", prefix, item)
+	fmt.Fprintf(messageWriter(), "  %s %s\n", prefix, item)
 }
@@ -0,0 +1,68 @@
+package ui
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// ============================================================================
+// Duration Formatter Tests
+// ============================================================================
+
+func TestFormatDuration(t *testing.T) {
+	tests := []struct {
+		input    time.Duration
+		expected string
+	}{
+		{-time.Minute, "0s"},
+		{500 * time.Millisecond, "0s"},
+		{45 * time.Second, "45s"},
+		{5*time.Minute + 12*time.Second, "5m12s"},
+		{2*time.Hour + 14*time.Minute + 59*time.Second, "2h14m"},
+		{2 * time.Hour, "2h"},
+		{76 * time.Hour, "3d4h"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expected, func(t *testing.T) {
+			assert.Equal(t, tt.expected, FormatDuration(tt.input))
+		})
+	}
+}
+
+// ============================================================================
+// Status Formatter Tests
+// ============================================================================
+
+func TestFormatServiceStatusWithHealth(t *testing.T) {
+	tests := []struct {
+		name   string
+		status string
+		health string
+		want   string
+	}{
+		{"running without a health check", "running", "", "Running"},
+		{"running and healthy", "running", "healthy", "Running (healthy)"},
+		{"running and unhealthy", "running", "unhealthy", "Running (unhealthy)"},
+		{"running, health starting", "running", "starting", "Running (health: starting)"},
+		{"unknown health", "running", "unknown", "Running"},
+		{"stopped ignores health", "stopped", "healthy", "Stopped"},
+		{"exited ignores health", "exited", "healthy", "Exited"},
+		{"starting container", "starting", "", "Starting"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := FormatServiceStatusWithHealth(tt.status, tt.health)
+			assert.Contains(t, got, tt.want)
+			if tt.want == "Running" {
+				assert.NotContains(t, got, "(")
+			}
+		})
+	}
+
+	assert.Equal(t, FormatServiceStatus("running"), FormatServiceStatusWithHealth("running", ""))
+	assert.Equal(t, StatusFailed("Running (unhealthy)"), FormatServiceStatusWithHealth("running", "unhealthy"))
+}
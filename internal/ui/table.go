@@ -3,9 +3,11 @@ package ui
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/charmbracelet/lipgloss"
 	"github.com/charmbracelet/lipgloss/table"
+	"github.com/docker/go-units"
 )
 
 // ============================================================================
@@ -34,11 +36,12 @@ var (
 
 // ServiceRow represents a single row in the service table
 type ServiceRow struct {
-	Service     string
-	Status      string
-	Ports       []string
-	ContainerID string
-	Uptime      string
+	Service     string   `json:"service"`
+	Status      string   `json:"status"`
+	Ports       []string `json:"ports"`
+	ContainerID string   `json:"container_id"`
+	Uptime      string   `json:"uptime"`
+	Health      string   `json:"health,omitempty"` // "healthy", "unhealthy", or "starting"; empty without a health check
 }
 
 // ServiceTable creates and renders a beautiful table for services
@@ -78,7 +81,7 @@ func ServiceTable(projectName string, rows []ServiceRow) string {
 
 		t.Row(
 			r.Service,
-			FormatServiceStatus(r.Status),
+			FormatServiceStatusWithHealth(r.Status, r.Health),
 			ports,
 			uptime,
 			containerID,
@@ -96,6 +99,278 @@ func ServiceTable(projectName string, rows []ServiceRow) string {
 	return output.String()
 }
 
+// WideServiceRow is a service row with the extra details shown by 'ork ps --format wide'
+type WideServiceRow struct {
+	ServiceRow
+	Image        string    `json:"image"`
+	Created      time.Time `json:"created"`
+	RestartCount int       `json:"restart_count"`
+}
+
+// WideServiceTable renders the service table with image, full container ID, created time,
+// restart count, and every port (rather than the first two)
+func WideServiceTable(projectName string, rows []WideServiceRow) string {
+	if len(rows) == 0 {
+		return renderEmptyState(projectName)
+	}
+
+	t := table.New().
+		Border(lipgloss.NormalBorder()).
+		BorderStyle(styleTableBorder).
+		StyleFunc(func(row, col int) lipgloss.Style {
+			if row == 0 {
+				return styleTableHeader
+			}
+			return styleTableCell
+		}).
+		Headers("SERVICE", "STATUS", "IMAGE", "PORTS", "CREATED", "RESTARTS", "UPTIME", "CONTAINER")
+
+	for _, r := range rows {
+		ports := Dim("-")
+		if len(r.Ports) > 0 {
+			ports = lipgloss.NewStyle().
+				Foreground(ColorSecondary).
+				Render(strings.Join(r.Ports, "\n"))
+		}
+		uptime := r.Uptime
+		if uptime == "" {
+			uptime = Dim("-")
+		}
+		created := Dim("-")
+		if !r.Created.IsZero() {
+			created = r.Created.Local().Format("2006-01-02 15:04:05")
+		}
+
+		t.Row(
+			r.Service,
+			FormatServiceStatusWithHealth(r.Status, r.Health),
+			r.Image,
+			ports,
+			created,
+			fmt.Sprintf("%d", r.RestartCount),
+			uptime,
+			Dim(r.ContainerID),
+		)
+	}
+
+	var output strings.Builder
+	headerText := StyleSubheader.Render(fmt.Sprintf("%s Services for project: %s", SymbolPackage, Bold(projectName)))
+	output.WriteString(headerText)
+	output.WriteString("\n\n")
+	output.WriteString(t.String())
+	output.WriteString("\n")
+
+	return output.String()
+}
+
+// ============================================================================
+// Stats Table - For 'ork stats' command
+// ============================================================================
+
+// StatsRow represents the resource usage of a single service
+type StatsRow struct {
+	Service       string  `json:"service"`
+	ContainerID   string  `json:"container_id"`
+	CPUPercent    float64 `json:"cpu_percent"`
+	MemoryUsage   uint64  `json:"memory_usage"`
+	MemoryLimit   uint64  `json:"memory_limit"`
+	MemoryPercent float64 `json:"memory_percent"`
+}
+
+// StatsTable creates and renders a table of CPU and memory usage per service
+func StatsTable(projectName string, rows []StatsRow) string {
+	if len(rows) == 0 {
+		return renderEmptyState(projectName)
+	}
+
+	t := table.New().
+		Border(lipgloss.NormalBorder()).
+		BorderStyle(styleTableBorder).
+		StyleFunc(func(row, col int) lipgloss.Style {
+			if row == 0 {
+				return styleTableHeader
+			}
+			return styleTableCell
+		}).
+		Headers("SERVICE", "CPU %", "MEM USAGE / LIMIT", "MEM %", "CONTAINER")
+
+	for _, r := range rows {
+		containerID := r.ContainerID
+		if len(containerID) > 12 {
+			containerID = containerID[:12]
+		}
+
+		t.Row(
+			r.Service,
+			fmt.Sprintf("%.2f%%", r.CPUPercent),
+			fmt.Sprintf("%s / %s", units.BytesSize(float64(r.MemoryUsage)), units.BytesSize(float64(r.MemoryLimit))),
+			fmt.Sprintf("%.2f%%", r.MemoryPercent),
+			Dim(containerID),
+		)
+	}
+
+	var output strings.Builder
+	headerText := StyleSubheader.Render(fmt.Sprintf("%s Resource usage for project: %s", SymbolPackage, Bold(projectName)))
+	output.WriteString(headerText)
+	output.WriteString("\n\n")
+	output.WriteString(t.String())
+	output.WriteString("\n")
+
+	return output.String()
+}
+
+// ============================================================================
+// Process Table - For 'ork top' command
+// ============================================================================
+
+// ProcessTable renders the processes running in a service's container, with the columns ps reported
+func ProcessTable(serviceName, containerID string, titles []string, processes [][]string) string {
+	if len(containerID) > 12 {
+		containerID = containerID[:12]
+	}
+
+	var output strings.Builder
+	headerText := StyleSubheader.Render(fmt.Sprintf("%s Processes in %s %s", SymbolPackage, Bold(serviceName), Dim(containerID)))
+	output.WriteString(headerText)
+	output.WriteString("\n\n")
+
+	if len(processes) == 0 {
+		output.WriteString(Dim("  No processes running"))
+		output.WriteString("\n")
+		return output.String()
+	}
+
+	t := table.New().
+		Border(lipgloss.NormalBorder()).
+		BorderStyle(styleTableBorder).
+		StyleFunc(func(row, col int) lipgloss.Style {
+			if row == 0 {
+				return styleTableHeader
+			}
+			return styleTableCell
+		}).
+		Headers(titles...)
+
+	for _, process := range processes {
+		t.Row(process...)
+	}
+
+	output.WriteString(t.String())
+	output.WriteString("\n")
+
+	return output.String()
+}
+
+// ============================================================================
+// Project Table - For 'ork projects' command
+// ============================================================================
+
+// ProjectRow represents a single row in the project table
+type ProjectRow struct {
+	Project string
+	Running int
+	Stopped int
+}
+
+// ProjectTable creates and renders a table of Ork projects on this host
+func ProjectTable(rows []ProjectRow) string {
+	if len(rows) == 0 {
+		return renderNoProjects()
+	}
+
+	t := table.New().
+		Border(lipgloss.NormalBorder()).
+		BorderStyle(styleTableBorder).
+		StyleFunc(func(row, col int) lipgloss.Style {
+			if row == 0 {
+				return styleTableHeader
+			}
+			return styleTableCell
+		}).
+		Headers("PROJECT", "RUNNING", "STOPPED", "TOTAL")
+
+	for _, r := range rows {
+		running := Dim("0")
+		if r.Running > 0 {
+			running = StatusRunning(fmt.Sprintf("%d", r.Running))
+		}
+		stopped := Dim("0")
+		if r.Stopped > 0 {
+			stopped = StatusStopped(fmt.Sprintf("%d", r.Stopped))
+		}
+
+		t.Row(
+			Bold(r.Project),
+			running,
+			stopped,
+			fmt.Sprintf("%d", r.Running+r.Stopped),
+		)
+	}
+
+	var output strings.Builder
+	headerText := StyleSubheader.Render(fmt.Sprintf("%s Ork projects", SymbolPackage))
+	output.WriteString(headerText)
+	output.WriteString("\n\n")
+	output.WriteString(t.String())
+	output.WriteString("\n")
+
+	return output.String()
+}
+
+// ============================================================================
+// Timing Table - For 'ork up --timing'
+// ============================================================================
+
+// TimingRow represents the startup phase breakdown for a single service
+type TimingRow struct {
+	Service    string
+	Build      time.Duration
+	Pull       time.Duration
+	Create     time.Duration
+	Start      time.Duration
+	HealthWait time.Duration
+	Total      time.Duration
+}
+
+// TimingTable creates and renders a per-service startup timing breakdown
+func TimingTable(rows []TimingRow) string {
+	if len(rows) == 0 {
+		return ""
+	}
+
+	t := table.New().
+		Border(lipgloss.NormalBorder()).
+		BorderStyle(styleTableBorder).
+		StyleFunc(func(row, col int) lipgloss.Style {
+			if row == 0 {
+				return styleTableHeader
+			}
+			return styleTableCell
+		}).
+		Headers("SERVICE", "BUILD", "PULL", "CREATE", "START", "HEALTH", "TOTAL")
+
+	for _, r := range rows {
+		t.Row(
+			r.Service,
+			formatPhaseDuration(r.Build),
+			formatPhaseDuration(r.Pull),
+			formatPhaseDuration(r.Create),
+			formatPhaseDuration(r.Start),
+			formatPhaseDuration(r.HealthWait),
+			Bold(formatPhaseDuration(r.Total)),
+		)
+	}
+
+	var output strings.Builder
+	headerText := StyleSubheader.Render(fmt.Sprintf("%s Startup timing", SymbolGear))
+	output.WriteString(headerText)
+	output.WriteString("\n\n")
+	output.WriteString(t.String())
+	output.WriteString("\n")
+
+	return output.String()
+}
+
 // ============================================================================
 // Port Table - For 'ork ports' command (future)
 // ============================================================================
@@ -243,6 +518,14 @@ func HealthCheckTable(category string, rows []HealthCheckRow) string {
 // Private Helper Functions
 // ============================================================================
 
+// formatPhaseDuration renders a phase duration with millisecond precision, or a dash if it didn't run
+func formatPhaseDuration(d time.Duration) string {
+	if d <= 0 {
+		return Dim("-")
+	}
+	return d.Round(time.Millisecond).String()
+}
+
 // formatPorts formats port list for display
 func formatPorts(ports []string) string {
 	if len(ports) == 0 {
@@ -294,6 +577,24 @@ func renderEmptyState(projectName string) string {
 	return box.Render(message) + "\n"
 }
 
+// renderNoProjects renders a message when no Ork projects have containers
+func renderNoProjects() string {
+	box := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(ColorTextDim).
+		Padding(1, 2).
+		Align(lipgloss.Center)
+
+	message := fmt.Sprintf(
+		"%s\n\n%s %s",
+		Dim("No Ork projects found"),
+		SymbolLightbulb,
+		Dim("Start services with: "+Code("ork up")),
+	)
+
+	return box.Render(message) + "\n"
+}
+
 // renderNoPortsAllocated renders a message when no ports are allocated
 func renderNoPortsAllocated() string {
 	box := lipgloss.NewStyle().
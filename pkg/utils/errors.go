@@ -1,6 +1,7 @@
 package utils
 
 import (
+	"errors"
 	"fmt"
 	"strings"
 )
@@ -23,6 +24,22 @@ const (
 	ErrorInternal   ErrorKind = "internal"   // Unexpected internal errors
 )
 
+// exitCodes maps each error kind to the process exit code ork uses for it,
+// so scripts can tell a bad config apart from an unreachable Docker daemon
+// Kinds without an entry (and errors that aren't an OrkError) exit with ExitFailure
+var exitCodes = map[ErrorKind]int{
+	ErrorConfig:     2,
+	ErrorDocker:     3,
+	ErrorNetwork:    4,
+	ErrorValidation: 5,
+	ErrorService:    6,
+	ErrorGit:        7,
+	ErrorFile:       8,
+}
+
+// ExitFailure is the exit code for an error without a more specific kind
+const ExitFailure = 1
+
 // ============================================================================
 // OrkError - Structured error with context and hints
 // ============================================================================
@@ -75,6 +92,22 @@ func (e *OrkError) Unwrap() error {
 	return e.Err
 }
 
+// ExitCode returns the process exit code for err: 0 for nil, a code per kind for an OrkError
+// (the outermost one, when several are wrapped), and ExitFailure for anything else
+func ExitCode(err error) int {
+	if err == nil {
+		return 0
+	}
+
+	var orkErr *OrkError
+	if errors.As(err, &orkErr) {
+		if code, ok := exitCodes[orkErr.Kind]; ok {
+			return code
+		}
+	}
+	return ExitFailure
+}
+
 // ============================================================================
 // Error Constructors - Convenience functions for common error types
 // ============================================================================
@@ -261,47 +294,55 @@ func ErrImageNotFound(imageName string) *OrkError {
 // ============================================================================
 
 // Wrap adds context to an existing error
+// The kind is inferred from err (see WrapKind to set it explicitly)
 func Wrap(err error, op, message string) error {
-	if err == nil {
-		return nil
-	}
-
-	// If it's already an OrkError, just add to the operation chain
-	if orkErr, ok := err.(*OrkError); ok {
-		orkErr.Op = op + "." + orkErr.Op
-		return orkErr
-	}
-
-	// Otherwise create a new OrkError
-	return &OrkError{
-		Op:      op,
-		Err:     err,
-		Message: message,
-	}
+	return WrapKind(err, inferKind(err), op, message, "")
 }
 
 // WrapWithHint adds context and a hint to an existing error
+// The kind is inferred from err, like Wrap
 func WrapWithHint(err error, op, message, hint string) error {
+	return WrapKind(err, inferKind(err), op, message, hint)
+}
+
+// WrapKind adds context, a kind, and an optional hint to an existing error
+// An OrkError keeps its own kind and hint; kind and hint only fill them in when empty
+func WrapKind(err error, kind ErrorKind, op, message, hint string) error {
 	if err == nil {
 		return nil
 	}
 
+	// If it's already an OrkError, just add to the operation chain
 	if orkErr, ok := err.(*OrkError); ok {
 		orkErr.Op = op + "." + orkErr.Op
+		if orkErr.Kind == "" {
+			orkErr.Kind = kind
+		}
 		if orkErr.Hint == "" {
 			orkErr.Hint = hint
 		}
 		return orkErr
 	}
 
+	// Otherwise create a new OrkError
 	return &OrkError{
 		Op:      op,
+		Kind:    kind,
 		Err:     err,
 		Message: message,
 		Hint:    hint,
 	}
 }
 
+// inferKind returns the kind of the first OrkError in err's chain, or ErrorInternal when there is none
+func inferKind(err error) ErrorKind {
+	var orkErr *OrkError
+	if errors.As(err, &orkErr) && orkErr.Kind != "" {
+		return orkErr.Kind
+	}
+	return ErrorInternal
+}
+
 // ============================================================================
 // Error Checking Helpers
 // ============================================================================
@@ -0,0 +1,90 @@
+package utils
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExitCode(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		expected int
+	}{
+		{name: "no error", err: nil, expected: 0},
+		{name: "config", err: ConfigError("config.load", "bad config", "", nil), expected: 2},
+		{name: "docker", err: DockerError("docker.connect", "no daemon", "", nil), expected: 3},
+		{name: "network", err: NetworkError("network.create", "no network", "", nil), expected: 4},
+		{name: "validation", err: ValidationError("service.validate", "unknown service", nil), expected: 5},
+		{name: "service", err: ServiceError("service.start", "failed to start", "", nil), expected: 6},
+		{name: "git", err: &OrkError{Kind: ErrorGit, Message: "clone failed"}, expected: 7},
+		{name: "file", err: FileError("file.read", "unreadable", "", nil), expected: 8},
+		{name: "internal", err: &OrkError{Kind: ErrorInternal, Message: "bug"}, expected: ExitFailure},
+		{name: "no kind", err: &OrkError{Message: "unknown"}, expected: ExitFailure},
+		{name: "plain error", err: errors.New("something broke"), expected: ExitFailure},
+		{name: "wrapped ork error", err: fmt.Errorf("up failed: %w", ConfigError("config.load", "bad config", "", nil)), expected: 2},
+		{
+			name:     "outermost kind wins",
+			err:      ServiceError("restart.start", "failed to start", "", DockerError("docker.run", "daemon error", "", nil)),
+			expected: 6,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, ExitCode(tt.err))
+		})
+	}
+}
+
+func TestWrap_PreservesKind(t *testing.T) {
+	err := Wrap(DockerError("docker.start", "failed to start", "Is Docker running?", nil), "service", "ignored")
+
+	assert.True(t, IsKind(err, ErrorDocker))
+	assert.Equal(t, "service.docker.start", err.(*OrkError).Op)
+	assert.Equal(t, "Is Docker running?", err.(*OrkError).Hint)
+}
+
+func TestWrap_InfersKind(t *testing.T) {
+	t.Run("plain error", func(t *testing.T) {
+		err := Wrap(errors.New("boom"), "service.start", "failed to start")
+		assert.True(t, IsKind(err, ErrorInternal))
+	})
+
+	t.Run("ork error in chain", func(t *testing.T) {
+		inner := fmt.Errorf("start: %w", NetworkError("network.create", "no network", "", nil))
+		err := WrapWithHint(inner, "service.start", "failed to start", "Run ork doctor")
+		assert.True(t, IsKind(err, ErrorNetwork))
+		assert.Equal(t, "Run ork doctor", err.(*OrkError).Hint)
+	})
+}
+
+func TestWrapKind(t *testing.T) {
+	t.Run("plain error gets the given kind", func(t *testing.T) {
+		cause := errors.New("connection refused")
+		err := WrapKind(cause, ErrorDocker, "docker.connect", "cannot reach Docker", "Start Docker")
+
+		orkErr := err.(*OrkError)
+		assert.Equal(t, ErrorDocker, orkErr.Kind)
+		assert.Equal(t, "cannot reach Docker", orkErr.Message)
+		assert.Equal(t, "Start Docker", orkErr.Hint)
+		assert.ErrorIs(t, err, cause)
+	})
+
+	t.Run("ork error keeps its own kind", func(t *testing.T) {
+		err := WrapKind(ConfigError("config.load", "bad config", "", nil), ErrorDocker, "up", "ignored", "")
+		assert.True(t, IsKind(err, ErrorConfig))
+	})
+
+	t.Run("ork error without a kind takes the given one", func(t *testing.T) {
+		err := WrapKind(&OrkError{Op: "load", Message: "unknown"}, ErrorFile, "config", "ignored", "")
+		assert.True(t, IsKind(err, ErrorFile))
+	})
+
+	t.Run("nil", func(t *testing.T) {
+		assert.NoError(t, WrapKind(nil, ErrorDocker, "docker.connect", "cannot reach Docker", ""))
+	})
+}
@@ -0,0 +1,46 @@
+package utils
+
+import (
+	"context"
+	"time"
+)
+
+// ============================================================================
+// Retry - Re-run transiently failing operations
+// ============================================================================
+
+// RetryOptions controls how an operation is retried
+type RetryOptions struct {
+	Attempts    int              // Total number of attempts, including the first (minimum 1)
+	Delay       time.Duration    // Wait between attempts
+	ShouldRetry func(error) bool // Which errors are worth retrying (nil retries every error)
+}
+
+// Retry runs fn until it succeeds, returns a non-retryable error, or runs out of attempts
+// Returns the last error from fn, or the context's error if it is canceled while waiting
+func Retry(ctx context.Context, opts RetryOptions, fn func() error) error {
+	attempts := opts.Attempts
+	if attempts < 1 {
+		attempts = 1
+	}
+
+	var err error
+	for attempt := 1; attempt <= attempts; attempt++ {
+		if err = fn(); err == nil {
+			return nil
+		}
+
+		// Give up on errors that won't go away by themselves, or after the last attempt
+		if (opts.ShouldRetry != nil && !opts.ShouldRetry(err)) || attempt == attempts {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(opts.Delay):
+		}
+	}
+
+	return err
+}
@@ -0,0 +1,73 @@
+package utils
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ============================================================================
+// Since - Parse "--since" style time arguments
+// ============================================================================
+
+// unixSecondsPattern matches a Unix timestamp in seconds, optionally with a fractional part
+var unixSecondsPattern = regexp.MustCompile(`^\d+(\.\d+)?$`)
+
+// relativeDaysPattern splits a relative duration into a leading day count and the rest (e.g., "1d12h")
+var relativeDaysPattern = regexp.MustCompile(`^(\d+)d(.*)$`)
+
+// ParseSince parses a point in time given as an RFC3339 timestamp ("2024-05-01T12:00:00Z"),
+// Unix seconds ("1714564800"), or a duration ago ("10m", "2h", "3d", "1d12h")
+func ParseSince(value string) (time.Time, error) {
+	return parseSinceAt(value, time.Now())
+}
+
+// parseSinceAt is ParseSince with relative durations measured back from now
+func parseSinceAt(value string, now time.Time) (time.Time, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return time.Time{}, fmt.Errorf("empty time value, expected a timestamp (RFC3339 or Unix seconds) or a duration like 10m, 2h, or 3d")
+	}
+
+	if t, err := time.Parse(time.RFC3339Nano, value); err == nil {
+		return t, nil
+	}
+
+	if unixSecondsPattern.MatchString(value) {
+		seconds, err := strconv.ParseFloat(value, 64)
+		if err == nil {
+			whole := int64(seconds)
+			return time.Unix(whole, int64((seconds-float64(whole))*1e9)), nil
+		}
+	}
+
+	if ago, ok := parseRelativeDuration(value); ok {
+		return now.Add(-ago), nil
+	}
+
+	return time.Time{}, fmt.Errorf("invalid time '%s', expected a timestamp (RFC3339 or Unix seconds) or a duration like 10m, 2h, or 3d", value)
+}
+
+// parseRelativeDuration parses a non-negative Go duration, extended with a leading day count ("3d", "1d12h")
+func parseRelativeDuration(value string) (time.Duration, bool) {
+	var days time.Duration
+	if match := relativeDaysPattern.FindStringSubmatch(value); match != nil {
+		count, err := strconv.Atoi(match[1])
+		if err != nil {
+			return 0, false
+		}
+		days = time.Duration(count) * 24 * time.Hour
+		value = match[2]
+		if value == "" {
+			return days, true
+		}
+	}
+
+	d, err := time.ParseDuration(value)
+	if err != nil || d < 0 {
+		return 0, false
+	}
+	return days + d, true
+}
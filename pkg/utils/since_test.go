@@ -0,0 +1,50 @@
+package utils
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseSince(t *testing.T) {
+	now := time.Date(2024, 5, 10, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		input    string
+		expected time.Time
+	}{
+		{"RFC3339", "2024-05-01T12:00:00Z", time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)},
+		{"RFC3339 with offset", "2024-05-01T14:00:00+02:00", time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)},
+		{"RFC3339 with fraction", "2024-05-01T12:00:00.5Z", time.Date(2024, 5, 1, 12, 0, 0, 500_000_000, time.UTC)},
+		{"Unix seconds", "1714564800", time.Unix(1714564800, 0)},
+		{"Unix seconds with fraction", "1714564800.25", time.Unix(1714564800, 250_000_000)},
+		{"minutes ago", "10m", now.Add(-10 * time.Minute)},
+		{"hours ago", "2h", now.Add(-2 * time.Hour)},
+		{"compound duration", "1h30m", now.Add(-90 * time.Minute)},
+		{"days ago", "3d", now.Add(-72 * time.Hour)},
+		{"days and hours ago", "1d12h", now.Add(-36 * time.Hour)},
+		{"surrounding whitespace", " 10m ", now.Add(-10 * time.Minute)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseSinceAt(tt.input, now)
+			require.NoError(t, err)
+			assert.True(t, tt.expected.Equal(got), "expected %v, got %v", tt.expected, got)
+		})
+	}
+}
+
+func TestParseSince_Invalid(t *testing.T) {
+	for _, input := range []string{"", "yesterday", "10x", "-5m", "d", "3d-1h", "2024-05-01"} {
+		t.Run(input, func(t *testing.T) {
+			_, err := ParseSince(input)
+
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), "expected a timestamp")
+		})
+	}
+}